@@ -0,0 +1,26 @@
+package constraintsext
+
+// Signed 是所有有符号整数类型的约束。
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned 是所有无符号整数类型的约束。
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer 是所有整数类型的约束。
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float 是所有浮点数类型的约束。
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number 是所有整数与浮点数类型的约束，满足该约束的类型支持四则运算与大小比较。
+type Number interface {
+	Integer | Float
+}
@@ -0,0 +1,149 @@
+package goexasync
+
+import (
+	"context"
+	"errors"
+
+	"github.com/birdmichael/GoEx/tupleext"
+)
+
+// All 等待所有 Future 成功完成，结果按输入顺序排列。
+//
+// 任意一个 Future 失败时，返回的 Future 立即以该错误失败，并取消其余的 Future。
+//
+// 示例：
+//   - All(ctx, []*Future[int]{Resolved(1), Resolved(2)}) 的结果为 []int{1, 2}。
+func All[T any](ctx context.Context, futures []*Future[T]) *Future[[]T] {
+	return Go(ctx, func(ctx context.Context) ([]T, error) {
+		results := make([]T, len(futures))
+		order := completions(ctx, futures)
+		for range futures {
+			i, err := next(ctx, order)
+			if err != nil {
+				cancelAll(futures)
+				return nil, err
+			}
+			value, err := futures[i].Await(ctx)
+			if err != nil {
+				cancelAll(futures)
+				return nil, err
+			}
+			results[i] = value
+		}
+		return results, nil
+	})
+}
+
+// Any 返回第一个成功完成的 Future 的结果，并取消其余的 Future。
+//
+// 所有 Future 都失败时，返回的 Future 以 errors.Join 合并的全部错误失败。
+func Any[T any](ctx context.Context, futures []*Future[T]) *Future[T] {
+	return Go(ctx, func(ctx context.Context) (T, error) {
+		var zero T
+		errs := make([]error, 0, len(futures))
+		order := completions(ctx, futures)
+		for range futures {
+			i, err := next(ctx, order)
+			if err != nil {
+				cancelAll(futures)
+				return zero, err
+			}
+			value, err := futures[i].Await(ctx)
+			if err == nil {
+				cancelAll(futures)
+				return value, nil
+			}
+			errs = append(errs, err)
+		}
+		if len(errs) == 0 {
+			return zero, errors.New("goexasync: Any called with no futures")
+		}
+		return zero, errors.Join(errs...)
+	})
+}
+
+// Race 返回第一个完成的 Future 的结果（无论成功还是失败），并取消其余的 Future。
+func Race[T any](ctx context.Context, futures []*Future[T]) *Future[T] {
+	return Go(ctx, func(ctx context.Context) (T, error) {
+		if len(futures) == 0 {
+			var zero T
+			return zero, errors.New("goexasync: Race called with no futures")
+		}
+		i, err := next(ctx, completions(ctx, futures))
+		if err != nil {
+			cancelAll(futures)
+			var zero T
+			return zero, err
+		}
+		cancelAll(futures)
+		return futures[i].Await(ctx)
+	})
+}
+
+// MARK: - Collection
+
+// GoEach 为 slice 中的每个元素启动一个 Future。
+//
+// 参数：
+//   - ctx: 所有 Future 的父上下文。
+//   - slice: 输入元素。
+//   - fn: 对每个元素执行的函数。
+//
+// 返回值：
+//   - 与 slice 顺序一致的 Future 切片，可以交给 All、Any、Race 或 Settle。
+func GoEach[S ~[]E, E, T any](ctx context.Context, slice S, fn func(ctx context.Context, item E) (T, error)) []*Future[T] {
+	futures := make([]*Future[T], len(slice))
+	for i, item := range slice {
+		item := item
+		futures[i] = Go(ctx, func(ctx context.Context) (T, error) { return fn(ctx, item) })
+	}
+	return futures
+}
+
+// Settle 等待所有 Future 完成（无论成功还是失败），按输入顺序返回结果与错误组成的元组。
+//
+// ctx 被取消时，尚未完成的 Future 对应的错误为 ctx 的错误。
+//
+// 示例：
+//   - Settle(ctx, []*Future[int]{Resolved(1), Rejected[int](err)}) 返回 [{1 <nil>} {0 err}]。
+func Settle[T any](ctx context.Context, futures []*Future[T]) []tupleext.Tuple[T, error] {
+	results := make([]tupleext.Tuple[T, error], len(futures))
+	for i, f := range futures {
+		value, err := f.Await(ctx)
+		results[i] = tupleext.Tuple[T, error]{S1: value, S2: err}
+	}
+	return results
+}
+
+// MARK: - Internal
+
+// completions 返回一个按完成顺序发送 Future 序号的通道，ctx 取消后不再发送。
+func completions[T any](ctx context.Context, futures []*Future[T]) <-chan int {
+	order := make(chan int, len(futures))
+	for i, f := range futures {
+		go func(i int, f *Future[T]) {
+			select {
+			case <-f.done:
+				order <- i
+			case <-ctx.Done():
+			}
+		}(i, f)
+	}
+	return order
+}
+
+// next 从 order 中取出下一个完成的序号，ctx 取消时返回 ctx 的错误。
+func next(ctx context.Context, order <-chan int) (int, error) {
+	select {
+	case i := <-order:
+		return i, nil
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+func cancelAll[T any](futures []*Future[T]) {
+	for _, f := range futures {
+		f.Cancel()
+	}
+}
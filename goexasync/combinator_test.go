@@ -0,0 +1,99 @@
+package goexasync
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func sleepValue(d time.Duration, v int, err error) func(ctx context.Context) (int, error) {
+	return func(ctx context.Context) (int, error) {
+		select {
+		case <-time.After(d):
+			return v, err
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+}
+
+func TestAll(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("TestAll_Success", func(t *testing.T) {
+		futures := []*Future[int]{
+			Go(ctx, sleepValue(3*time.Millisecond, 1, nil)),
+			Go(ctx, sleepValue(time.Millisecond, 2, nil)),
+			Resolved(3),
+		}
+		result, err := All(ctx, futures).Await(ctx)
+		if err != nil || !reflect.DeepEqual(result, []int{1, 2, 3}) {
+			t.Errorf("Expected [1 2 3] and nil, but got %v and %v", result, err)
+		}
+	})
+
+	t.Run("TestAll_FailCancelsOthers", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		slow := Go(ctx, sleepValue(time.Hour, 1, nil))
+		_, err := All(ctx, []*Future[int]{slow, Rejected[int](errBoom)}).Await(ctx)
+		if !errors.Is(err, errBoom) {
+			t.Errorf("Expected boom, but got %v", err)
+		}
+		if _, err := slow.Await(ctx); !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected slow future to be canceled, but got %v", err)
+		}
+	})
+}
+
+func TestAny(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("TestAny_FirstSuccess", func(t *testing.T) {
+		futures := []*Future[int]{
+			Rejected[int](errors.New("fail")),
+			Go(ctx, sleepValue(time.Millisecond, 2, nil)),
+			Go(ctx, sleepValue(time.Hour, 3, nil)),
+		}
+		if v, err := Any(ctx, futures).Await(ctx); v != 2 || err != nil {
+			t.Errorf("Expected 2 and nil, but got %v and %v", v, err)
+		}
+	})
+
+	t.Run("TestAny_AllFail", func(t *testing.T) {
+		errA, errB := errors.New("a"), errors.New("b")
+		_, err := Any(ctx, []*Future[int]{Rejected[int](errA), Rejected[int](errB)}).Await(ctx)
+		if !errors.Is(err, errA) || !errors.Is(err, errB) {
+			t.Errorf("Expected joined errors, but got %v", err)
+		}
+	})
+}
+
+func TestRace(t *testing.T) {
+	ctx := context.Background()
+	errFast := errors.New("fast")
+	slow := Go(ctx, sleepValue(time.Hour, 1, nil))
+	fast := Go(ctx, sleepValue(time.Millisecond, 0, errFast))
+	if _, err := Race(ctx, []*Future[int]{slow, fast}).Await(ctx); !errors.Is(err, errFast) {
+		t.Errorf("Expected fast error, but got %v", err)
+	}
+	if _, err := slow.Await(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected slow future to be canceled, but got %v", err)
+	}
+}
+
+func TestGoEachSettle(t *testing.T) {
+	ctx := context.Background()
+	errOdd := errors.New("odd")
+	futures := GoEach(ctx, []int{1, 2, 3}, func(ctx context.Context, v int) (int, error) {
+		if v%2 == 1 {
+			return 0, errOdd
+		}
+		return v * 10, nil
+	})
+	results := Settle(ctx, futures)
+	if len(results) != 3 || results[1].S1 != 20 || results[1].S2 != nil || !errors.Is(results[0].S2, errOdd) {
+		t.Errorf("Unexpected settled results %v", results)
+	}
+}
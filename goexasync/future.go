@@ -0,0 +1,169 @@
+package goexasync
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexsync"
+)
+
+// Future 表示一个异步计算的结果，类似 Swift 中的 Task。
+//
+// Future 在创建时即开始执行，结果只会被设置一次，可以被任意多个 goroutine 并发地等待。
+//
+// 参数：
+//   - T: 结果的类型。
+type Future[T any] struct {
+	done   chan struct{}
+	parent context.Context
+	cancel context.CancelFunc
+	value  T
+	err    error
+}
+
+// Go 在新的 goroutine 中执行 fn，并返回代表其结果的 Future。
+//
+// fn 中发生的 panic 会被转换为 *goexsync.PanicError。
+//
+// 参数：
+//   - ctx: 父上下文，取消后 fn 接收的上下文也会被取消。
+//   - fn: 要执行的函数，应在其上下文取消时尽快返回。
+//
+// 返回值：
+//   - 代表 fn 结果的 Future。
+//
+// 示例：
+//
+//	f := Go(ctx, func(ctx context.Context) (int, error) { return 42, nil })
+//	v, err := f.Await(ctx) // 返回 42 和 nil
+func Go[T any](ctx context.Context, fn func(ctx context.Context) (T, error)) *Future[T] {
+	runCtx, cancel := context.WithCancel(ctx)
+	f := &Future[T]{done: make(chan struct{}), parent: ctx, cancel: cancel}
+	go func() {
+		defer close(f.done)
+		defer cancel()
+		f.value, f.err = run(runCtx, fn)
+	}()
+	return f
+}
+
+// Resolved 返回一个已经成功完成的 Future。
+func Resolved[T any](value T) *Future[T] {
+	f := &Future[T]{done: make(chan struct{}), parent: context.Background(), cancel: func() {}, value: value}
+	close(f.done)
+	return f
+}
+
+// Rejected 返回一个已经以 err 失败的 Future。
+func Rejected[T any](err error) *Future[T] {
+	f := &Future[T]{done: make(chan struct{}), parent: context.Background(), cancel: func() {}, err: err}
+	close(f.done)
+	return f
+}
+
+func run[T any](ctx context.Context, fn func(ctx context.Context) (T, error)) (value T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &goexsync.PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+	return fn(ctx)
+}
+
+// MARK: - Await
+
+// Done 返回一个在 Future 完成时关闭的通道。
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// IsDone 判断 Future 是否已经完成。
+func (f *Future[T]) IsDone() bool {
+	select {
+	case <-f.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Await 等待 Future 完成并返回结果。
+//
+// 参数：
+//   - ctx: 等待的上下文，取消时 Await 立即返回 ctx 的错误，但不会取消 Future 本身。
+//
+// 返回值：
+//   - Future 的结果与错误。
+func (f *Future[T]) Await(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.value, f.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// AwaitTimeout 最多等待 d 时长，超时返回 context.DeadlineExceeded。
+func (f *Future[T]) AwaitTimeout(d time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+
+	return f.Await(ctx)
+}
+
+// Result 在 Future 已完成时返回结果，ok 为 false 表示尚未完成，此时 err 为 nil。
+func (f *Future[T]) Result() (value T, ok bool, err error) {
+	if !f.IsDone() {
+		return value, false, nil
+	}
+	return f.value, true, f.err
+}
+
+// Cancel 取消 Future 执行函数接收的上下文。
+//
+// 取消是协作式的：执行函数需要检查上下文并返回，Future 才会完成。
+func (f *Future[T]) Cancel() {
+	f.cancel()
+}
+
+// MARK: - Chain
+
+// Then 在 Future 成功完成后执行 fn，返回代表 fn 结果的新 Future。
+//
+// Future 失败时 fn 不会执行，新的 Future 以相同的错误失败。
+// 需要转换结果类型时使用 Map。
+func (f *Future[T]) Then(fn func(ctx context.Context, value T) (T, error)) *Future[T] {
+	return Map(f, fn)
+}
+
+// Catch 在 Future 失败后执行 fn，可用于提供默认值或转换错误；Future 成功时结果原样传递。
+func (f *Future[T]) Catch(fn func(ctx context.Context, err error) (T, error)) *Future[T] {
+	return Go(f.parent, func(ctx context.Context) (T, error) {
+		value, err := f.Await(ctx)
+		if err == nil {
+			return value, nil
+		}
+		return fn(ctx, err)
+	})
+}
+
+// Map 在 f 成功完成后执行 fn，返回代表 fn 结果的新 Future。
+//
+// 新的 Future 使用 f 的父上下文；f 失败或被取消时 fn 不会执行，新的 Future 以相同的错误失败。
+//
+// 示例：
+//
+//	user := Go(ctx, loadUser)
+//	name := Map(user, func(ctx context.Context, u User) (string, error) { return u.Name, nil })
+func Map[T, U any](f *Future[T], fn func(ctx context.Context, value T) (U, error)) *Future[U] {
+	return Go(f.parent, func(ctx context.Context) (U, error) {
+		value, err := f.Await(ctx)
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+		return fn(ctx, value)
+	})
+}
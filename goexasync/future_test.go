@@ -0,0 +1,83 @@
+package goexasync
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexsync"
+)
+
+func TestFuture(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("TestFuture_Await", func(t *testing.T) {
+		f := Go(ctx, func(ctx context.Context) (int, error) { return 42, nil })
+		if v, err := f.Await(ctx); v != 42 || err != nil {
+			t.Errorf("Expected 42 and nil, but got %v and %v", v, err)
+		}
+		if v, ok, err := f.Result(); v != 42 || !ok || err != nil || !f.IsDone() {
+			t.Errorf("Expected 42, true and nil, but got %v, %v and %v", v, ok, err)
+		}
+	})
+
+	t.Run("TestFuture_AwaitTimeout", func(t *testing.T) {
+		block := make(chan struct{})
+		defer close(block)
+		f := Go(ctx, func(ctx context.Context) (int, error) {
+			<-block
+			return 1, nil
+		})
+		if _, err := f.AwaitTimeout(time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected DeadlineExceeded, but got %v", err)
+		}
+		if _, ok, err := f.Result(); ok || err != nil {
+			t.Errorf("Expected pending result, but got %v and %v", ok, err)
+		}
+	})
+
+	t.Run("TestFuture_Cancel", func(t *testing.T) {
+		f := Go(ctx, func(ctx context.Context) (int, error) {
+			<-ctx.Done()
+			return 0, ctx.Err()
+		})
+		f.Cancel()
+		if _, err := f.Await(ctx); !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected Canceled, but got %v", err)
+		}
+	})
+
+	t.Run("TestFuture_Panic", func(t *testing.T) {
+		f := Go(ctx, func(ctx context.Context) (int, error) { panic("boom") })
+		var panicErr *goexsync.PanicError
+		if _, err := f.Await(ctx); !errors.As(err, &panicErr) {
+			t.Errorf("Expected PanicError, but got %v", err)
+		}
+	})
+
+	t.Run("TestFuture_Chain", func(t *testing.T) {
+		f := Resolved(20).Then(func(ctx context.Context, v int) (int, error) { return v + 1, nil })
+		s := Map(f, func(ctx context.Context, v int) (string, error) { return strconv.Itoa(v * 2), nil })
+		if v, err := s.Await(ctx); v != "42" || err != nil {
+			t.Errorf("Expected 42 and nil, but got %v and %v", v, err)
+		}
+	})
+
+	t.Run("TestFuture_ChainError", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		called := false
+		f := Map(Rejected[int](errBoom), func(ctx context.Context, v int) (int, error) {
+			called = true
+			return v, nil
+		})
+		if _, err := f.Await(ctx); !errors.Is(err, errBoom) || called {
+			t.Errorf("Expected error to propagate without calling fn, but got %v", err)
+		}
+		recovered := f.Catch(func(ctx context.Context, err error) (int, error) { return -1, nil })
+		if v, err := recovered.Await(ctx); v != -1 || err != nil {
+			t.Errorf("Expected -1 and nil, but got %v and %v", v, err)
+		}
+	})
+}
@@ -0,0 +1,370 @@
+package goexbits
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/birdmichael/GoEx/constraintsext"
+)
+
+// Bitmap 是一个 roaring 风格的压缩位图，保存 uint32 值的集合。
+//
+// 值按高 16 位分组，每组根据元素数量使用有序数组或 8KB 位图存储，
+// 因此对稀疏与稠密的数据都有较好的空间效率。零值即为空集合。Bitmap 不是并发安全的。
+type Bitmap struct {
+	keys       []uint16
+	containers []*container
+}
+
+// NewBitmap 创建一个包含给定值的 Bitmap。
+func NewBitmap(values ...uint32) *Bitmap {
+	b := &Bitmap{}
+	for _, v := range values {
+		b.Add(v)
+	}
+	return b
+}
+
+// BitmapFromSlice 创建一个包含 values 中所有值的 Bitmap。
+//
+// values 中的值必须在 [0, math.MaxUint32] 范围内，否则会 panic。
+func BitmapFromSlice[T constraintsext.Integer](values []T) *Bitmap {
+	b := &Bitmap{}
+	for _, v := range values {
+		if v < 0 || uint64(v) > math.MaxUint32 {
+			panic(fmt.Sprintf("goexbits: value %d out of uint32 range", v))
+		}
+		b.Add(uint32(v))
+	}
+	return b
+}
+
+func split(x uint32) (high, low uint16) {
+	return uint16(x >> 16), uint16(x)
+}
+
+func (b *Bitmap) find(high uint16) (int, bool) {
+	return slices.BinarySearch(b.keys, high)
+}
+
+// MARK: - Read
+
+// Contains 判断 x 是否在集合中。
+func (b *Bitmap) Contains(x uint32) bool {
+	high, low := split(x)
+	i, ok := b.find(high)
+	return ok && b.containers[i].contains(low)
+}
+
+// Cardinality 返回集合中元素的个数。
+func (b *Bitmap) Cardinality() int {
+	n := 0
+	for _, c := range b.containers {
+		n += c.card
+	}
+	return n
+}
+
+// IsEmpty 判断集合是否为空。
+func (b *Bitmap) IsEmpty() bool {
+	return len(b.containers) == 0
+}
+
+// Min 返回最小的元素，集合为空时 ok 为 false。
+func (b *Bitmap) Min() (x uint32, ok bool) {
+	if b.IsEmpty() {
+		return 0, false
+	}
+	return uint32(b.keys[0])<<16 | uint32(b.containers[0].min()), true
+}
+
+// Max 返回最大的元素，集合为空时 ok 为 false。
+func (b *Bitmap) Max() (x uint32, ok bool) {
+	if b.IsEmpty() {
+		return 0, false
+	}
+	last := len(b.keys) - 1
+	return uint32(b.keys[last])<<16 | uint32(b.containers[last].max()), true
+}
+
+// Range 按从小到大的顺序遍历集合，回调返回 false 时停止遍历。
+func (b *Bitmap) Range(fn func(x uint32) bool) {
+	for i, c := range b.containers {
+		high := uint32(b.keys[i]) << 16
+		if !c.rangeValues(func(low uint16) bool { return fn(high | uint32(low)) }) {
+			return
+		}
+	}
+}
+
+// ToSlice 返回所有元素组成的有序切片。
+func (b *Bitmap) ToSlice() []uint32 {
+	result := make([]uint32, 0, b.Cardinality())
+	b.Range(func(x uint32) bool {
+		result = append(result, x)
+		return true
+	})
+	return result
+}
+
+// Equal 判断两个 Bitmap 是否包含相同的元素。
+func (b *Bitmap) Equal(other *Bitmap) bool {
+	if !slices.Equal(b.keys, other.keys) {
+		return false
+	}
+	for i, c := range b.containers {
+		o := other.containers[i]
+		if c.card != o.card {
+			return false
+		}
+		// 元素数量相同的容器表示方式也相同
+		if c.bitmap != nil && !slices.Equal(c.bitmap, o.bitmap) || c.bitmap == nil && !slices.Equal(c.array, o.array) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone 返回 Bitmap 的深拷贝。
+func (b *Bitmap) Clone() *Bitmap {
+	result := &Bitmap{keys: slices.Clone(b.keys), containers: make([]*container, len(b.containers))}
+	for i, c := range b.containers {
+		result.containers[i] = c.clone()
+	}
+	return result
+}
+
+// String 返回形如 {1, 3, 5} 的字符串。
+func (b *Bitmap) String() string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	first := true
+	b.Range(func(x uint32) bool {
+		if !first {
+			sb.WriteString(", ")
+		}
+		first = false
+		sb.WriteString(strconv.FormatUint(uint64(x), 10))
+		return true
+	})
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// MARK: - Write
+
+// Add 将 x 加入集合。
+//
+// 返回值：
+//   - 如果 x 原本不在集合中，返回 true。
+func (b *Bitmap) Add(x uint32) bool {
+	high, low := split(x)
+	i, ok := b.find(high)
+	if !ok {
+		b.keys = slices.Insert(b.keys, i, high)
+		b.containers = slices.Insert(b.containers, i, newArrayContainer(low))
+		return true
+	}
+	return b.containers[i].add(low)
+}
+
+// Remove 将 x 从集合中删除。
+//
+// 返回值：
+//   - 如果 x 原本在集合中，返回 true。
+func (b *Bitmap) Remove(x uint32) bool {
+	high, low := split(x)
+	i, ok := b.find(high)
+	if !ok || !b.containers[i].remove(low) {
+		return false
+	}
+	if b.containers[i].card == 0 {
+		b.keys = slices.Delete(b.keys, i, i+1)
+		b.containers = slices.Delete(b.containers, i, i+1)
+	}
+	return true
+}
+
+// Clear 删除所有元素。
+func (b *Bitmap) Clear() {
+	b.keys = nil
+	b.containers = nil
+}
+
+// MARK: - Algebra
+
+// And 返回 b 与 other 的交集。
+func (b *Bitmap) And(other *Bitmap) *Bitmap {
+	return b.combine(other, opAnd)
+}
+
+// Or 返回 b 与 other 的并集。
+func (b *Bitmap) Or(other *Bitmap) *Bitmap {
+	return b.combine(other, opOr)
+}
+
+// Xor 返回 b 与 other 的对称差。
+func (b *Bitmap) Xor(other *Bitmap) *Bitmap {
+	return b.combine(other, opXor)
+}
+
+// AndNot 返回在 b 中但不在 other 中的元素。
+func (b *Bitmap) AndNot(other *Bitmap) *Bitmap {
+	return b.combine(other, opAndNot)
+}
+
+// combine 按 key 归并两个 Bitmap 的容器。只在一侧出现的 key 是否保留由运算决定。
+func (b *Bitmap) combine(other *Bitmap, op setOp) *Bitmap {
+	result := &Bitmap{}
+	keepLeft := op != opAnd
+	keepRight := op == opOr || op == opXor
+	push := func(key uint16, c *container) {
+		if c != nil {
+			result.keys = append(result.keys, key)
+			result.containers = append(result.containers, c)
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(b.keys) || j < len(other.keys) {
+		switch {
+		case j == len(other.keys) || i < len(b.keys) && b.keys[i] < other.keys[j]:
+			if keepLeft {
+				push(b.keys[i], b.containers[i].clone())
+			}
+			i++
+		case i == len(b.keys) || b.keys[i] > other.keys[j]:
+			if keepRight {
+				push(other.keys[j], other.containers[j].clone())
+			}
+			j++
+		default:
+			push(b.keys[i], combine(b.containers[i], other.containers[j], op))
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+// MARK: - Serialization
+
+const (
+	kindArray  byte = 0
+	kindBitmap byte = 1
+)
+
+// MarshalBinary 实现 encoding.BinaryMarshaler。
+//
+// 格式为 uvarint 编码的容器数，随后每个容器依次为：小端序的 uint16 key、类型字节、
+// 数组容器的 uvarint 元素数与小端序 uint16 元素，或位图容器的 1024 个小端序 uint64。
+func (b *Bitmap) MarshalBinary() ([]byte, error) {
+	data := binary.AppendUvarint(nil, uint64(len(b.keys)))
+	for i, c := range b.containers {
+		data = binary.LittleEndian.AppendUint16(data, b.keys[i])
+		if c.bitmap != nil {
+			data = append(data, kindBitmap)
+			for _, w := range c.bitmap {
+				data = binary.LittleEndian.AppendUint64(data, w)
+			}
+			continue
+		}
+		data = append(data, kindArray)
+		data = binary.AppendUvarint(data, uint64(len(c.array)))
+		for _, x := range c.array {
+			data = binary.LittleEndian.AppendUint16(data, x)
+		}
+	}
+	return data, nil
+}
+
+// UnmarshalBinary 实现 encoding.BinaryUnmarshaler，会覆盖 b 原有的内容。
+func (b *Bitmap) UnmarshalBinary(data []byte) error {
+	r := &reader{data: data}
+	n := r.uvarint()
+	if n > 1<<16 {
+		return ErrInvalidData
+	}
+
+	result := Bitmap{}
+	for i := uint64(0); i < n && r.err == nil; i++ {
+		key := r.uint16()
+		if len(result.keys) > 0 && key <= result.keys[len(result.keys)-1] {
+			return ErrInvalidData
+		}
+
+		c := &container{}
+		switch r.byte() {
+		case kindArray:
+			size := r.uvarint()
+			if size == 0 || size > arrayMaxSize {
+				return ErrInvalidData
+			}
+			c.array = make([]uint16, size)
+			for j := range c.array {
+				c.array[j] = r.uint16()
+				if j > 0 && c.array[j] <= c.array[j-1] {
+					return ErrInvalidData
+				}
+			}
+			c.card = len(c.array)
+		case kindBitmap:
+			c.bitmap = make([]uint64, bitmapWords)
+			for j := range c.bitmap {
+				c.bitmap[j] = r.uint64()
+				c.card += bits.OnesCount64(c.bitmap[j])
+			}
+			if c.card <= arrayMaxSize {
+				return ErrInvalidData
+			}
+		default:
+			return ErrInvalidData
+		}
+		result.keys = append(result.keys, key)
+		result.containers = append(result.containers, c)
+	}
+	if r.err != nil || len(r.data) != 0 {
+		return ErrInvalidData
+	}
+
+	*b = result
+	return nil
+}
+
+// reader 顺序读取二进制数据，数据不足时记录错误并返回零值。
+type reader struct {
+	data []byte
+	err  error
+}
+
+func (r *reader) take(n int) []byte {
+	if r.err != nil || len(r.data) < n {
+		r.err = ErrInvalidData
+		return make([]byte, n)
+	}
+	p := r.data[:n]
+	r.data = r.data[n:]
+	return p
+}
+
+func (r *reader) byte() byte     { return r.take(1)[0] }
+func (r *reader) uint16() uint16 { return binary.LittleEndian.Uint16(r.take(2)) }
+func (r *reader) uint64() uint64 { return binary.LittleEndian.Uint64(r.take(8)) }
+
+func (r *reader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		r.err = ErrInvalidData
+		return 0
+	}
+	r.data = r.data[n:]
+	return v
+}
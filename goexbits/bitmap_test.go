@@ -0,0 +1,224 @@
+package goexbits
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestBitmap_Basic(t *testing.T) {
+	b := NewBitmap(5, 1, 70000, math.MaxUint32)
+
+	if !b.Add(3) || b.Add(3) {
+		t.Errorf("Expected Add to report whether the value is new")
+	}
+	if !b.Contains(70000) || b.Contains(70001) || b.Contains(2) {
+		t.Errorf("Unexpected contents %v", b)
+	}
+	if b.Cardinality() != 5 {
+		t.Errorf("Expected %v, but got %v", 5, b.Cardinality())
+	}
+	expected := []uint32{1, 3, 5, 70000, math.MaxUint32}
+	if got := b.ToSlice(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+	if min, _ := b.Min(); min != 1 {
+		t.Errorf("Expected %v, but got %v", 1, min)
+	}
+	if max, _ := b.Max(); max != math.MaxUint32 {
+		t.Errorf("Expected %v, but got %v", uint32(math.MaxUint32), max)
+	}
+
+	if !b.Remove(70000) || b.Remove(70000) || len(b.keys) != 2 {
+		t.Errorf("Expected empty container to be removed, but got keys %v", b.keys)
+	}
+	if b.String() != "{1, 3, 5, 4294967295}" {
+		t.Errorf("Unexpected string %q", b.String())
+	}
+
+	b.Clear()
+	if !b.IsEmpty() {
+		t.Errorf("Expected empty bitmap after Clear")
+	}
+	if _, ok := b.Min(); ok {
+		t.Errorf("Expected Min of empty bitmap to fail")
+	}
+	if _, ok := b.Max(); ok {
+		t.Errorf("Expected Max of empty bitmap to fail")
+	}
+}
+
+func TestBitmap_ContainerConversion(t *testing.T) {
+	b := &Bitmap{}
+	for i := uint32(0); i <= arrayMaxSize; i++ {
+		b.Add(i * 2)
+	}
+	c := b.containers[0]
+	if c.bitmap == nil || c.card != arrayMaxSize+1 {
+		t.Fatalf("Expected container to become a bitmap above %d elements", arrayMaxSize)
+	}
+	if min, _ := b.Min(); min != 0 {
+		t.Errorf("Expected %v, but got %v", 0, min)
+	}
+	if max, _ := b.Max(); max != arrayMaxSize*2 {
+		t.Errorf("Expected %v, but got %v", arrayMaxSize*2, max)
+	}
+
+	b.Remove(0)
+	if c := b.containers[0]; c.bitmap != nil || c.card != arrayMaxSize || c.array[0] != 2 {
+		t.Errorf("Expected container to become an array again")
+	}
+}
+
+func TestBitmap_Algebra(t *testing.T) {
+	// 同时覆盖数组容器之间、位图容器之间以及两者混合的运算
+	dense := func(from, to, step uint32) []uint32 {
+		var values []uint32
+		for i := from; i < to; i += step {
+			values = append(values, i)
+		}
+		return values
+	}
+	a := NewBitmap(append(dense(0, 20000, 2), 1<<20, 3<<20)...)
+	b := NewBitmap(append(dense(10000, 30000, 3), 2<<20, 3<<20)...)
+
+	model := func(x, y []uint32, keep func(inX, inY bool) bool) []uint32 {
+		set := map[uint32]int{}
+		for _, v := range x {
+			set[v] |= 1
+		}
+		for _, v := range y {
+			set[v] |= 2
+		}
+		var result []uint32
+		for v, m := range set {
+			if keep(m&1 != 0, m&2 != 0) {
+				result = append(result, v)
+			}
+		}
+		sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+		return result
+	}
+
+	x, y := a.ToSlice(), b.ToSlice()
+	tests := []struct {
+		name   string
+		result *Bitmap
+		keep   func(inX, inY bool) bool
+	}{
+		{"And", a.And(b), func(p, q bool) bool { return p && q }},
+		{"Or", a.Or(b), func(p, q bool) bool { return p || q }},
+		{"Xor", a.Xor(b), func(p, q bool) bool { return p != q }},
+		{"AndNot", a.AndNot(b), func(p, q bool) bool { return p && !q }},
+	}
+	for _, tt := range tests {
+		t.Run("TestBitmap_Algebra_"+tt.name, func(t *testing.T) {
+			expected := model(x, y, tt.keep)
+			if got := tt.result.ToSlice(); !reflect.DeepEqual(got, expected) {
+				t.Errorf("Expected %d values, but got %d", len(expected), len(got))
+			}
+			if !tt.result.Equal(NewBitmap(expected...)) {
+				t.Errorf("Expected result to be normalized")
+			}
+		})
+	}
+
+	if !a.Xor(a).IsEmpty() || !a.And(&Bitmap{}).IsEmpty() {
+		t.Errorf("Expected empty results")
+	}
+	c := a.Clone()
+	c.Add(7)
+	if a.Contains(7) || a.Equal(c) {
+		t.Errorf("Expected clone to be independent")
+	}
+}
+
+func TestBitmap_RandomOperations(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	b := &Bitmap{}
+	model := map[uint32]bool{}
+
+	for i := 0; i < 20000; i++ {
+		// 集中在少数几个容器中，以触发数组与位图之间的转换
+		v := uint32(rnd.Intn(3))<<16 | uint32(rnd.Intn(12000))
+		if rnd.Intn(4) == 0 {
+			if b.Remove(v) != model[v] {
+				t.Fatalf("Remove(%d) disagrees with model", v)
+			}
+			delete(model, v)
+		} else {
+			if b.Add(v) == model[v] {
+				t.Fatalf("Add(%d) disagrees with model", v)
+			}
+			model[v] = true
+		}
+	}
+
+	if b.Cardinality() != len(model) {
+		t.Fatalf("Expected %v, but got %v", len(model), b.Cardinality())
+	}
+	for v := range model {
+		if !b.Contains(v) {
+			t.Fatalf("Expected %d to be present", v)
+		}
+	}
+}
+
+func TestBitmap_MarshalBinary(t *testing.T) {
+	b := NewBitmap(1, 2, 3, 1<<20)
+	for i := uint32(0); i < 10000; i++ {
+		b.Add(5<<16 | i)
+	}
+
+	data, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Expected nil, but got %v", err)
+	}
+	var decoded Bitmap
+	if err := decoded.UnmarshalBinary(data); err != nil || !decoded.Equal(b) {
+		t.Errorf("Expected round trip, but got %v", err)
+	}
+
+	empty, _ := (&Bitmap{}).MarshalBinary()
+	if err := decoded.UnmarshalBinary(empty); err != nil || !decoded.IsEmpty() {
+		t.Errorf("Expected empty round trip, but got %v", err)
+	}
+
+	for name, bad := range map[string][]byte{
+		"Empty":       nil,
+		"Truncated":   data[:len(data)-1],
+		"Trailing":    append(append([]byte(nil), data...), 0),
+		"UnknownKind": {1, 0, 0, 9},
+		"Unsorted":    {1, 0, 0, 0, 2, 5, 0, 4, 0},
+		"EmptyArray":  {1, 0, 0, 0, 0},
+	} {
+		if err := decoded.UnmarshalBinary(bad); !errors.Is(err, ErrInvalidData) {
+			t.Errorf("%s: expected %v, but got %v", name, ErrInvalidData, err)
+		}
+	}
+}
+
+func TestBitmapFromSlice(t *testing.T) {
+	b := BitmapFromSlice([]int64{3, 1, 2, 3})
+	if got := b.ToSlice(); !reflect.DeepEqual(got, []uint32{1, 2, 3}) {
+		t.Errorf("Unexpected values %v", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected out of range value to panic")
+		}
+	}()
+	BitmapFromSlice([]int64{1 << 32})
+}
+
+func BenchmarkBitmap_Add(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	bm := &Bitmap{}
+	for i := 0; i < b.N; i++ {
+		bm.Add(rnd.Uint32() % (1 << 22))
+	}
+}
@@ -0,0 +1,346 @@
+package goexbits
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/birdmichael/GoEx/constraintsext"
+)
+
+// ErrInvalidData 表示反序列化的数据格式不正确。
+var ErrInvalidData = errors.New("goexbits: invalid data")
+
+const wordSize = 64
+
+// BitSet 是一个稠密的位集合，用 []uint64 存储，容量随写入自动增长。
+//
+// 适合元素为较小非负整数且分布较密集的场景，例如权限位与特性开关。零值即为空集合。
+// BitSet 不是并发安全的。
+type BitSet struct {
+	words []uint64
+}
+
+// New 创建一个预留了 capacity 个位的 BitSet。
+func New(capacity int) *BitSet {
+	return &BitSet{words: make([]uint64, wordsFor(capacity))}
+}
+
+// FromSlice 创建一个包含 values 中所有值的 BitSet。
+//
+// 示例：
+//   - FromSlice([]int{1, 3, 5}).Test(3) 返回 true。
+func FromSlice[T constraintsext.Integer](values []T) *BitSet {
+	b := &BitSet{}
+	for _, v := range values {
+		b.Set(checkIndex(v))
+	}
+	return b
+}
+
+func wordsFor(n int) int {
+	return (n + wordSize - 1) / wordSize
+}
+
+func checkIndex[T constraintsext.Integer](i T) int {
+	if i < 0 || uint64(i) > uint64(maxIndex) {
+		panic(fmt.Sprintf("goexbits: index %d out of range", i))
+	}
+	return int(i)
+}
+
+const maxIndex = int(^uint(0) >> 1)
+
+// MARK: - Read
+
+// Test 判断第 i 位是否为 1。
+func (b *BitSet) Test(i int) bool {
+	checkIndex(i)
+	w := i / wordSize
+	return w < len(b.words) && b.words[w]&(1<<(uint(i)%wordSize)) != 0
+}
+
+// Count 返回值为 1 的位的个数。
+func (b *BitSet) Count() int {
+	n := 0
+	for _, w := range b.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// Len 返回当前的容量（位数），总是 64 的整数倍。
+func (b *BitSet) Len() int {
+	return len(b.words) * wordSize
+}
+
+// IsEmpty 判断是否没有任何位为 1。
+func (b *BitSet) IsEmpty() bool {
+	for _, w := range b.words {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// NextSet 返回大于等于 from 的第一个值为 1 的位。
+//
+// 返回值：
+//   - 位的序号。
+//   - 不存在时为 false。
+//
+// 示例：
+//
+//	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+//		fmt.Println(i)
+//	}
+func (b *BitSet) NextSet(from int) (int, bool) {
+	checkIndex(from)
+	w := from / wordSize
+	if w >= len(b.words) {
+		return 0, false
+	}
+	word := b.words[w] >> (uint(from) % wordSize)
+	if word != 0 {
+		return from + bits.TrailingZeros64(word), true
+	}
+	for w++; w < len(b.words); w++ {
+		if b.words[w] != 0 {
+			return w*wordSize + bits.TrailingZeros64(b.words[w]), true
+		}
+	}
+	return 0, false
+}
+
+// NextClear 返回大于等于 from 的第一个值为 0 的位。容量之外的位都视为 0。
+func (b *BitSet) NextClear(from int) int {
+	checkIndex(from)
+	w := from / wordSize
+	if w >= len(b.words) {
+		return from
+	}
+	word := ^b.words[w] >> (uint(from) % wordSize)
+	if word != 0 {
+		return from + bits.TrailingZeros64(word)
+	}
+	for w++; w < len(b.words); w++ {
+		if b.words[w] != ^uint64(0) {
+			return w*wordSize + bits.TrailingZeros64(^b.words[w])
+		}
+	}
+	return len(b.words) * wordSize
+}
+
+// Range 按从小到大的顺序遍历值为 1 的位，回调返回 false 时停止遍历。
+func (b *BitSet) Range(fn func(i int) bool) {
+	for w, word := range b.words {
+		for word != 0 {
+			t := bits.TrailingZeros64(word)
+			if !fn(w*wordSize + t) {
+				return
+			}
+			word &= word - 1
+		}
+	}
+}
+
+// ToSlice 返回所有值为 1 的位组成的有序切片。
+func (b *BitSet) ToSlice() []int {
+	result := make([]int, 0, b.Count())
+	b.Range(func(i int) bool {
+		result = append(result, i)
+		return true
+	})
+	return result
+}
+
+// Equal 判断两个 BitSet 是否包含相同的位，与容量无关。
+func (b *BitSet) Equal(other *BitSet) bool {
+	long, short := b.words, other.words
+	if len(long) < len(short) {
+		long, short = short, long
+	}
+	for i, w := range short {
+		if long[i] != w {
+			return false
+		}
+	}
+	for _, w := range long[len(short):] {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSubsetOf 判断 b 中的每一位是否都在 other 中。
+func (b *BitSet) IsSubsetOf(other *BitSet) bool {
+	for i, w := range b.words {
+		var o uint64
+		if i < len(other.words) {
+			o = other.words[i]
+		}
+		if w&^o != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone 返回 BitSet 的副本。
+func (b *BitSet) Clone() *BitSet {
+	return &BitSet{words: append([]uint64(nil), b.words...)}
+}
+
+// String 返回形如 {1, 3, 5} 的字符串。
+func (b *BitSet) String() string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	first := true
+	b.Range(func(i int) bool {
+		if !first {
+			sb.WriteString(", ")
+		}
+		first = false
+		sb.WriteString(strconv.Itoa(i))
+		return true
+	})
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// MARK: - Write
+
+// Set 将第 i 位设置为 1，必要时扩容。
+func (b *BitSet) Set(i int) {
+	checkIndex(i)
+	w := i / wordSize
+	b.grow(w + 1)
+	b.words[w] |= 1 << (uint(i) % wordSize)
+}
+
+// Clear 将第 i 位设置为 0。
+func (b *BitSet) Clear(i int) {
+	checkIndex(i)
+	if w := i / wordSize; w < len(b.words) {
+		b.words[w] &^= 1 << (uint(i) % wordSize)
+	}
+}
+
+// Flip 翻转第 i 位。
+func (b *BitSet) Flip(i int) {
+	checkIndex(i)
+	w := i / wordSize
+	b.grow(w + 1)
+	b.words[w] ^= 1 << (uint(i) % wordSize)
+}
+
+// SetTo 将第 i 位设置为 value。
+func (b *BitSet) SetTo(i int, value bool) {
+	if value {
+		b.Set(i)
+	} else {
+		b.Clear(i)
+	}
+}
+
+// ClearAll 将所有位设置为 0，保留容量。
+func (b *BitSet) ClearAll() {
+	clear(b.words)
+}
+
+func (b *BitSet) grow(words int) {
+	if words <= len(b.words) {
+		return
+	}
+	if words <= cap(b.words) {
+		b.words = b.words[:words]
+		return
+	}
+	grown := make([]uint64, words, max(words, 2*cap(b.words)))
+	copy(grown, b.words)
+	b.words = grown
+}
+
+// MARK: - Algebra
+
+// And 返回 b 与 other 的交集。
+func (b *BitSet) And(other *BitSet) *BitSet {
+	n := min(len(b.words), len(other.words))
+	result := &BitSet{words: make([]uint64, n)}
+	for i := 0; i < n; i++ {
+		result.words[i] = b.words[i] & other.words[i]
+	}
+	return result
+}
+
+// Or 返回 b 与 other 的并集。
+func (b *BitSet) Or(other *BitSet) *BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x | y })
+}
+
+// Xor 返回 b 与 other 的对称差。
+func (b *BitSet) Xor(other *BitSet) *BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x ^ y })
+}
+
+// AndNot 返回在 b 中但不在 other 中的位。
+func (b *BitSet) AndNot(other *BitSet) *BitSet {
+	result := b.Clone()
+	for i := 0; i < min(len(b.words), len(other.words)); i++ {
+		result.words[i] &^= other.words[i]
+	}
+	return result
+}
+
+func (b *BitSet) combine(other *BitSet, op func(x, y uint64) uint64) *BitSet {
+	n := max(len(b.words), len(other.words))
+	result := &BitSet{words: make([]uint64, n)}
+	for i := 0; i < n; i++ {
+		var x, y uint64
+		if i < len(b.words) {
+			x = b.words[i]
+		}
+		if i < len(other.words) {
+			y = other.words[i]
+		}
+		result.words[i] = op(x, y)
+	}
+	return result
+}
+
+// MARK: - Serialization
+
+// MarshalBinary 实现 encoding.BinaryMarshaler。
+//
+// 格式为 uvarint 编码的字数，随后是小端序的各个字；末尾全为 0 的字不会被写入。
+func (b *BitSet) MarshalBinary() ([]byte, error) {
+	n := len(b.words)
+	for n > 0 && b.words[n-1] == 0 {
+		n--
+	}
+	data := binary.AppendUvarint(make([]byte, 0, binary.MaxVarintLen64+8*n), uint64(n))
+	for _, w := range b.words[:n] {
+		data = binary.LittleEndian.AppendUint64(data, w)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary 实现 encoding.BinaryUnmarshaler，会覆盖 b 原有的内容。
+func (b *BitSet) UnmarshalBinary(data []byte) error {
+	n, size := binary.Uvarint(data)
+	if size <= 0 || n > uint64(len(data)) || uint64(len(data)-size) != n*8 {
+		return ErrInvalidData
+	}
+	data = data[size:]
+	words := make([]uint64, n)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[i*8:])
+	}
+	b.words = words
+	return nil
+}
@@ -0,0 +1,164 @@
+package goexbits
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBitSet_Basic(t *testing.T) {
+	var b BitSet
+	b.Set(1)
+	b.Set(64)
+	b.Set(200)
+	b.Flip(3)
+	b.Flip(1)
+
+	if !b.Test(64) || b.Test(1) || !b.Test(3) || b.Test(10000) {
+		t.Errorf("Unexpected bits %v", b.String())
+	}
+	if b.Count() != 3 || b.Len() != 256 {
+		t.Errorf("Expected 3 bits in 256, but got %d in %d", b.Count(), b.Len())
+	}
+	if got := b.ToSlice(); !reflect.DeepEqual(got, []int{3, 64, 200}) {
+		t.Errorf("Unexpected bits %v", got)
+	}
+	if b.String() != "{3, 64, 200}" {
+		t.Errorf("Unexpected string %q", b.String())
+	}
+
+	b.Clear(64)
+	b.Clear(100000)
+	b.SetTo(5, true)
+	b.SetTo(3, false)
+	if got := b.ToSlice(); !reflect.DeepEqual(got, []int{5, 200}) {
+		t.Errorf("Unexpected bits %v", got)
+	}
+
+	b.ClearAll()
+	if !b.IsEmpty() || b.Len() != 256 {
+		t.Errorf("Expected ClearAll to keep capacity")
+	}
+}
+
+func TestBitSet_Panic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected negative index to panic")
+		}
+	}()
+	New(0).Set(-1)
+}
+
+func TestBitSet_NextSet(t *testing.T) {
+	b := FromSlice([]int{0, 63, 64, 130})
+
+	var got []int
+	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+		got = append(got, i)
+	}
+	if !reflect.DeepEqual(got, []int{0, 63, 64, 130}) {
+		t.Errorf("Unexpected iteration %v", got)
+	}
+	if _, ok := b.NextSet(131); ok {
+		t.Errorf("Expected no set bit after 130")
+	}
+	if _, ok := b.NextSet(1000); ok {
+		t.Errorf("Expected no set bit beyond capacity")
+	}
+
+	tests := []struct {
+		from, expected int
+	}{
+		{0, 1},
+		{63, 65},
+		{130, 131},
+		{500, 500},
+	}
+	for _, tt := range tests {
+		if got := b.NextClear(tt.from); got != tt.expected {
+			t.Errorf("NextClear(%d): expected %v, but got %v", tt.from, tt.expected, got)
+		}
+	}
+
+	full := New(128)
+	for i := 0; i < 128; i++ {
+		full.Set(i)
+	}
+	if got := full.NextClear(0); got != 128 {
+		t.Errorf("Expected %v, but got %v", 128, got)
+	}
+}
+
+func TestBitSet_Algebra(t *testing.T) {
+	a := FromSlice([]uint8{1, 2, 3, 100})
+	b := FromSlice([]int{2, 3, 4, 200})
+
+	tests := []struct {
+		name     string
+		result   *BitSet
+		expected []int
+	}{
+		{"And", a.And(b), []int{2, 3}},
+		{"Or", a.Or(b), []int{1, 2, 3, 4, 100, 200}},
+		{"Xor", a.Xor(b), []int{1, 4, 100, 200}},
+		{"AndNot", a.AndNot(b), []int{1, 100}},
+		{"AndNotReverse", b.AndNot(a), []int{4, 200}},
+	}
+	for _, tt := range tests {
+		t.Run("TestBitSet_Algebra_"+tt.name, func(t *testing.T) {
+			if got := tt.result.ToSlice(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+
+	if !a.And(b).IsSubsetOf(a) || a.IsSubsetOf(b) {
+		t.Errorf("Unexpected subset relation")
+	}
+	if !New(1000).Equal(&BitSet{}) || !FromSlice([]int{5}).Equal(FromSlice([]int{5})) || a.Equal(b) {
+		t.Errorf("Unexpected equality")
+	}
+
+	c := a.Clone()
+	c.Set(7)
+	if a.Test(7) {
+		t.Errorf("Expected clone to be independent")
+	}
+}
+
+func TestBitSet_MarshalBinary(t *testing.T) {
+	b := FromSlice([]int{0, 64, 1000})
+	b.Set(5000)
+	b.Clear(5000)
+
+	data, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Expected nil, but got %v", err)
+	}
+	if len(data) != 1+16*8 {
+		t.Errorf("Expected trailing zero words to be trimmed, but got %d bytes", len(data))
+	}
+
+	var decoded BitSet
+	if err := decoded.UnmarshalBinary(data); err != nil || !decoded.Equal(b) {
+		t.Errorf("Expected round trip, but got %v, %v", decoded.String(), err)
+	}
+
+	for _, bad := range [][]byte{nil, {2, 0, 0}, {0xff}, append(data, 0)} {
+		if err := decoded.UnmarshalBinary(bad); !errors.Is(err, ErrInvalidData) {
+			t.Errorf("Expected %v for %v, but got %v", ErrInvalidData, bad, err)
+		}
+	}
+}
+
+func BenchmarkBitSet_Count(b *testing.B) {
+	s := New(1 << 16)
+	for i := 0; i < 1<<16; i += 3 {
+		s.Set(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Count()
+	}
+}
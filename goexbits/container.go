@@ -0,0 +1,243 @@
+package goexbits
+
+import (
+	"math/bits"
+	"slices"
+)
+
+const (
+	// arrayMaxSize 是数组容器的最大元素数，超过后转换为位图容器；两种表示此时占用的空间相同（8KB）。
+	arrayMaxSize = 4096
+	// bitmapWords 是位图容器的字数，可以表示 65536 个值。
+	bitmapWords = 1 << 16 / wordSize
+)
+
+// container 保存高 16 位相同的一组值的低 16 位。
+//
+// 元素较少时使用有序数组 array，较多时使用位图 bitmap，两者有且只有一个不为 nil。
+type container struct {
+	array  []uint16
+	bitmap []uint64
+	card   int
+}
+
+func newArrayContainer(values ...uint16) *container {
+	return &container{array: values, card: len(values)}
+}
+
+func (c *container) contains(x uint16) bool {
+	if c.bitmap != nil {
+		return c.bitmap[x/wordSize]&(1<<(x%wordSize)) != 0
+	}
+	_, ok := slices.BinarySearch(c.array, x)
+	return ok
+}
+
+func (c *container) add(x uint16) bool {
+	if c.bitmap != nil {
+		mask := uint64(1) << (x % wordSize)
+		if c.bitmap[x/wordSize]&mask != 0 {
+			return false
+		}
+		c.bitmap[x/wordSize] |= mask
+		c.card++
+		return true
+	}
+
+	i, ok := slices.BinarySearch(c.array, x)
+	if ok {
+		return false
+	}
+	c.array = slices.Insert(c.array, i, x)
+	c.card++
+	if c.card > arrayMaxSize {
+		c.toBitmap()
+	}
+	return true
+}
+
+func (c *container) remove(x uint16) bool {
+	if c.bitmap != nil {
+		mask := uint64(1) << (x % wordSize)
+		if c.bitmap[x/wordSize]&mask == 0 {
+			return false
+		}
+		c.bitmap[x/wordSize] &^= mask
+		c.card--
+		if c.card <= arrayMaxSize {
+			c.toArray()
+		}
+		return true
+	}
+
+	i, ok := slices.BinarySearch(c.array, x)
+	if !ok {
+		return false
+	}
+	c.array = slices.Delete(c.array, i, i+1)
+	c.card--
+	return true
+}
+
+func (c *container) toBitmap() {
+	c.bitmap = c.words()
+	c.array = nil
+}
+
+func (c *container) toArray() {
+	array := make([]uint16, 0, c.card)
+	c.rangeValues(func(x uint16) bool {
+		array = append(array, x)
+		return true
+	})
+	c.array = array
+	c.bitmap = nil
+}
+
+// words 返回容器的位图表示，数组容器会被转换为新的位图。
+func (c *container) words() []uint64 {
+	if c.bitmap != nil {
+		return c.bitmap
+	}
+	words := make([]uint64, bitmapWords)
+	for _, x := range c.array {
+		words[x/wordSize] |= 1 << (x % wordSize)
+	}
+	return words
+}
+
+func (c *container) rangeValues(fn func(x uint16) bool) bool {
+	if c.bitmap == nil {
+		for _, x := range c.array {
+			if !fn(x) {
+				return false
+			}
+		}
+		return true
+	}
+	for w, word := range c.bitmap {
+		for word != 0 {
+			if !fn(uint16(w*wordSize + bits.TrailingZeros64(word))) {
+				return false
+			}
+			word &= word - 1
+		}
+	}
+	return true
+}
+
+func (c *container) clone() *container {
+	return &container{array: slices.Clone(c.array), bitmap: slices.Clone(c.bitmap), card: c.card}
+}
+
+func (c *container) min() uint16 {
+	if c.bitmap == nil {
+		return c.array[0]
+	}
+	for w, word := range c.bitmap {
+		if word != 0 {
+			return uint16(w*wordSize + bits.TrailingZeros64(word))
+		}
+	}
+	return 0
+}
+
+func (c *container) max() uint16 {
+	if c.bitmap == nil {
+		return c.array[len(c.array)-1]
+	}
+	for w := len(c.bitmap) - 1; w >= 0; w-- {
+		if word := c.bitmap[w]; word != 0 {
+			return uint16(w*wordSize + wordSize - 1 - bits.LeadingZeros64(word))
+		}
+	}
+	return 0
+}
+
+// MARK: - Algebra
+
+type setOp int
+
+const (
+	opAnd setOp = iota
+	opOr
+	opXor
+	opAndNot
+)
+
+func (op setOp) apply(x, y uint64) uint64 {
+	switch op {
+	case opAnd:
+		return x & y
+	case opOr:
+		return x | y
+	case opXor:
+		return x ^ y
+	default:
+		return x &^ y
+	}
+}
+
+// combine 计算两个容器的集合运算，结果为空时返回 nil。
+func combine(a, b *container, op setOp) *container {
+	if a.bitmap == nil && b.bitmap == nil {
+		return combineArrays(a.array, b.array, op)
+	}
+
+	x, y := a.words(), b.words()
+	words := make([]uint64, bitmapWords)
+	card := 0
+	for i := range words {
+		words[i] = op.apply(x[i], y[i])
+		card += bits.OnesCount64(words[i])
+	}
+	if card == 0 {
+		return nil
+	}
+	c := &container{bitmap: words, card: card}
+	if card <= arrayMaxSize {
+		c.toArray()
+	}
+	return c
+}
+
+// combineArrays 以归并的方式计算两个有序数组的集合运算。
+func combineArrays(a, b []uint16, op setOp) *container {
+	var result []uint16
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			if op != opAnd {
+				result = append(result, a[i])
+			}
+			i++
+		case a[i] > b[j]:
+			if op == opOr || op == opXor {
+				result = append(result, b[j])
+			}
+			j++
+		default:
+			if op == opAnd || op == opOr {
+				result = append(result, a[i])
+			}
+			i++
+			j++
+		}
+	}
+	if op != opAnd {
+		result = append(result, a[i:]...)
+	}
+	if op == opOr || op == opXor {
+		result = append(result, b[j:]...)
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+	c := newArrayContainer(result...)
+	if c.card > arrayMaxSize {
+		c.toBitmap()
+	}
+	return c
+}
@@ -0,0 +1,300 @@
+package goexbus
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/birdmichael/GoEx/goexslice"
+)
+
+// SlowPolicy 决定异步订阅者的缓冲队列已满时如何处理新事件。
+type SlowPolicy int
+
+const (
+	// Block 阻塞发布方，直到订阅者的队列有空位或订阅被取消。
+	Block SlowPolicy = iota
+	// DropNewest 丢弃新事件。
+	DropNewest
+	// DropOldest 丢弃队列中最早的事件，为新事件腾出位置。
+	DropOldest
+)
+
+// String 返回策略的名称。
+func (p SlowPolicy) String() string {
+	switch p {
+	case Block:
+		return "block"
+	case DropNewest:
+		return "drop-newest"
+	case DropOldest:
+		return "drop-oldest"
+	default:
+		return "unknown"
+	}
+}
+
+// Handler 处理一个事件，topic 为事件发布时的主题。
+type Handler[T any] func(topic string, event T)
+
+// SubscribeOptions 是订阅时的配置项，所有字段均可省略。
+//
+// 参数：
+//   - T: 事件的类型。
+type SubscribeOptions[T any] struct {
+	// Async 为 true 时事件由订阅者独立的协程按顺序处理，否则在 Publish 的调用方协程中同步处理。
+	Async bool
+	// BufferSize 是异步订阅者的缓冲队列容量，小于等于 0 时为 DefaultBufferSize。
+	BufferSize int
+	// Policy 是异步订阅者的队列已满时的处理策略，默认为 Block。
+	Policy SlowPolicy
+	// Filter 过滤事件，返回 false 的事件不会投递给该订阅者。
+	Filter goexslice.Predicate[T]
+}
+
+// DefaultBufferSize 是异步订阅者默认的缓冲队列容量。
+const DefaultBufferSize = 64
+
+// Bus 是一个进程内的类型化发布/订阅事件总线，类似于 Swift 的 NotificationCenter。
+//
+// 订阅模式支持通配符，详见 Match。
+//
+// 参数：
+//   - T: 事件的类型。
+type Bus[T any] struct {
+	mu     sync.RWMutex
+	subs   []*Subscription[T]
+	closed bool
+}
+
+// New 创建一个 Bus。
+func New[T any]() *Bus[T] {
+	return &Bus[T]{}
+}
+
+// Subscription 是一次订阅的句柄。
+type Subscription[T any] struct {
+	bus     *Bus[T]
+	pattern string
+	handler Handler[T]
+	opts    SubscribeOptions[T]
+
+	queue   chan delivery[T]
+	done    chan struct{}
+	once    sync.Once
+	dropped atomic.Uint64
+}
+
+type delivery[T any] struct {
+	topic string
+	event T
+}
+
+// MARK: - Subscribe
+
+// Subscribe 以同步方式订阅匹配 pattern 的主题。
+//
+// 示例：
+//
+//	bus := New[Order]()
+//	sub := bus.Subscribe("order.*", func(topic string, o Order) { log.Println(topic, o.ID) })
+//	defer sub.Unsubscribe()
+func (b *Bus[T]) Subscribe(pattern string, handler Handler[T]) *Subscription[T] {
+	return b.SubscribeWithOptions(pattern, handler, SubscribeOptions[T]{})
+}
+
+// SubscribeWithOptions 按给定的配置订阅匹配 pattern 的主题。
+//
+// 参数：
+//   - pattern: 订阅模式，可以包含通配符。
+//   - handler: 事件处理函数。
+//   - opts: 订阅配置。
+//
+// 返回值：
+//   - 订阅句柄；Bus 已关闭时返回的句柄不会收到任何事件。
+func (b *Bus[T]) SubscribeWithOptions(pattern string, handler Handler[T], opts SubscribeOptions[T]) *Subscription[T] {
+	s := &Subscription[T]{bus: b, pattern: pattern, handler: handler, opts: opts, done: make(chan struct{})}
+	if opts.Async {
+		if opts.BufferSize <= 0 {
+			s.opts.BufferSize = DefaultBufferSize
+		}
+		s.queue = make(chan delivery[T], s.opts.BufferSize)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		s.stop()
+		return s
+	}
+	b.subs = append(b.subs, s)
+	if opts.Async {
+		go s.loop()
+	}
+	return s
+}
+
+// MARK: - Publish
+
+// Publish 向主题 topic 发布一个事件。
+//
+// 事件按订阅的先后顺序投递给所有匹配的订阅者。同步订阅者在返回前已处理完事件；
+// 异步订阅者只保证事件进入队列，其队列已满时按订阅的 SlowPolicy 处理。
+//
+// 返回值：
+//   - 接收了该事件的订阅者数量，不包括被过滤或丢弃的。
+func (b *Bus[T]) Publish(topic string, event T) int {
+	b.mu.RLock()
+	var matched []*Subscription[T]
+	for _, s := range b.subs {
+		if Match(s.pattern, topic) {
+			matched = append(matched, s)
+		}
+	}
+	b.mu.RUnlock()
+
+	delivered := 0
+	for _, s := range matched {
+		if s.deliver(topic, event) {
+			delivered++
+		}
+	}
+	return delivered
+}
+
+// HasSubscribers 判断是否有订阅者匹配主题 topic。
+func (b *Bus[T]) HasSubscribers(topic string) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, s := range b.subs {
+		if Match(s.pattern, topic) {
+			return true
+		}
+	}
+	return false
+}
+
+// Len 返回订阅者的数量。
+func (b *Bus[T]) Len() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return len(b.subs)
+}
+
+// Close 取消所有订阅，之后的 Publish 不会投递任何事件，Subscribe 返回的句柄也不会收到事件。
+func (b *Bus[T]) Close() {
+	b.mu.Lock()
+	subs := b.subs
+	b.subs = nil
+	b.closed = true
+	b.mu.Unlock()
+
+	for _, s := range subs {
+		s.stop()
+	}
+}
+
+// MARK: - Subscription
+
+// Pattern 返回订阅模式。
+func (s *Subscription[T]) Pattern() string {
+	return s.pattern
+}
+
+// Dropped 返回因队列已满被丢弃的事件数。
+func (s *Subscription[T]) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Done 返回一个在订阅被取消后关闭的通道。
+func (s *Subscription[T]) Done() <-chan struct{} {
+	return s.done
+}
+
+// Unsubscribe 取消订阅。可以多次调用，也可以在事件处理函数中调用。
+//
+// 取消后不再投递新事件，异步订阅者队列中尚未处理的事件会被丢弃。
+func (s *Subscription[T]) Unsubscribe() {
+	b := s.bus
+	b.mu.Lock()
+	for i, sub := range b.subs {
+		if sub == s {
+			b.subs = slices.Delete(b.subs, i, i+1)
+			break
+		}
+	}
+	b.mu.Unlock()
+
+	s.stop()
+}
+
+func (s *Subscription[T]) stop() {
+	s.once.Do(func() { close(s.done) })
+}
+
+func (s *Subscription[T]) stopped() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Subscription[T]) deliver(topic string, event T) bool {
+	if s.stopped() {
+		return false
+	}
+	if s.opts.Filter != nil && !s.opts.Filter(event) {
+		return false
+	}
+	if !s.opts.Async {
+		s.handler(topic, event)
+		return true
+	}
+
+	d := delivery[T]{topic: topic, event: event}
+	for {
+		select {
+		case s.queue <- d:
+			return true
+		default:
+		}
+
+		switch s.opts.Policy {
+		case DropNewest:
+			s.dropped.Add(1)
+			return false
+		case DropOldest:
+			select {
+			case <-s.queue:
+				s.dropped.Add(1)
+			default:
+			}
+		default:
+			select {
+			case s.queue <- d:
+				return true
+			case <-s.done:
+				return false
+			}
+		}
+	}
+}
+
+func (s *Subscription[T]) loop() {
+	for {
+		select {
+		case d := <-s.queue:
+			if s.stopped() {
+				return
+			}
+			s.handler(d.topic, d.event)
+		case <-s.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package goexbus
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *recorder) handle(prefix string) Handler[int] {
+	return func(topic string, event int) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.events = append(r.events, prefix+":"+topic)
+	}
+}
+
+func (r *recorder) get() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.events...)
+}
+
+func TestBus_Publish(t *testing.T) {
+	bus := New[int]()
+	rec := &recorder{}
+	bus.Subscribe("order.created", rec.handle("exact"))
+	bus.Subscribe("order.*", rec.handle("one"))
+	bus.Subscribe("#", rec.handle("all"))
+
+	if got := bus.Publish("order.created", 1); got != 3 {
+		t.Errorf("Expected %v, but got %v", 3, got)
+	}
+	if got := bus.Publish("user.created", 2); got != 1 {
+		t.Errorf("Expected %v, but got %v", 1, got)
+	}
+
+	expected := []string{"exact:order.created", "one:order.created", "all:order.created", "all:user.created"}
+	if got := rec.get(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+	if !bus.HasSubscribers("order.deleted") || bus.Len() != 3 {
+		t.Errorf("Expected wildcard subscribers to be counted")
+	}
+}
+
+func TestBus_Filter(t *testing.T) {
+	bus := New[int]()
+	var got []int
+	bus.SubscribeWithOptions("n", func(topic string, n int) { got = append(got, n) }, SubscribeOptions[int]{
+		Filter: func(n int) bool { return n%2 == 0 },
+	})
+
+	for i := 1; i <= 5; i++ {
+		bus.Publish("n", i)
+	}
+	if !reflect.DeepEqual(got, []int{2, 4}) {
+		t.Errorf("Expected %v, but got %v", []int{2, 4}, got)
+	}
+}
+
+func TestBus_Unsubscribe(t *testing.T) {
+	bus := New[int]()
+	count := 0
+	var sub *Subscription[int]
+	sub = bus.Subscribe("t", func(topic string, n int) {
+		count++
+		// 在处理函数中取消订阅不会死锁
+		sub.Unsubscribe()
+	})
+
+	bus.Publish("t", 1)
+	bus.Publish("t", 2)
+	sub.Unsubscribe()
+	if count != 1 || bus.Len() != 0 {
+		t.Errorf("Expected one delivery, but got %d", count)
+	}
+	select {
+	case <-sub.Done():
+	default:
+		t.Errorf("Expected Done to be closed")
+	}
+}
+
+func TestBus_Async(t *testing.T) {
+	bus := New[int]()
+	got := make(chan int, 10)
+	bus.SubscribeWithOptions("t", func(topic string, n int) { got <- n }, SubscribeOptions[int]{Async: true})
+
+	for i := 0; i < 5; i++ {
+		bus.Publish("t", i)
+	}
+	for i := 0; i < 5; i++ {
+		select {
+		case n := <-got:
+			if n != i {
+				t.Errorf("Expected %v, but got %v", i, n)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Timed out waiting for event %d", i)
+		}
+	}
+}
+
+func TestBus_SlowPolicy(t *testing.T) {
+	// setup 创建一个容量为 2 的异步订阅者，它在处理第一个事件时阻塞到 release 被关闭
+	setup := func(policy SlowPolicy) (*Bus[int], *Subscription[int], chan struct{}, chan int) {
+		bus := New[int]()
+		started := make(chan struct{})
+		release := make(chan struct{})
+		got := make(chan int, 10)
+		sub := bus.SubscribeWithOptions("t", func(topic string, n int) {
+			if n == 0 {
+				close(started)
+				<-release
+			}
+			got <- n
+		}, SubscribeOptions[int]{Async: true, BufferSize: 2, Policy: policy})
+
+		bus.Publish("t", 0)
+		<-started
+		return bus, sub, release, got
+	}
+	collect := func(got chan int, n int) []int {
+		var result []int
+		for i := 0; i < n; i++ {
+			result = append(result, <-got)
+		}
+		return result
+	}
+
+	t.Run("TestBus_SlowPolicy_DropNewest", func(t *testing.T) {
+		bus, sub, release, got := setup(DropNewest)
+		delivered := 0
+		for i := 1; i <= 4; i++ {
+			delivered += bus.Publish("t", i)
+		}
+		close(release)
+
+		if result := collect(got, 3); !reflect.DeepEqual(result, []int{0, 1, 2}) {
+			t.Errorf("Expected %v, but got %v", []int{0, 1, 2}, result)
+		}
+		if delivered != 2 || sub.Dropped() != 2 {
+			t.Errorf("Expected 2 delivered and 2 dropped, but got %d and %d", delivered, sub.Dropped())
+		}
+	})
+
+	t.Run("TestBus_SlowPolicy_DropOldest", func(t *testing.T) {
+		bus, sub, release, got := setup(DropOldest)
+		for i := 1; i <= 4; i++ {
+			bus.Publish("t", i)
+		}
+		close(release)
+
+		if result := collect(got, 3); !reflect.DeepEqual(result, []int{0, 3, 4}) {
+			t.Errorf("Expected %v, but got %v", []int{0, 3, 4}, result)
+		}
+		if sub.Dropped() != 2 {
+			t.Errorf("Expected %v, but got %v", 2, sub.Dropped())
+		}
+	})
+
+	t.Run("TestBus_SlowPolicy_Block", func(t *testing.T) {
+		bus, sub, release, got := setup(Block)
+		bus.Publish("t", 1)
+		bus.Publish("t", 2)
+
+		published := make(chan int, 1)
+		go func() { published <- bus.Publish("t", 3) }()
+		select {
+		case <-published:
+			t.Fatalf("Expected Publish to block while the queue is full")
+		case <-time.After(10 * time.Millisecond):
+		}
+		close(release)
+
+		if n := <-published; n != 1 {
+			t.Errorf("Expected %v, but got %v", 1, n)
+		}
+		if result := collect(got, 4); !reflect.DeepEqual(result, []int{0, 1, 2, 3}) {
+			t.Errorf("Expected %v, but got %v", []int{0, 1, 2, 3}, result)
+		}
+		sub.Unsubscribe()
+	})
+
+	t.Run("TestBus_SlowPolicy_BlockUnsubscribe", func(t *testing.T) {
+		bus, sub, release, _ := setup(Block)
+		defer close(release)
+		bus.Publish("t", 1)
+		bus.Publish("t", 2)
+
+		published := make(chan int, 1)
+		go func() { published <- bus.Publish("t", 3) }()
+		time.Sleep(10 * time.Millisecond)
+		sub.Unsubscribe()
+		if n := <-published; n != 0 {
+			t.Errorf("Expected %v, but got %v", 0, n)
+		}
+	})
+}
+
+func TestBus_Close(t *testing.T) {
+	bus := New[int]()
+	rec := &recorder{}
+	sub := bus.Subscribe("t", rec.handle("a"))
+	bus.Close()
+
+	late := bus.Subscribe("t", rec.handle("b"))
+	if got := bus.Publish("t", 1); got != 0 {
+		t.Errorf("Expected %v, but got %v", 0, got)
+	}
+	if len(rec.get()) != 0 || bus.Len() != 0 {
+		t.Errorf("Expected no deliveries after Close")
+	}
+	for _, s := range []*Subscription[int]{sub, late} {
+		select {
+		case <-s.Done():
+		default:
+			t.Errorf("Expected subscription %q to be done", s.Pattern())
+		}
+	}
+}
+
+func TestSlowPolicy_String(t *testing.T) {
+	tests := []struct {
+		policy   SlowPolicy
+		expected string
+	}{
+		{Block, "block"},
+		{DropNewest, "drop-newest"},
+		{DropOldest, "drop-oldest"},
+		{SlowPolicy(9), "unknown"},
+	}
+	for _, tt := range tests {
+		if got := tt.policy.String(); got != tt.expected {
+			t.Errorf("Expected %v, but got %v", tt.expected, got)
+		}
+	}
+}
+
+func BenchmarkBus_Publish(b *testing.B) {
+	bus := New[int]()
+	for _, p := range []string{"a.b.c", "a.*.c", "a.#", "x.y"} {
+		bus.Subscribe(p, func(topic string, n int) {})
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bus.Publish("a.b.c", i)
+	}
+}
@@ -0,0 +1,72 @@
+package goexbus
+
+import (
+	"strings"
+)
+
+const (
+	// Separator 分隔主题中的各个层级，例如 "order.created.eu"。
+	Separator = "."
+	// WildcardOne 在订阅模式中匹配恰好一个层级。
+	WildcardOne = "*"
+	// WildcardAll 在订阅模式中匹配零个或多个层级。
+	WildcardAll = "#"
+)
+
+// Match 判断主题 topic 是否匹配订阅模式 pattern。
+//
+// 模式按 Separator 分为多个层级，WildcardOne 匹配恰好一个层级，WildcardAll 匹配零个或多个层级，
+// 其余层级必须与主题完全相同。
+//
+// 示例：
+//   - Match("order.*", "order.created") 返回 true。
+//   - Match("order.*", "order.created.eu") 返回 false。
+//   - Match("order.#", "order") 返回 true。
+//   - Match("*.created", "user.created") 返回 true。
+func Match(pattern, topic string) bool {
+	if pattern == topic {
+		return true
+	}
+	if !IsWildcard(pattern) {
+		return false
+	}
+	return matchSegments(strings.Split(pattern, Separator), strings.Split(topic, Separator))
+}
+
+// IsWildcard 判断订阅模式中是否包含通配符。
+func IsWildcard(pattern string) bool {
+	for _, seg := range strings.Split(pattern, Separator) {
+		if seg == WildcardOne || seg == WildcardAll {
+			return true
+		}
+	}
+	return false
+}
+
+func matchSegments(pattern, topic []string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case WildcardAll:
+			pattern = pattern[1:]
+			if len(pattern) == 0 {
+				return true
+			}
+			for i := 0; i <= len(topic); i++ {
+				if matchSegments(pattern, topic[i:]) {
+					return true
+				}
+			}
+			return false
+		case WildcardOne:
+			if len(topic) == 0 {
+				return false
+			}
+		default:
+			if len(topic) == 0 || pattern[0] != topic[0] {
+				return false
+			}
+		}
+		pattern, topic = pattern[1:], topic[1:]
+	}
+	return len(topic) == 0
+}
@@ -0,0 +1,55 @@
+package goexbus
+
+import (
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		topic    string
+		expected bool
+	}{
+		{"Exact", "order.created", "order.created", true},
+		{"ExactMismatch", "order.created", "order.deleted", false},
+		{"One", "order.*", "order.created", true},
+		{"OneTooDeep", "order.*", "order.created.eu", false},
+		{"OneTooShallow", "order.*", "order", false},
+		{"OneLeading", "*.created", "user.created", true},
+		{"AllZero", "order.#", "order", true},
+		{"AllMany", "order.#", "order.created.eu", true},
+		{"AllMiddle", "order.#.eu", "order.created.paid.eu", true},
+		{"AllMiddleZero", "order.#.eu", "order.eu", true},
+		{"AllMiddleMismatch", "order.#.eu", "order.created.us", false},
+		{"AllOnly", "#", "anything.at.all", true},
+		{"Mixed", "*.#.eu", "order.eu", true},
+		{"MixedTooShort", "*.#.eu", "eu", false},
+		{"PrefixIsNotSegment", "order*", "orders", false},
+	}
+
+	for _, tt := range tests {
+		t.Run("TestMatch_"+tt.name, func(t *testing.T) {
+			if got := Match(tt.pattern, tt.topic); got != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsWildcard(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		expected bool
+	}{
+		{"order.created", false},
+		{"order.*", true},
+		{"#", true},
+		{"order*", false},
+	}
+	for _, tt := range tests {
+		if got := IsWildcard(tt.pattern); got != tt.expected {
+			t.Errorf("Expected %v, but got %v", tt.expected, got)
+		}
+	}
+}
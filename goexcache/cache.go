@@ -0,0 +1,424 @@
+package goexcache
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+)
+
+// EvictReason 表示条目离开缓存的原因。
+type EvictReason int
+
+const (
+	// EvictCapacity 表示条目因缓存容量不足被淘汰。
+	EvictCapacity EvictReason = iota
+	// EvictExpired 表示条目因过期被移除。
+	EvictExpired
+	// EvictDeleted 表示条目被 Delete 或 Clear 显式删除。
+	EvictDeleted
+)
+
+// String 返回淘汰原因的名称。
+func (r EvictReason) String() string {
+	switch r {
+	case EvictCapacity:
+		return "capacity"
+	case EvictExpired:
+		return "expired"
+	case EvictDeleted:
+		return "deleted"
+	default:
+		return "unknown"
+	}
+}
+
+// Options 是创建 Cache 时的配置项，所有字段均可省略。
+//
+// 参数：
+//   - K: key 的类型。
+//   - V: value 的类型。
+type Options[K comparable, V any] struct {
+	// Policy 是容量不足时的淘汰策略，默认为 LRU。
+	Policy Policy
+	// MaxWeight 是缓存允许的最大总权重，小于等于 0 表示不限制。
+	MaxWeight int64
+	// Weigher 计算单个条目的权重，为 nil 时每个条目的权重为 1，此时 MaxWeight 即为最大条目数。
+	Weigher func(key K, value V) int64
+	// TTL 是条目默认的存活时间，小于等于 0 表示永不过期。
+	TTL time.Duration
+	// OnEvict 在条目离开缓存后被调用，调用时不持有缓存的锁。
+	OnEvict func(key K, value V, reason EvictReason)
+	// Clock 用于获取当前时间，为 nil 时使用真实时钟。
+	Clock goexclock.Clock
+}
+
+// Stats 是缓存的统计数据快照。
+type Stats struct {
+	Hits        uint64 // 命中次数
+	Misses      uint64 // 未命中次数
+	Evictions   uint64 // 因容量不足淘汰的条目数
+	Expirations uint64 // 因过期移除的条目数
+	Loads       uint64 // GetOrLoad 实际调用加载函数的次数
+	LoadErrors  uint64 // 加载函数返回错误的次数
+}
+
+// HitRate 返回命中率，没有任何访问时返回 0。
+func (s Stats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// Cache 是一个并发安全的泛型缓存，支持 LRU、LFU、TTL 淘汰策略与按权重限制容量。
+//
+// 参数：
+//   - K: key 的类型。
+//   - V: value 的类型。
+type Cache[K comparable, V any] struct {
+	mu      sync.Mutex
+	opts    Options[K, V]
+	clock   goexclock.Clock
+	entries map[K]*entry[K, V]
+	order   evictionList[K, V]
+	weight  int64
+	seq     uint64
+
+	loadMu sync.Mutex
+	loads  map[K]*call[V]
+
+	hits, misses, evictions, expirations, loadCount, loadErrors atomic.Uint64
+}
+
+type call[V any] struct {
+	wg    sync.WaitGroup
+	value V
+	err   error
+}
+
+type evicted[K comparable, V any] struct {
+	key    K
+	value  V
+	reason EvictReason
+}
+
+// New 创建一个 Cache。
+//
+// 参数：
+//   - opts: 缓存配置。
+//
+// 返回值：
+//   - 新的 Cache。
+//
+// 示例：
+//   - New(Options[string, int]{MaxWeight: 100}) 创建一个最多保存 100 个条目的 LRU 缓存。
+func New[K comparable, V any](opts Options[K, V]) *Cache[K, V] {
+	return &Cache[K, V]{
+		opts:    opts,
+		clock:   goexclock.OrReal(opts.Clock),
+		entries: make(map[K]*entry[K, V]),
+		order:   newEvictionList[K, V](opts.Policy),
+		loads:   make(map[K]*call[V]),
+	}
+}
+
+// MARK: - Read
+
+// Get 返回 key 对应的值，并更新该条目的访问记录。
+//
+// 返回值：
+//   - value: key 对应的值。
+//   - ok: key 存在且未过期时返回 true。
+func (c *Cache[K, V]) Get(key K) (value V, ok bool) {
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	var out []evicted[K, V]
+	if ok && e.expired(c.clock.Now()) {
+		out = append(out, c.removeLocked(e, EvictExpired))
+		ok = false
+	}
+	if ok {
+		c.touchLocked(e)
+		value = e.value
+	}
+	c.mu.Unlock()
+
+	c.notify(out)
+	if ok {
+		c.hits.Add(1)
+	} else {
+		c.misses.Add(1)
+	}
+	return value, ok
+}
+
+// Peek 返回 key 对应的值，但不更新访问记录与统计数据。
+func (c *Cache[K, V]) Peek(key K) (value V, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || e.expired(c.clock.Now()) {
+		return value, false
+	}
+	return e.value, true
+}
+
+// Len 返回缓存中的条目数，其中可能包含已过期但尚未清理的条目。
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+// Weight 返回缓存中所有条目的总权重。
+func (c *Cache[K, V]) Weight() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.weight
+}
+
+// Keys 返回所有未过期条目的 key，顺序不确定。
+func (c *Cache[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock.Now()
+	keys := make([]K, 0, len(c.entries))
+	for k, e := range c.entries {
+		if !e.expired(now) {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// Stats 返回当前统计数据的快照。
+func (c *Cache[K, V]) Stats() Stats {
+	return Stats{
+		Hits:        c.hits.Load(),
+		Misses:      c.misses.Load(),
+		Evictions:   c.evictions.Load(),
+		Expirations: c.expirations.Load(),
+		Loads:       c.loadCount.Load(),
+		LoadErrors:  c.loadErrors.Load(),
+	}
+}
+
+// MARK: - Write
+
+// Set 使用默认的 TTL 设置 key 对应的值。
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.SetWithTTL(key, value, c.opts.TTL)
+}
+
+// SetWithTTL 设置 key 对应的值，并指定该条目的存活时间。
+//
+// 参数：
+//   - key: 要设置的 key。
+//   - value: 新的值。
+//   - ttl: 存活时间，小于等于 0 表示永不过期。
+func (c *Cache[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	weight := int64(1)
+	if c.opts.Weigher != nil {
+		weight = c.opts.Weigher(key, value)
+	}
+
+	c.mu.Lock()
+	now := c.clock.Now()
+	var expireAt time.Time
+	if ttl > 0 {
+		expireAt = now.Add(ttl)
+	}
+
+	e, ok := c.entries[key]
+	if ok {
+		c.weight += weight - e.weight
+		e.value, e.weight, e.expireAt = value, weight, expireAt
+		c.touchLocked(e)
+	} else {
+		c.seq++
+		e = &entry[K, V]{key: key, value: value, weight: weight, expireAt: expireAt, freq: 1, seq: c.seq}
+		c.entries[key] = e
+		c.order.push(e)
+		c.weight += weight
+	}
+	out := c.shrinkLocked(now, e)
+	c.mu.Unlock()
+
+	c.notify(out)
+}
+
+// Delete 删除 key。
+//
+// 返回值：
+//   - 如果 key 存在，返回 true。
+func (c *Cache[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	var out []evicted[K, V]
+	if ok {
+		out = append(out, c.removeLocked(e, EvictDeleted))
+	}
+	c.mu.Unlock()
+
+	c.notify(out)
+	return ok
+}
+
+// Clear 删除所有条目。
+func (c *Cache[K, V]) Clear() {
+	c.mu.Lock()
+	out := make([]evicted[K, V], 0, len(c.entries))
+	for _, e := range c.entries {
+		out = append(out, c.removeLocked(e, EvictDeleted))
+	}
+	c.mu.Unlock()
+
+	c.notify(out)
+}
+
+// PurgeExpired 移除所有已过期的条目。
+//
+// 返回值：
+//   - 被移除的条目数。
+func (c *Cache[K, V]) PurgeExpired() int {
+	c.mu.Lock()
+	now := c.clock.Now()
+	var out []evicted[K, V]
+	for _, e := range c.entries {
+		if e.expired(now) {
+			out = append(out, c.removeLocked(e, EvictExpired))
+		}
+	}
+	c.mu.Unlock()
+
+	c.notify(out)
+	return len(out)
+}
+
+// MARK: - Load
+
+// GetOrLoad 返回 key 对应的值，不存在时调用 loader 加载并写入缓存。
+//
+// 同一时间对同一个 key 的并发调用只会执行一次 loader，其余调用等待并共享其结果。
+// loader 返回错误时结果不会被缓存；loader 发生 panic 时会被转换为错误返回给所有等待者。
+//
+// 参数：
+//   - key: 要获取的 key。
+//   - loader: 加载 key 对应值的函数。
+//
+// 返回值：
+//   - value: key 对应的值。
+//   - err: loader 返回的错误。
+func (c *Cache[K, V]) GetOrLoad(key K, loader func(key K) (V, error)) (value V, err error) {
+	if v, ok := c.Get(key); ok {
+		return v, nil
+	}
+
+	c.loadMu.Lock()
+	if cl, ok := c.loads[key]; ok {
+		c.loadMu.Unlock()
+		cl.wg.Wait()
+		return cl.value, cl.err
+	}
+	// 加载结果在登记的调用移除之前写入缓存，这里再检查一次以免重复加载
+	if v, ok := c.Peek(key); ok {
+		c.loadMu.Unlock()
+		return v, nil
+	}
+	cl := &call[V]{}
+	cl.wg.Add(1)
+	c.loads[key] = cl
+	c.loadMu.Unlock()
+
+	c.doLoad(key, cl, loader)
+	return cl.value, cl.err
+}
+
+func (c *Cache[K, V]) doLoad(key K, cl *call[V], loader func(key K) (V, error)) {
+	defer func() {
+		if r := recover(); r != nil {
+			cl.err = fmt.Errorf("goexcache: loader panicked: %v", r)
+		}
+		if cl.err != nil {
+			c.loadErrors.Add(1)
+		} else {
+			c.Set(key, cl.value)
+		}
+
+		c.loadMu.Lock()
+		delete(c.loads, key)
+		c.loadMu.Unlock()
+		cl.wg.Done()
+	}()
+
+	c.loadCount.Add(1)
+	cl.value, cl.err = loader(key)
+}
+
+// MARK: - Internal
+
+func (c *Cache[K, V]) touchLocked(e *entry[K, V]) {
+	c.seq++
+	e.freq++
+	e.seq = c.seq
+	c.order.touch(e)
+}
+
+func (c *Cache[K, V]) removeLocked(e *entry[K, V], reason EvictReason) evicted[K, V] {
+	c.order.remove(e)
+	delete(c.entries, e.key)
+	c.weight -= e.weight
+
+	switch reason {
+	case EvictCapacity:
+		c.evictions.Add(1)
+	case EvictExpired:
+		c.expirations.Add(1)
+	}
+	return evicted[K, V]{key: e.key, value: e.value, reason: reason}
+}
+
+// shrinkLocked 按淘汰策略移除条目，直到总权重不超过 MaxWeight。
+//
+// 刚写入的条目 keep 最后才会被淘汰，避免 LFU 等策略立即淘汰新条目。
+func (c *Cache[K, V]) shrinkLocked(now time.Time, keep *entry[K, V]) []evicted[K, V] {
+	if c.opts.MaxWeight <= 0 || c.weight <= c.opts.MaxWeight {
+		return nil
+	}
+
+	var out []evicted[K, V]
+	c.order.remove(keep)
+	for c.weight > c.opts.MaxWeight {
+		e := c.order.victim()
+		if e == nil {
+			break
+		}
+		reason := EvictCapacity
+		if e.expired(now) {
+			reason = EvictExpired
+		}
+		out = append(out, c.removeLocked(e, reason))
+	}
+	c.order.push(keep)
+	if c.weight > c.opts.MaxWeight {
+		out = append(out, c.removeLocked(keep, EvictCapacity))
+	}
+	return out
+}
+
+func (c *Cache[K, V]) notify(out []evicted[K, V]) {
+	if c.opts.OnEvict == nil {
+		return
+	}
+	for _, e := range out {
+		c.opts.OnEvict(e.key, e.value, e.reason)
+	}
+}
@@ -0,0 +1,200 @@
+package goexcache
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+)
+
+func sortedKeys(c *Cache[string, int]) []string {
+	keys := c.Keys()
+	sort.Strings(keys)
+	return keys
+}
+
+func TestCacheLRU(t *testing.T) {
+	c := New(Options[string, int]{MaxWeight: 2})
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")
+	c.Set("c", 3)
+
+	expected := []string{"a", "c"}
+	if result := sortedKeys(c); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestCacheLFU(t *testing.T) {
+	c := New(Options[string, int]{Policy: LFU, MaxWeight: 2})
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Set("c", 3)
+
+	expected := []string{"a", "c"}
+	if result := sortedKeys(c); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	clock := goexclock.NewFake(time.Time{})
+
+	t.Run("TestCacheTTL_Expire", func(t *testing.T) {
+		var reasons []EvictReason
+		c := New(Options[string, int]{
+			TTL:   time.Minute,
+			Clock: clock,
+			OnEvict: func(key string, value int, reason EvictReason) {
+				reasons = append(reasons, reason)
+			},
+		})
+		c.Set("a", 1)
+		c.SetWithTTL("b", 2, 0)
+		clock.Advance(time.Minute)
+
+		if _, ok := c.Get("a"); ok {
+			t.Errorf("Expected a to be expired")
+		}
+		if v, ok := c.Get("b"); !ok || v != 2 {
+			t.Errorf("Expected b to survive, but got %v and %v", v, ok)
+		}
+		if !reflect.DeepEqual(reasons, []EvictReason{EvictExpired}) {
+			t.Errorf("Expected one expired eviction, but got %v", reasons)
+		}
+	})
+
+	t.Run("TestCacheTTL_EvictSoonestExpiring", func(t *testing.T) {
+		c := New(Options[string, int]{Policy: TTL, MaxWeight: 2, Clock: clock})
+		c.SetWithTTL("a", 1, time.Hour)
+		c.SetWithTTL("b", 2, time.Minute)
+		c.SetWithTTL("c", 3, 0)
+
+		expected := []string{"a", "c"}
+		if result := sortedKeys(c); !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, but got %v", expected, result)
+		}
+	})
+}
+
+func TestCacheWeight(t *testing.T) {
+	var evicted []string
+	c := New(Options[string, string]{
+		MaxWeight: 10,
+		Weigher:   func(key string, value string) int64 { return int64(len(value)) },
+		OnEvict: func(key string, value string, reason EvictReason) {
+			if reason == EvictCapacity {
+				evicted = append(evicted, key)
+			}
+		},
+	})
+	c.Set("a", "12345")
+	c.Set("b", "1234")
+	c.Set("c", "123")
+
+	if c.Weight() != 7 {
+		t.Errorf("Expected weight 7, but got %d", c.Weight())
+	}
+	if !reflect.DeepEqual(evicted, []string{"a"}) {
+		t.Errorf("Expected a to be evicted, but got %v", evicted)
+	}
+	if c.Stats().Evictions != 1 {
+		t.Errorf("Expected 1 eviction, but got %d", c.Stats().Evictions)
+	}
+}
+
+func TestCacheGetOrLoad(t *testing.T) {
+	t.Run("TestCacheGetOrLoad_Singleflight", func(t *testing.T) {
+		c := New(Options[string, int]{})
+		var calls atomic.Int32
+		release := make(chan struct{})
+		loader := func(key string) (int, error) {
+			calls.Add(1)
+			<-release
+			return 42, nil
+		}
+
+		var wg sync.WaitGroup
+		results := make([]int, 8)
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i], _ = c.GetOrLoad("answer", loader)
+			}(i)
+		}
+		for c.Stats().Misses < 8 {
+			time.Sleep(time.Millisecond)
+		}
+		close(release)
+		wg.Wait()
+
+		if calls.Load() != 1 {
+			t.Errorf("Expected loader to run once, but ran %d times", calls.Load())
+		}
+		for _, r := range results {
+			if r != 42 {
+				t.Errorf("Expected 42, but got %d", r)
+			}
+		}
+		if v, ok := c.Get("answer"); !ok || v != 42 {
+			t.Errorf("Expected loaded value to be cached")
+		}
+	})
+
+	t.Run("TestCacheGetOrLoad_Error", func(t *testing.T) {
+		c := New(Options[string, int]{})
+		errLoad := errors.New("load failed")
+		_, err := c.GetOrLoad("a", func(string) (int, error) { return 0, errLoad })
+		if !errors.Is(err, errLoad) {
+			t.Errorf("Expected %v, but got %v", errLoad, err)
+		}
+		if c.Len() != 0 || c.Stats().LoadErrors != 1 {
+			t.Errorf("Expected failed load not to be cached")
+		}
+	})
+
+	t.Run("TestCacheGetOrLoad_Panic", func(t *testing.T) {
+		c := New(Options[string, int]{})
+		_, err := c.GetOrLoad("a", func(string) (int, error) { panic("boom") })
+		if err == nil {
+			t.Errorf("Expected panic to be converted to error")
+		}
+	})
+}
+
+func TestCacheStats(t *testing.T) {
+	c := New(Options[string, int]{})
+	c.Set("a", 1)
+	c.Get("a")
+	c.Get("b")
+
+	stats := c.Stats()
+	if stats.Hits != 1 || stats.Misses != 1 || stats.HitRate() != 0.5 {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+}
+
+func BenchmarkCache_SetGet(b *testing.B) {
+	c := New(Options[int, int]{MaxWeight: 1024})
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%4 == 0 {
+				c.Set(i%2048, i)
+			} else {
+				c.Get(i % 2048)
+			}
+			i++
+		}
+	})
+}
@@ -0,0 +1,165 @@
+package goexcache
+
+import (
+	"container/heap"
+	"time"
+)
+
+// Policy 表示缓存容量不足时选择淘汰条目的策略。
+type Policy int
+
+const (
+	// LRU 淘汰最久未被访问的条目。
+	LRU Policy = iota
+	// LFU 淘汰访问次数最少的条目，访问次数相同时淘汰最久未被访问的条目。
+	LFU
+	// TTL 淘汰最早过期的条目，没有过期时间的条目最后淘汰。
+	TTL
+)
+
+// String 返回策略的名称。
+func (p Policy) String() string {
+	switch p {
+	case LRU:
+		return "LRU"
+	case LFU:
+		return "LFU"
+	case TTL:
+		return "TTL"
+	default:
+		return "Policy(?)"
+	}
+}
+
+type entry[K comparable, V any] struct {
+	key      K
+	value    V
+	weight   int64
+	expireAt time.Time
+	freq     uint64
+	seq      uint64
+
+	// LRU 链表指针
+	prev, next *entry[K, V]
+	// 堆中的位置
+	index int
+}
+
+func (e *entry[K, V]) expired(now time.Time) bool {
+	return !e.expireAt.IsZero() && !now.Before(e.expireAt)
+}
+
+// evictionList 维护条目的淘汰顺序。
+type evictionList[K comparable, V any] interface {
+	push(e *entry[K, V])
+	touch(e *entry[K, V])
+	remove(e *entry[K, V])
+	victim() *entry[K, V]
+}
+
+func newEvictionList[K comparable, V any](p Policy) evictionList[K, V] {
+	switch p {
+	case LFU:
+		return &heapList[K, V]{less: func(a, b *entry[K, V]) bool {
+			if a.freq != b.freq {
+				return a.freq < b.freq
+			}
+			return a.seq < b.seq
+		}}
+	case TTL:
+		return &heapList[K, V]{less: func(a, b *entry[K, V]) bool {
+			switch {
+			case a.expireAt.IsZero() && b.expireAt.IsZero():
+				return a.seq < b.seq
+			case a.expireAt.IsZero():
+				return false
+			case b.expireAt.IsZero():
+				return true
+			case !a.expireAt.Equal(b.expireAt):
+				return a.expireAt.Before(b.expireAt)
+			default:
+				return a.seq < b.seq
+			}
+		}}
+	default:
+		l := &lruList[K, V]{}
+		l.root.next = &l.root
+		l.root.prev = &l.root
+		return l
+	}
+}
+
+// MARK: - LRU
+
+// lruList 是一个以 root 为哨兵的侵入式双向链表，表头为最近访问的条目。
+type lruList[K comparable, V any] struct {
+	root entry[K, V]
+}
+
+func (l *lruList[K, V]) push(e *entry[K, V]) {
+	e.prev = &l.root
+	e.next = l.root.next
+	l.root.next.prev = e
+	l.root.next = e
+}
+
+func (l *lruList[K, V]) touch(e *entry[K, V]) {
+	l.remove(e)
+	l.push(e)
+}
+
+func (l *lruList[K, V]) remove(e *entry[K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.prev, e.next = nil, nil
+}
+
+func (l *lruList[K, V]) victim() *entry[K, V] {
+	if l.root.prev == &l.root {
+		return nil
+	}
+	return l.root.prev
+}
+
+// MARK: - Heap
+
+// heapList 以最小堆维护条目，堆顶即为下一个被淘汰的条目。
+type heapList[K comparable, V any] struct {
+	items []*entry[K, V]
+	less  func(a, b *entry[K, V]) bool
+}
+
+func (h *heapList[K, V]) Len() int           { return len(h.items) }
+func (h *heapList[K, V]) Less(i, j int) bool { return h.less(h.items[i], h.items[j]) }
+
+func (h *heapList[K, V]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+func (h *heapList[K, V]) Push(x any) {
+	e := x.(*entry[K, V])
+	e.index = len(h.items)
+	h.items = append(h.items, e)
+}
+
+func (h *heapList[K, V]) Pop() any {
+	n := len(h.items)
+	e := h.items[n-1]
+	h.items[n-1] = nil
+	h.items = h.items[:n-1]
+	e.index = -1
+	return e
+}
+
+func (h *heapList[K, V]) push(e *entry[K, V])   { heap.Push(h, e) }
+func (h *heapList[K, V]) touch(e *entry[K, V])  { heap.Fix(h, e.index) }
+func (h *heapList[K, V]) remove(e *entry[K, V]) { heap.Remove(h, e.index) }
+
+func (h *heapList[K, V]) victim() *entry[K, V] {
+	if len(h.items) == 0 {
+		return nil
+	}
+	return h.items[0]
+}
@@ -0,0 +1,233 @@
+package goexchan
+
+import (
+	"context"
+	"sync"
+
+	"github.com/birdmichael/GoEx/goexslice"
+)
+
+// MARK: - Source
+
+// FromSlice 返回一个依次发送 slice 中元素的通道，发送完毕或 ctx 取消后关闭。
+//
+// 参数：
+//   - ctx: 用于取消发送的上下文。
+//   - slice: 要发送的元素。
+//
+// 返回值：
+//   - 只读通道。
+//
+// 示例：
+//   - ToSlice(ctx, FromSlice(ctx, []int{1, 2, 3})) 返回 []int{1, 2, 3}。
+func FromSlice[S ~[]E, E any](ctx context.Context, slice S) <-chan E {
+	out := make(chan E)
+	go func() {
+		defer close(out)
+		for _, v := range slice {
+			if !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// ToSlice 接收通道中的所有元素直到通道关闭或 ctx 取消。
+//
+// 返回值：
+//   - 按接收顺序排列的元素切片。
+func ToSlice[T any](ctx context.Context, in <-chan T) []T {
+	var result []T
+	for v := range OrDone(ctx, in) {
+		result = append(result, v)
+	}
+	return result
+}
+
+// MARK: - Control
+
+// OrDone 转发 in 中的元素，在 in 关闭或 ctx 取消时关闭返回的通道。
+//
+// 用于在 for range 中读取一个可能永不关闭的通道。
+func OrDone[T any](ctx context.Context, in <-chan T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			v, ok := recv(ctx, in)
+			if !ok || !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Merge 将多个通道合并为一个通道，所有输入通道关闭或 ctx 取消后关闭。
+//
+// 不同输入通道之间的元素顺序不确定，同一输入通道内的元素保持原有顺序。
+func Merge[T any](ctx context.Context, ins ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	wg.Add(len(ins))
+	for _, in := range ins {
+		go func(in <-chan T) {
+			defer wg.Done()
+			for {
+				v, ok := recv(ctx, in)
+				if !ok || !send(ctx, out, v) {
+					return
+				}
+			}
+		}(in)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// FanOut 将 in 中的元素分发到 n 个输出通道，每个元素只会被其中一个通道接收。
+//
+// 空闲的输出通道会优先拿到下一个元素，适合把任务分发给多个并行的消费者。
+//
+// 参数：
+//   - n: 输出通道数量，小于 1 时按 1 处理。
+//
+// 返回值：
+//   - n 个只读通道，in 关闭或 ctx 取消后全部关闭。
+func FanOut[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	n = max(n, 1)
+	outs := make([]<-chan T, n)
+	for i := range outs {
+		out := make(chan T)
+		outs[i] = out
+		go func() {
+			defer close(out)
+			for {
+				v, ok := recv(ctx, in)
+				if !ok || !send(ctx, out, v) {
+					return
+				}
+			}
+		}()
+	}
+	return outs
+}
+
+// Tee 将 in 中的每个元素复制到 n 个输出通道。
+//
+// 每个元素发送给所有输出通道之后才会读取下一个元素，因此最慢的消费者决定整体速度；
+// 需要时可以配合 Buffer 使用。
+//
+// 参数：
+//   - n: 输出通道数量，小于 1 时按 1 处理。
+//
+// 返回值：
+//   - n 个只读通道，in 关闭或 ctx 取消后全部关闭。
+func Tee[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	n = max(n, 1)
+	chans := make([]chan T, n)
+	outs := make([]<-chan T, n)
+	for i := range chans {
+		chans[i] = make(chan T)
+		outs[i] = chans[i]
+	}
+	go func() {
+		defer func() {
+			for _, c := range chans {
+				close(c)
+			}
+		}()
+		for {
+			v, ok := recv(ctx, in)
+			if !ok {
+				return
+			}
+			for _, c := range chans {
+				if !send(ctx, c, v) {
+					return
+				}
+			}
+		}
+	}()
+	return outs
+}
+
+// Buffer 在 in 与返回的通道之间插入一个容量为 size 的缓冲区，使生产者不必等待慢速消费者。
+func Buffer[T any](ctx context.Context, in <-chan T, size int) <-chan T {
+	out := make(chan T, max(size, 0))
+	go func() {
+		defer close(out)
+		for {
+			v, ok := recv(ctx, in)
+			if !ok || !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// MARK: - Transform
+
+// Map 对 in 中的每个元素调用 fn，并发送结果。
+//
+// 示例：
+//   - Map(ctx, FromSlice(ctx, []int{1, 2}), strconv.Itoa) 依次发送 "1"、"2"。
+func Map[T, U any](ctx context.Context, in <-chan T, fn func(value T) U) <-chan U {
+	out := make(chan U)
+	go func() {
+		defer close(out)
+		for {
+			v, ok := recv(ctx, in)
+			if !ok || !send(ctx, out, fn(v)) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Filter 只转发满足 predicate 的元素。
+func Filter[T any](ctx context.Context, in <-chan T, predicate goexslice.Predicate[T]) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			v, ok := recv(ctx, in)
+			if !ok {
+				return
+			}
+			if predicate != nil && !predicate(v) {
+				continue
+			}
+			if !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// MARK: - Internal
+
+func send[T any](ctx context.Context, out chan<- T, v T) bool {
+	select {
+	case out <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func recv[T any](ctx context.Context, in <-chan T) (v T, ok bool) {
+	select {
+	case v, ok = <-in:
+		return v, ok
+	case <-ctx.Done():
+		return v, false
+	}
+}
@@ -0,0 +1,108 @@
+package goexchan
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func TestFromSliceToSlice(t *testing.T) {
+	ctx := context.Background()
+	expected := []int{1, 2, 3}
+	if result := ToSlice(ctx, FromSlice(ctx, expected)); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestOrDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	never := make(chan int)
+	out := OrDone(ctx, never)
+	cancel()
+	if _, ok := <-out; ok {
+		t.Errorf("Expected OrDone to close after cancel")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	ctx := context.Background()
+	result := ToSlice(ctx, Merge(ctx, FromSlice(ctx, []int{1, 3, 5}), FromSlice(ctx, []int{2, 4})))
+	sort.Ints(result)
+	expected := []int{1, 2, 3, 4, 5}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestFanOut(t *testing.T) {
+	ctx := context.Background()
+	outs := FanOut(ctx, FromSlice(ctx, []int{1, 2, 3, 4, 5, 6}), 3)
+	if len(outs) != 3 {
+		t.Fatalf("Expected 3 outputs, but got %d", len(outs))
+	}
+	result := ToSlice(ctx, Merge(ctx, outs...))
+	sort.Ints(result)
+	expected := []int{1, 2, 3, 4, 5, 6}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected each element exactly once %v, but got %v", expected, result)
+	}
+}
+
+func TestTee(t *testing.T) {
+	ctx := context.Background()
+	outs := Tee(ctx, FromSlice(ctx, []int{1, 2, 3}), 2)
+	results := make([][]int, 2)
+	done := make(chan int)
+	for i := range outs {
+		go func(i int) {
+			results[i] = ToSlice(ctx, outs[i])
+			done <- i
+		}(i)
+	}
+	<-done
+	<-done
+	expected := []int{1, 2, 3}
+	for _, result := range results {
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, but got %v", expected, result)
+		}
+	}
+}
+
+func TestBuffer(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan int)
+	out := Buffer(ctx, in, 3)
+	for i := 0; i < 3; i++ {
+		in <- i
+	}
+	close(in)
+	expected := []int{0, 1, 2}
+	if result := ToSlice(ctx, out); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestMapFilter(t *testing.T) {
+	ctx := context.Background()
+	evens := Filter(ctx, FromSlice(ctx, []int{1, 2, 3, 4}), func(v int) bool { return v%2 == 0 })
+	result := ToSlice(ctx, Map(ctx, evens, strconv.Itoa))
+	expected := []string{"2", "4"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+func TestCancelClosesOutputs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int)
+	outs := append(FanOut(ctx, in, 2), Tee(ctx, in, 2)...)
+	outs = append(outs, Merge(ctx, in), Map(ctx, in, func(v int) int { return v }))
+	cancel()
+	for _, out := range outs {
+		for range out {
+		}
+	}
+}
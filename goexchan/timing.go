@@ -0,0 +1,170 @@
+package goexchan
+
+import (
+	"context"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+)
+
+// Batch 将 in 中的元素按批次发送：批次达到 size 个元素，或批次中第一个元素到达后经过 timeout 时发送。
+//
+// in 关闭时会先发送未满的最后一个批次，再关闭返回的通道。
+//
+// 参数：
+//   - size: 每个批次的最大元素个数，小于 1 时按 1 处理。
+//   - timeout: 批次的最长等待时间，小于等于 0 表示只按 size 分批。
+//
+// 示例：
+//   - Batch(ctx, FromSlice(ctx, []int{1, 2, 3, 4, 5}), 2, time.Second) 依次发送 [1 2]、[3 4]、[5]。
+func Batch[T any](ctx context.Context, in <-chan T, size int, timeout time.Duration) <-chan []T {
+	return BatchWithClock(ctx, goexclock.Real(), in, size, timeout)
+}
+
+// BatchWithClock 与 Batch 相同，但使用 clock 计时，便于在测试中使用 goexclock.Fake。
+func BatchWithClock[T any](ctx context.Context, clock goexclock.Clock, in <-chan T, size int, timeout time.Duration) <-chan []T {
+	size = max(size, 1)
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+
+		var batch []T
+		var timer goexclock.Timer
+		var timeoutC <-chan time.Time
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				timer, timeoutC = nil, nil
+			}
+			if len(batch) == 0 {
+				return true
+			}
+			b := batch
+			batch = nil
+			return send(ctx, out, b)
+		}
+
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+				batch = append(batch, v)
+				if len(batch) == 1 && timeout > 0 {
+					timer = clock.NewTimer(timeout)
+					timeoutC = timer.C()
+				}
+				if len(batch) >= size && !flush() {
+					return
+				}
+			case <-timeoutC:
+				timer, timeoutC = nil, nil
+				if !flush() {
+					return
+				}
+			case <-ctx.Done():
+				if timer != nil {
+					timer.Stop()
+				}
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Debounce 只在 in 停止发送 d 时长之后发送最后收到的元素。
+//
+// in 关闭时如果还有未发送的元素，会立即发送后再关闭返回的通道。
+//
+// 示例：
+//   - 搜索框输入 "g"、"go"、"goe" 的间隔都小于 d 时，只会发送 "goe"。
+func Debounce[T any](ctx context.Context, in <-chan T, d time.Duration) <-chan T {
+	return DebounceWithClock(ctx, goexclock.Real(), in, d)
+}
+
+// DebounceWithClock 与 Debounce 相同，但使用 clock 计时，便于在测试中使用 goexclock.Fake。
+func DebounceWithClock[T any](ctx context.Context, clock goexclock.Clock, in <-chan T, d time.Duration) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+
+		var pending T
+		var hasPending bool
+		var deadline time.Time
+		var timer goexclock.Timer
+		var timerC <-chan time.Time
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					if hasPending {
+						send(ctx, out, pending)
+					}
+					return
+				}
+				pending, hasPending = v, true
+				deadline = clock.Now().Add(d)
+				// 计时器只在空闲时创建，之后通过推迟 deadline 延长等待，避免频繁重置计时器
+				if timer == nil {
+					timer = clock.NewTimer(d)
+					timerC = timer.C()
+				}
+			case now := <-timerC:
+				if now.Before(deadline) {
+					timer.Reset(deadline.Sub(now))
+					continue
+				}
+				timer, timerC = nil, nil
+				hasPending = false
+				if !send(ctx, out, pending) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Throttle 限制发送频率：发送一个元素后的 d 时长内收到的元素都会被丢弃。
+//
+// 第一个元素会立即发送，相当于 Combine 中 latest 为 false 的 throttle。
+func Throttle[T any](ctx context.Context, in <-chan T, d time.Duration) <-chan T {
+	return ThrottleWithClock(ctx, goexclock.Real(), in, d)
+}
+
+// ThrottleWithClock 与 Throttle 相同，但使用 clock 计时，便于在测试中使用 goexclock.Fake。
+func ThrottleWithClock[T any](ctx context.Context, clock goexclock.Clock, in <-chan T, d time.Duration) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+
+		var last time.Time
+		emitted := false
+		for {
+			v, ok := recv(ctx, in)
+			if !ok {
+				return
+			}
+			now := clock.Now()
+			if emitted && now.Sub(last) < d {
+				continue
+			}
+			last, emitted = now, true
+			if !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,113 @@
+package goexchan
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+)
+
+func TestBatch(t *testing.T) {
+	t.Run("TestBatch_Size", func(t *testing.T) {
+		ctx := context.Background()
+		result := ToSlice(ctx, Batch(ctx, FromSlice(ctx, []int{1, 2, 3, 4, 5}), 2, 0))
+		expected := [][]int{{1, 2}, {3, 4}, {5}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, but got %v", expected, result)
+		}
+	})
+
+	t.Run("TestBatch_Timeout", func(t *testing.T) {
+		ctx := context.Background()
+		clock := goexclock.NewFake(time.Time{})
+		in := make(chan int)
+		out := BatchWithClock(ctx, clock, in, 3, time.Second)
+
+		in <- 1
+		in <- 2
+		clock.BlockUntil(1)
+		clock.Advance(time.Second)
+		if result := <-out; !reflect.DeepEqual(result, []int{1, 2}) {
+			t.Errorf("Expected [1 2], but got %v", result)
+		}
+
+		in <- 3
+		in <- 4
+		in <- 5
+		if result := <-out; !reflect.DeepEqual(result, []int{3, 4, 5}) {
+			t.Errorf("Expected [3 4 5], but got %v", result)
+		}
+		close(in)
+		if _, ok := <-out; ok {
+			t.Errorf("Expected output to be closed")
+		}
+	})
+}
+
+func TestDebounce(t *testing.T) {
+	ctx := context.Background()
+	clock := goexclock.NewFake(time.Time{})
+	in := make(chan string)
+	out := DebounceWithClock(ctx, clock, in, time.Second)
+
+	in <- "g"
+	in <- "go"
+	clock.BlockUntil(1)
+	clock.Advance(500 * time.Millisecond)
+	in <- "goe"
+	clock.Advance(500 * time.Millisecond)
+
+	// 第一个计时器到期时 deadline 已被推迟，Debounce 会重新等待剩余的时间
+	clock.BlockUntil(1)
+	select {
+	case v := <-out:
+		t.Fatalf("Expected no value before quiet period, but got %v", v)
+	default:
+	}
+	clock.Advance(time.Second)
+	if v := <-out; v != "goe" {
+		t.Errorf("Expected goe, but got %v", v)
+	}
+
+	in <- "last"
+	close(in)
+	if v := <-out; v != "last" {
+		t.Errorf("Expected pending value to be flushed on close, but got %v", v)
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	t.Run("TestThrottle_DropWithinInterval", func(t *testing.T) {
+		ctx := context.Background()
+		clock := goexclock.NewFake(time.Time{})
+		in := make(chan int, 3)
+		in <- 1
+		in <- 2
+		in <- 3
+		close(in)
+		result := ToSlice(ctx, ThrottleWithClock(ctx, clock, in, time.Second))
+		if !reflect.DeepEqual(result, []int{1}) {
+			t.Errorf("Expected [1], but got %v", result)
+		}
+	})
+
+	t.Run("TestThrottle_EmitAfterInterval", func(t *testing.T) {
+		ctx := context.Background()
+		clock := goexclock.NewFake(time.Time{})
+		in := make(chan int)
+		out := ThrottleWithClock(ctx, clock, in, time.Second)
+
+		in <- 1
+		if v := <-out; v != 1 {
+			t.Errorf("Expected 1, but got %v", v)
+		}
+		clock.Advance(time.Second)
+		in <- 2
+		if v := <-out; v != 2 {
+			t.Errorf("Expected 2, but got %v", v)
+		}
+		close(in)
+	})
+}
@@ -0,0 +1,87 @@
+package goexclock
+
+import (
+	"time"
+)
+
+// Clock 抽象了 time 包中与当前时间和定时器相关的函数。
+//
+// 依赖时间的组件应通过 Clock 获取时间，生产环境使用 Real()，测试中使用 NewFake() 精确控制时间流逝。
+type Clock interface {
+	// Now 返回当前时间。
+	Now() time.Time
+	// Since 返回自 t 以来经过的时间。
+	Since(t time.Time) time.Duration
+	// After 在 d 之后向返回的通道发送当时的时间。
+	After(d time.Duration) <-chan time.Time
+	// Sleep 阻塞当前 goroutine 至少 d 时长。
+	Sleep(d time.Duration)
+	// NewTimer 创建一个在 d 之后触发一次的定时器。
+	NewTimer(d time.Duration) Timer
+	// NewTicker 创建一个每隔 d 触发一次的周期定时器。
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer 对应 *time.Timer。
+type Timer interface {
+	// C 返回定时器触发时接收时间的通道。
+	C() <-chan time.Time
+	// Stop 停止定时器，如果定时器在触发前被停止则返回 true。
+	Stop() bool
+	// Reset 让定时器在 d 之后重新触发，如果定时器此前处于活动状态则返回 true。
+	Reset(d time.Duration) bool
+}
+
+// Ticker 对应 *time.Ticker。
+type Ticker interface {
+	// C 返回每次触发时接收时间的通道。
+	C() <-chan time.Time
+	// Stop 停止周期定时器。
+	Stop()
+	// Reset 停止周期定时器并将周期重置为 d。
+	Reset(d time.Duration)
+}
+
+// MARK: - Real
+
+type realClock struct{}
+
+// Real 返回基于 time 包实现的真实时钟。
+func Real() Clock {
+	return realClock{}
+}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) Since(t time.Time) time.Duration        { return time.Since(t) }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+func (realClock) Sleep(d time.Duration)                  { time.Sleep(d) }
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{time.NewTimer(d)}
+}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+type realTimer struct{ t *time.Timer }
+
+func (r realTimer) C() <-chan time.Time        { return r.t.C }
+func (r realTimer) Stop() bool                 { return r.t.Stop() }
+func (r realTimer) Reset(d time.Duration) bool { return r.t.Reset(d) }
+
+type realTicker struct{ t *time.Ticker }
+
+func (r realTicker) C() <-chan time.Time   { return r.t.C }
+func (r realTicker) Stop()                 { r.t.Stop() }
+func (r realTicker) Reset(d time.Duration) { r.t.Reset(d) }
+
+// OrReal 在 c 为 nil 时返回真实时钟，否则返回 c。
+//
+// 便于各组件的配置项把 Clock 作为可选字段。
+func OrReal(c Clock) Clock {
+	if c == nil {
+		return Real()
+	}
+	return c
+}
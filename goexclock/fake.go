@@ -0,0 +1,236 @@
+package goexclock
+
+import (
+	"sync"
+	"time"
+)
+
+// Fake 是一个手动推进的时钟，用于编写确定性的测试。
+//
+// 时间只会在调用 Advance 或 Set 时前进，到期的定时器会在推进过程中按到期时间依次触发。
+type Fake struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	waiters []*fakeWaiter
+}
+
+type fakeWaiter struct {
+	until  time.Time
+	period time.Duration
+	ch     chan time.Time
+}
+
+// NewFake 创建一个以 start 为当前时间的 Fake 时钟。
+//
+// 参数：
+//   - start: 初始时间，为零值时使用 2000-01-01 00:00:00 UTC。
+//
+// 返回值：
+//   - 新的 Fake 时钟。
+func NewFake(start time.Time) *Fake {
+	if start.IsZero() {
+		start = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	f := &Fake{now: start}
+	f.cond = sync.NewCond(&f.mu)
+	return f
+}
+
+// Now 返回 Fake 时钟的当前时间。
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.now
+}
+
+// Since 返回自 t 以来经过的 Fake 时间。
+func (f *Fake) Since(t time.Time) time.Duration {
+	return f.Now().Sub(t)
+}
+
+// After 在 Fake 时间经过 d 之后向返回的通道发送当时的时间。
+func (f *Fake) After(d time.Duration) <-chan time.Time {
+	return f.NewTimer(d).C()
+}
+
+// Sleep 阻塞直到 Fake 时间经过 d。
+func (f *Fake) Sleep(d time.Duration) {
+	<-f.After(d)
+}
+
+// NewTimer 创建一个在 Fake 时间经过 d 之后触发的定时器。
+func (f *Fake) NewTimer(d time.Duration) Timer {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w := &fakeWaiter{ch: make(chan time.Time, 1)}
+	f.schedule(w, d)
+	return &fakeTimer{clock: f, w: w}
+}
+
+// NewTicker 创建一个每隔 d 的 Fake 时间触发一次的周期定时器。
+func (f *Fake) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("goexclock: non-positive interval for NewTicker")
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w := &fakeWaiter{ch: make(chan time.Time, 1), period: d}
+	f.schedule(w, d)
+	return &fakeTicker{clock: f, w: w}
+}
+
+// Advance 将 Fake 时间推进 d，并触发所有在此期间到期的定时器。
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.advanceTo(f.now.Add(d))
+}
+
+// Set 将 Fake 时间设置为 t，t 早于当前时间时不会触发任何定时器。
+func (f *Fake) Set(t time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if t.Before(f.now) {
+		f.now = t
+		return
+	}
+	f.advanceTo(t)
+}
+
+// Waiters 返回当前处于活动状态的定时器数量（包括 Sleep 和 After）。
+func (f *Fake) Waiters() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.waiters)
+}
+
+// BlockUntil 阻塞直到至少有 n 个活动的定时器。
+//
+// 在测试中，通常在 Advance 之前调用 BlockUntil，以确保被测 goroutine 已经开始等待。
+func (f *Fake) BlockUntil(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for len(f.waiters) < n {
+		f.cond.Wait()
+	}
+}
+
+func (f *Fake) advanceTo(target time.Time) {
+	for {
+		next := f.nextDue(target)
+		if next == nil {
+			break
+		}
+		f.now = next.until
+		select {
+		case next.ch <- f.now:
+		default:
+		}
+		if next.period > 0 {
+			next.until = next.until.Add(next.period)
+		} else {
+			f.remove(next)
+		}
+	}
+	f.now = target
+}
+
+func (f *Fake) nextDue(target time.Time) *fakeWaiter {
+	var next *fakeWaiter
+	for _, w := range f.waiters {
+		if w.until.After(target) {
+			continue
+		}
+		if next == nil || w.until.Before(next.until) {
+			next = w
+		}
+	}
+	return next
+}
+
+// schedule 将 w 登记为在 d 之后到期，d 小于等于 0 时立即触发。调用方必须持有锁。
+func (f *Fake) schedule(w *fakeWaiter, d time.Duration) {
+	if d <= 0 && w.period == 0 {
+		select {
+		case w.ch <- f.now:
+		default:
+		}
+		return
+	}
+	w.until = f.now.Add(d)
+	f.waiters = append(f.waiters, w)
+	f.cond.Broadcast()
+}
+
+// remove 注销 w 并返回它此前是否处于活动状态。调用方必须持有锁。
+func (f *Fake) remove(w *fakeWaiter) bool {
+	for i, item := range f.waiters {
+		if item == w {
+			f.waiters = append(f.waiters[:i], f.waiters[i+1:]...)
+			f.cond.Broadcast()
+			return true
+		}
+	}
+	return false
+}
+
+type fakeTimer struct {
+	clock *Fake
+	w     *fakeWaiter
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.w.ch
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	return t.clock.remove(t.w)
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	active := t.clock.remove(t.w)
+	t.clock.schedule(t.w, d)
+	return active
+}
+
+type fakeTicker struct {
+	clock *Fake
+	w     *fakeWaiter
+}
+
+func (t *fakeTicker) C() <-chan time.Time {
+	return t.w.ch
+}
+
+func (t *fakeTicker) Stop() {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	t.clock.remove(t.w)
+}
+
+func (t *fakeTicker) Reset(d time.Duration) {
+	if d <= 0 {
+		panic("goexclock: non-positive interval for Ticker.Reset")
+	}
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	t.clock.remove(t.w)
+	t.w.period = d
+	t.clock.schedule(t.w, d)
+}
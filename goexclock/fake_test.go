@@ -0,0 +1,74 @@
+package goexclock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("TestFake_Advance", func(t *testing.T) {
+		c := NewFake(start)
+		c.Advance(time.Hour)
+		if got := c.Since(start); got != time.Hour {
+			t.Errorf("Expected %v, but got %v", time.Hour, got)
+		}
+	})
+
+	t.Run("TestFake_Timer", func(t *testing.T) {
+		c := NewFake(start)
+		timer := c.NewTimer(time.Second)
+		c.Advance(999 * time.Millisecond)
+		select {
+		case <-timer.C():
+			t.Fatalf("Expected timer not to fire yet")
+		default:
+		}
+		c.Advance(time.Millisecond)
+		select {
+		case got := <-timer.C():
+			if !got.Equal(start.Add(time.Second)) {
+				t.Errorf("Expected fire time %v, but got %v", start.Add(time.Second), got)
+			}
+		default:
+			t.Fatalf("Expected timer to fire")
+		}
+		if timer.Stop() {
+			t.Errorf("Expected Stop on fired timer to return false")
+		}
+	})
+
+	t.Run("TestFake_Ticker", func(t *testing.T) {
+		c := NewFake(start)
+		ticker := c.NewTicker(time.Second)
+		defer ticker.Stop()
+		fired := 0
+		for i := 0; i < 3; i++ {
+			c.Advance(time.Second)
+			select {
+			case <-ticker.C():
+				fired++
+			default:
+			}
+		}
+		if fired != 3 {
+			t.Errorf("Expected 3 ticks, but got %d", fired)
+		}
+	})
+
+	t.Run("TestFake_SleepBlockUntil", func(t *testing.T) {
+		c := NewFake(start)
+		done := make(chan struct{})
+		go func() {
+			c.Sleep(time.Minute)
+			close(done)
+		}()
+		c.BlockUntil(1)
+		c.Advance(time.Minute)
+		<-done
+		if c.Waiters() != 0 {
+			t.Errorf("Expected no waiters, but got %d", c.Waiters())
+		}
+	})
+}
@@ -0,0 +1,93 @@
+package goexdecimal
+
+import (
+	"errors"
+	"math/big"
+)
+
+// ErrInvalidRatios 表示分配比例为空、含有负数或全部为零。
+var ErrInvalidRatios = errors.New("goexdecimal: ratios must be non-negative and not all zero")
+
+// Sum 返回 values 中所有 Decimal 的和，values 为空时返回 Zero。
+//
+// 示例：
+//   - Sum([]Decimal{MustParse("0.10"), MustParse("0.20")}) 返回 0.30。
+func Sum(values []Decimal) Decimal {
+	sum := Zero
+	for _, v := range values {
+		sum = sum.Add(v)
+	}
+	return sum
+}
+
+// Split 将 d 平均分为 n 份，每份的小数位数与 d 相同，各份之和恰好等于 d。
+//
+// 无法平分的最小单位（10^-scale）依次分给前面的几份，因此各份之间最多相差一个最小单位。
+//
+// 返回值：
+//   - []Decimal: n 份金额。
+//   - error: n 小于 1 时返回 ErrInvalidRatios。
+//
+// 示例：
+//   - MustParse("100.00").Split(3) 返回 [33.34 33.33 33.33]。
+func (d Decimal) Split(n int) ([]Decimal, error) {
+	if n < 1 {
+		return nil, ErrInvalidRatios
+	}
+	ratios := make([]int, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return d.Allocate(ratios...)
+}
+
+// Allocate 按 ratios 的比例分配 d，每份的小数位数与 d 相同，各份之和恰好等于 d。
+//
+// 每份先按比例向零截断到最小单位，剩余的最小单位再从前往后逐个分给比例不为零的份额，
+// 因此比例为零的份额总是得到 0。
+//
+// 返回值：
+//   - []Decimal: 与 ratios 一一对应的金额。
+//   - error: ratios 为空、含有负数或全部为零时返回 ErrInvalidRatios。
+//
+// 示例：
+//   - MustParse("0.05").Allocate(3, 7) 返回 [0.02 0.03]。
+//   - MustParse("-10").Allocate(1, 1, 1) 返回 [-4 -3 -3]。
+func (d Decimal) Allocate(ratios ...int) ([]Decimal, error) {
+	total := new(big.Int)
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, ErrInvalidRatios
+		}
+		total.Add(total, big.NewInt(int64(ratio)))
+	}
+	if total.Sign() == 0 {
+		return nil, ErrInvalidRatios
+	}
+
+	amount := d.unscaled()
+	shares := make([]*big.Int, len(ratios))
+	remainder := new(big.Int).Set(amount)
+	for i, ratio := range ratios {
+		share := new(big.Int).Mul(amount, big.NewInt(int64(ratio)))
+		share.Quo(share, total)
+		shares[i] = share
+		remainder.Sub(remainder, share)
+	}
+
+	// 截断损失的最小单位少于比例不为零的份数，逐个补回
+	unit := big.NewInt(int64(amount.Sign()))
+	for i := 0; remainder.Sign() != 0; i++ {
+		if ratios[i] == 0 {
+			continue
+		}
+		shares[i].Add(shares[i], unit)
+		remainder.Sub(remainder, unit)
+	}
+
+	result := make([]Decimal, len(shares))
+	for i, share := range shares {
+		result[i] = Decimal{value: share, scale: d.scale}
+	}
+	return result, nil
+}
@@ -0,0 +1,103 @@
+package goexdecimal
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func toStrings(values []Decimal) []string {
+	result := make([]string, len(values))
+	for i, v := range values {
+		result[i] = v.String()
+	}
+	return result
+}
+
+func TestSum(t *testing.T) {
+	values := []Decimal{MustParse("0.10"), MustParse("0.20"), MustParse("-0.05")}
+	if got := Sum(values).String(); got != "0.25" {
+		t.Errorf("Expected %s, but got %s", "0.25", got)
+	}
+	if got := Sum(nil); !got.IsZero() {
+		t.Errorf("Expected zero, but got %s", got)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	testCases := []struct {
+		amount string
+		n      int
+		want   []string
+	}{
+		{"100.00", 3, []string{"33.34", "33.33", "33.33"}},
+		{"0.05", 3, []string{"0.02", "0.02", "0.01"}},
+		{"-0.05", 3, []string{"-0.02", "-0.02", "-0.01"}},
+		{"10", 1, []string{"10"}},
+		{"0.01", 4, []string{"0.01", "0.00", "0.00", "0.00"}},
+	}
+
+	for _, tc := range testCases {
+		got, err := MustParse(tc.amount).Split(tc.n)
+		if err != nil || !slices.Equal(toStrings(got), tc.want) {
+			t.Errorf("Split(%s, %d): Expected %v, but got %v (%v)", tc.amount, tc.n, tc.want, toStrings(got), err)
+		}
+	}
+
+	if _, err := MustParse("1").Split(0); !errors.Is(err, ErrInvalidRatios) {
+		t.Errorf("Expected %v, but got %v", ErrInvalidRatios, err)
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	testCases := []struct {
+		amount string
+		ratios []int
+		want   []string
+	}{
+		{"0.05", []int{3, 7}, []string{"0.02", "0.03"}},
+		{"100", []int{50, 30, 20}, []string{"50", "30", "20"}},
+		{"-10", []int{1, 1, 1}, []string{"-4", "-3", "-3"}},
+		{"10.00", []int{0, 1, 1}, []string{"0.00", "5.00", "5.00"}},
+		{"0.03", []int{0, 1, 0, 1}, []string{"0.00", "0.02", "0.00", "0.01"}},
+	}
+
+	for _, tc := range testCases {
+		got, err := MustParse(tc.amount).Allocate(tc.ratios...)
+		if err != nil || !slices.Equal(toStrings(got), tc.want) {
+			t.Errorf("Allocate(%s, %v): Expected %v, but got %v (%v)", tc.amount, tc.ratios, tc.want, toStrings(got), err)
+		}
+	}
+
+	for _, ratios := range [][]int{nil, {0, 0}, {1, -1}} {
+		if _, err := MustParse("1").Allocate(ratios...); !errors.Is(err, ErrInvalidRatios) {
+			t.Errorf("Allocate(%v): Expected %v, but got %v", ratios, ErrInvalidRatios, err)
+		}
+	}
+}
+
+func TestAllocate_SumsExactly(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for i := 0; i < 500; i++ {
+		amount := New(r.Int63n(2_000_000)-1_000_000, int32(r.Intn(4)))
+		ratios := make([]int, 1+r.Intn(6))
+		for j := range ratios {
+			ratios[j] = r.Intn(10)
+		}
+		ratios[0]++
+
+		parts, err := amount.Allocate(ratios...)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if sum := Sum(parts); !sum.Equal(amount) {
+			t.Fatalf("Allocate(%s, %v) = %v sums to %s", amount, ratios, toStrings(parts), sum)
+		}
+		for j, part := range parts {
+			if part.Scale() != amount.Scale() {
+				t.Fatalf("part %d has scale %d, want %d", j, part.Scale(), amount.Scale())
+			}
+		}
+	}
+}
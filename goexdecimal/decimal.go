@@ -0,0 +1,286 @@
+package goexdecimal
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidFormat 表示字符串不是合法的十进制数。
+	ErrInvalidFormat = errors.New("goexdecimal: invalid decimal format")
+	// ErrInvalidFloat 表示浮点数是 NaN 或无穷大，无法转换为 Decimal。
+	ErrInvalidFloat = errors.New("goexdecimal: NaN or infinite float")
+	// ErrDivisionByZero 表示除数为零。
+	ErrDivisionByZero = errors.New("goexdecimal: division by zero")
+)
+
+// maxExponent 限制解析时科学计数法指数的大小，防止 "1e999999999" 这样的输入耗尽内存。
+const maxExponent = 1 << 16
+
+// Decimal 是任意精度的十进制定点数，适合表示金额等不能有二进制舍入误差的数值。
+//
+// Decimal 的值为 value × 10^-scale，scale 是小数位数，加减乘运算都是精确的，只有除法与 Round 会按指定的模式舍入。
+// Decimal 是不可变的值类型，所有运算都返回新的 Decimal，零值表示 0，可以安全地在多个 goroutine 间共享。
+//
+// 注意 Decimal 会保留小数位数："1.50" 与 "1.5" 数值相等（Equal 返回 true），但 String 的结果不同，
+// 因此请使用 Equal 或 Cmp 比较，而不是 == 或 reflect.DeepEqual。
+type Decimal struct {
+	// value 为 nil 时表示 0
+	value *big.Int
+	scale int32
+}
+
+// Zero 是数值为 0、小数位数为 0 的 Decimal。
+var Zero = Decimal{}
+
+var bigTen = big.NewInt(10)
+
+// pow10 返回 10^n。
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// MARK: - Constructors
+
+// New 返回 value × 10^-scale。
+//
+// scale 为负数时表示乘以 10 的 -scale 次方，结果的小数位数为 0。
+//
+// 示例：
+//   - New(12345, 2) 表示 123.45。
+//   - New(5, -3) 表示 5000。
+func New(value int64, scale int32) Decimal {
+	return newFromBig(big.NewInt(value), scale)
+}
+
+// NewFromBigInt 返回 value × 10^-scale，value 会被复制。
+func NewFromBigInt(value *big.Int, scale int32) Decimal {
+	return newFromBig(new(big.Int).Set(value), scale)
+}
+
+// newFromBig 使用 value 本身（不复制）构造 Decimal。
+func newFromBig(value *big.Int, scale int32) Decimal {
+	if scale < 0 {
+		value.Mul(value, pow10(-scale))
+		scale = 0
+	}
+	return Decimal{value: value, scale: scale}
+}
+
+// NewFromInt 返回整数 value 对应的 Decimal。
+func NewFromInt(value int64) Decimal {
+	return New(value, 0)
+}
+
+// NewFromFloat 返回与 f 的最短十进制表示相同的 Decimal。
+//
+// 转换使用 strconv 的最短表示，因此 NewFromFloat(0.1) 得到 0.1，而不是二进制误差展开后的长数字。
+// f 为 NaN 或无穷大时返回 ErrInvalidFloat。
+//
+// 示例：
+//   - NewFromFloat(19.99) 返回 19.99。
+func NewFromFloat(f float64) (Decimal, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Zero, ErrInvalidFloat
+	}
+	return Parse(strconv.FormatFloat(f, 'g', -1, 64))
+}
+
+// Parse 解析十进制字符串，小数位数与字符串中的写法一致。
+//
+// 支持可选的正负号、小数点以及科学计数法指数，例如 "-123.450"、"+1"、".5"、"1.5e-3"、"2E3"。
+//
+// 返回值：
+//   - Decimal: 解析结果，例如 Parse("1.50") 的小数位数为 2。
+//   - error: 格式不合法时返回 ErrInvalidFormat。
+func Parse(s string) (Decimal, error) {
+	input := s
+	negative := false
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	exponent := int64(0)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil || e > maxExponent || e < -maxExponent {
+			return Zero, invalidFormat(input)
+		}
+		exponent = e
+		s = s[:i]
+	}
+
+	integer, fraction, _ := strings.Cut(s, ".")
+	digits := integer + fraction
+	if digits == "" {
+		return Zero, invalidFormat(input)
+	}
+	for i := 0; i < len(digits); i++ {
+		if digits[i] < '0' || digits[i] > '9' {
+			return Zero, invalidFormat(input)
+		}
+	}
+
+	value, _ := new(big.Int).SetString(digits, 10)
+	if negative {
+		value.Neg(value)
+	}
+	return newFromBig(value, int32(int64(len(fraction))-exponent)), nil
+}
+
+func invalidFormat(s string) error {
+	return &formatError{input: s}
+}
+
+// formatError 携带无法解析的原始输入，errors.Is(err, ErrInvalidFormat) 为 true。
+type formatError struct {
+	input string
+}
+
+func (e *formatError) Error() string {
+	return ErrInvalidFormat.Error() + ": " + strconv.Quote(e.input)
+}
+
+func (e *formatError) Is(target error) bool {
+	return target == ErrInvalidFormat
+}
+
+// MustParse 与 Parse 相同，但在格式不合法时 panic，适合初始化常量。
+func MustParse(s string) Decimal {
+	d, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// MARK: - Accessors
+
+// unscaled 返回内部的整数值，零值 Decimal 返回一个新的 0。
+func (d Decimal) unscaled() *big.Int {
+	if d.value == nil {
+		return new(big.Int)
+	}
+	return d.value
+}
+
+// Scale 返回小数位数。
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// Coefficient 返回不含小数点的整数值（value × 10^scale）的副本。
+func (d Decimal) Coefficient() *big.Int {
+	return new(big.Int).Set(d.unscaled())
+}
+
+// Sign 返回 d 的符号：负数返回 -1，零返回 0，正数返回 1。
+func (d Decimal) Sign() int {
+	return d.unscaled().Sign()
+}
+
+// IsZero 判断 d 是否等于 0。
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// String 返回不使用科学计数法的十进制字符串，保留全部小数位，例如 "-0.50"。
+func (d Decimal) String() string {
+	value := d.unscaled()
+	digits := new(big.Int).Abs(value).String()
+	sign := ""
+	if value.Sign() < 0 {
+		sign = "-"
+	}
+	if d.scale == 0 {
+		return sign + digits
+	}
+	if pad := int(d.scale) + 1 - len(digits); pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+	point := len(digits) - int(d.scale)
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// Float64 返回最接近 d 的 float64，可能损失精度。
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// MARK: - Comparison
+
+// rescaled 返回 d 与 other 对齐到相同小数位数后的整数值。
+func rescaled(d, other Decimal) (a, b *big.Int, scale int32) {
+	a, b = d.unscaled(), other.unscaled()
+	switch {
+	case d.scale < other.scale:
+		a = new(big.Int).Mul(a, pow10(other.scale-d.scale))
+		return a, b, other.scale
+	case d.scale > other.scale:
+		b = new(big.Int).Mul(b, pow10(d.scale-other.scale))
+		return a, b, d.scale
+	default:
+		return a, b, d.scale
+	}
+}
+
+// Cmp 比较 d 与 other 的数值：d < other 返回 -1，相等返回 0，d > other 返回 1。
+func (d Decimal) Cmp(other Decimal) int {
+	a, b, _ := rescaled(d, other)
+	return a.Cmp(b)
+}
+
+// Equal 判断 d 与 other 的数值是否相等，不考虑小数位数，例如 1.50 与 1.5 相等。
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// LessThan 判断 d 是否小于 other。
+func (d Decimal) LessThan(other Decimal) bool {
+	return d.Cmp(other) < 0
+}
+
+// GreaterThan 判断 d 是否大于 other。
+func (d Decimal) GreaterThan(other Decimal) bool {
+	return d.Cmp(other) > 0
+}
+
+// MARK: - Arithmetic
+
+// Add 返回 d + other，结果的小数位数为两者中较大的一个。
+//
+// 示例：
+//   - MustParse("1.1").Add(MustParse("2.25")) 返回 3.35。
+func (d Decimal) Add(other Decimal) Decimal {
+	a, b, scale := rescaled(d, other)
+	return Decimal{value: new(big.Int).Add(a, b), scale: scale}
+}
+
+// Sub 返回 d - other，结果的小数位数为两者中较大的一个。
+func (d Decimal) Sub(other Decimal) Decimal {
+	a, b, scale := rescaled(d, other)
+	return Decimal{value: new(big.Int).Sub(a, b), scale: scale}
+}
+
+// Mul 返回 d × other，结果是精确的，小数位数为两者之和；需要固定小数位时再调用 Round。
+//
+// 示例：
+//   - MustParse("19.99").Mul(MustParse("0.08")) 返回 1.5992。
+func (d Decimal) Mul(other Decimal) Decimal {
+	return Decimal{value: new(big.Int).Mul(d.unscaled(), other.unscaled()), scale: d.scale + other.scale}
+}
+
+// Neg 返回 -d。
+func (d Decimal) Neg() Decimal {
+	return Decimal{value: new(big.Int).Neg(d.unscaled()), scale: d.scale}
+}
+
+// Abs 返回 d 的绝对值。
+func (d Decimal) Abs() Decimal {
+	return Decimal{value: new(big.Int).Abs(d.unscaled()), scale: d.scale}
+}
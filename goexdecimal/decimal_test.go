@@ -0,0 +1,182 @@
+package goexdecimal
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		s     string
+		want  string
+		scale int32
+	}{
+		{"0", "0", 0},
+		{"123.450", "123.450", 3},
+		{"-0.5", "-0.5", 1},
+		{"+1", "1", 0},
+		{".5", "0.5", 1},
+		{"5.", "5", 0},
+		{"1.5e-3", "0.0015", 4},
+		{"2E3", "2000", 0},
+		{"1.25e1", "12.5", 1},
+		{"-000.010", "-0.010", 3},
+		{"123456789012345678901234567890.123456789", "123456789012345678901234567890.123456789", 9},
+	}
+
+	for _, tc := range testCases {
+		d, err := Parse(tc.s)
+		if err != nil {
+			t.Errorf("Parse(%q): unexpected error %v", tc.s, err)
+			continue
+		}
+		if d.String() != tc.want || d.Scale() != tc.scale {
+			t.Errorf("Parse(%q): Expected %s (scale %d), but got %s (scale %d)", tc.s, tc.want, tc.scale, d, d.Scale())
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, s := range []string{"", "-", ".", "1.2.3", "abc", "1,000", "1_000", "1e", "1e1.5", "--1", "1e99999999", " 1", "NaN"} {
+		if _, err := Parse(s); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Parse(%q): Expected %v, but got %v", s, ErrInvalidFormat, err)
+		}
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	MustParse("oops")
+}
+
+func TestConstructors(t *testing.T) {
+	testCases := []struct {
+		name string
+		got  Decimal
+		want string
+	}{
+		{"New", New(12345, 2), "123.45"},
+		{"NewNegativeScale", New(5, -3), "5000"},
+		{"NewSmall", New(-5, 3), "-0.005"},
+		{"NewFromInt", NewFromInt(-42), "-42"},
+		{"NewFromBigInt", NewFromBigInt(big.NewInt(7), 1), "0.7"},
+		{"Zero", Zero, "0"},
+		{"ZeroValue", Decimal{}, "0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestConstructors_"+tc.name, func(t *testing.T) {
+			if got := tc.got.String(); got != tc.want {
+				t.Errorf("Expected %s, but got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestNewFromBigInt_Copies(t *testing.T) {
+	value := big.NewInt(100)
+	d := NewFromBigInt(value, 0)
+	value.SetInt64(1)
+	if d.String() != "100" {
+		t.Errorf("Expected %s, but got %s", "100", d)
+	}
+	d.Coefficient().SetInt64(5)
+	if d.String() != "100" {
+		t.Errorf("Expected %s, but got %s", "100", d)
+	}
+}
+
+func TestNewFromFloat(t *testing.T) {
+	testCases := []struct {
+		f    float64
+		want string
+	}{
+		{0.1, "0.1"},
+		{19.99, "19.99"},
+		{-2.5e-7, "-0.00000025"},
+		{1e21, "1000000000000000000000"},
+		{0, "0"},
+	}
+
+	for _, tc := range testCases {
+		d, err := NewFromFloat(tc.f)
+		if err != nil || d.String() != tc.want {
+			t.Errorf("NewFromFloat(%v): Expected %s, but got %s (%v)", tc.f, tc.want, d, err)
+		}
+	}
+
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, err := NewFromFloat(f); !errors.Is(err, ErrInvalidFloat) {
+			t.Errorf("NewFromFloat(%v): Expected %v, but got %v", f, ErrInvalidFloat, err)
+		}
+	}
+}
+
+func TestFloat64(t *testing.T) {
+	if got := MustParse("19.99").Float64(); got != 19.99 {
+		t.Errorf("Expected %v, but got %v", 19.99, got)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	a, b := MustParse("1.50"), MustParse("1.5")
+	if !a.Equal(b) || a.Cmp(b) != 0 {
+		t.Errorf("Expected %s to equal %s", a, b)
+	}
+	if !MustParse("-1").LessThan(Zero) || !MustParse("0.01").GreaterThan(Zero) {
+		t.Errorf("Unexpected ordering")
+	}
+	if MustParse("10").Cmp(MustParse("9.999")) != 1 {
+		t.Errorf("Expected 10 > 9.999")
+	}
+
+	signs := map[string]int{"-0.01": -1, "0.00": 0, "3": 1}
+	for s, want := range signs {
+		d := MustParse(s)
+		if d.Sign() != want || d.IsZero() != (want == 0) {
+			t.Errorf("%s: Expected sign %d, but got %d", s, want, d.Sign())
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	testCases := []struct {
+		name string
+		got  Decimal
+		want string
+	}{
+		{"Add", MustParse("1.1").Add(MustParse("2.25")), "3.35"},
+		{"AddExact", MustParse("0.1").Add(MustParse("0.2")), "0.3"},
+		{"AddZeroValue", Decimal{}.Add(MustParse("1.00")), "1.00"},
+		{"Sub", MustParse("1").Sub(MustParse("0.01")), "0.99"},
+		{"SubNegative", MustParse("1.5").Sub(MustParse("3")), "-1.5"},
+		{"Mul", MustParse("19.99").Mul(MustParse("0.08")), "1.5992"},
+		{"MulNegative", MustParse("-0.5").Mul(MustParse("0.5")), "-0.25"},
+		{"Neg", MustParse("2.50").Neg(), "-2.50"},
+		{"Abs", MustParse("-2.50").Abs(), "2.50"},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestArithmetic_"+tc.name, func(t *testing.T) {
+			if got := tc.got.String(); got != tc.want {
+				t.Errorf("Expected %s, but got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestImmutability(t *testing.T) {
+	a := MustParse("1.00")
+	b := a.Add(MustParse("1"))
+	a.Neg()
+	a.Round(0, HalfUp)
+	if a.String() != "1.00" || b.String() != "2.00" {
+		t.Errorf("Expected operands to be unchanged, but got %s and %s", a, b)
+	}
+}
@@ -0,0 +1,136 @@
+package goexdecimal
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrNull 表示从数据库读取到 NULL，需要可空的列时请使用 NullDecimal。
+var ErrNull = errors.New("goexdecimal: cannot scan NULL into Decimal")
+
+// MARK: - Text & JSON
+
+// MarshalText 实现 encoding.TextMarshaler，结果与 String 相同。
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText 实现 encoding.TextUnmarshaler。
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalJSON 实现 json.Marshaler，输出带引号的字符串，例如 "19.90"。
+//
+// 使用字符串而不是 JSON 数字，是为了避免 JavaScript 等以 float64 解析 JSON 的客户端损失精度与小数位。
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON 实现 json.Unmarshaler，同时接受带引号的字符串与 JSON 数字；null 不修改 d。
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		unquoted, err := strconv.Unquote(string(data))
+		if err != nil {
+			return invalidFormat(string(data))
+		}
+		data = []byte(unquoted)
+	}
+	return d.UnmarshalText(data)
+}
+
+// MARK: - SQL
+
+// Value 实现 driver.Valuer，以字符串形式写入数据库，适合 DECIMAL/NUMERIC 列。
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan 实现 sql.Scanner，支持 string、[]byte、int64 与 float64 类型的数据库值。
+//
+// float64 按其最短十进制表示转换；NULL 返回 ErrNull。
+func (d *Decimal) Scan(src any) error {
+	var (
+		parsed Decimal
+		err    error
+	)
+	switch v := src.(type) {
+	case nil:
+		return ErrNull
+	case string:
+		parsed, err = Parse(v)
+	case []byte:
+		parsed, err = Parse(string(v))
+	case int64:
+		parsed = NewFromInt(v)
+	case float64:
+		parsed, err = NewFromFloat(v)
+	default:
+		return fmt.Errorf("goexdecimal: cannot scan %T into Decimal", src)
+	}
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// NullDecimal 表示可能为 NULL 的 Decimal，用法与 sql.NullString 相同。
+type NullDecimal struct {
+	Decimal Decimal
+	// Valid 为 true 表示 Decimal 不是 NULL
+	Valid bool
+}
+
+// Value 实现 driver.Valuer。
+func (n NullDecimal) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Decimal.Value()
+}
+
+// Scan 实现 sql.Scanner。
+func (n *NullDecimal) Scan(src any) error {
+	if src == nil {
+		*n = NullDecimal{}
+		return nil
+	}
+	if err := n.Decimal.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalJSON 实现 json.Marshaler，NULL 输出为 null。
+func (n NullDecimal) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Decimal.MarshalJSON()
+}
+
+// UnmarshalJSON 实现 json.Unmarshaler，null 表示 NULL。
+func (n *NullDecimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*n = NullDecimal{}
+		return nil
+	}
+	if err := n.Decimal.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
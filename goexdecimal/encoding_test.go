@@ -0,0 +1,138 @@
+package goexdecimal
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestJSON(t *testing.T) {
+	type invoice struct {
+		Total Decimal     `json:"total"`
+		Tax   NullDecimal `json:"tax"`
+	}
+
+	t.Run("TestJSON_Marshal", func(t *testing.T) {
+		data, err := json.Marshal(invoice{Total: MustParse("19.90")})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if want := `{"total":"19.90","tax":null}`; string(data) != want {
+			t.Errorf("Expected %s, but got %s", want, data)
+		}
+	})
+
+	t.Run("TestJSON_RoundTrip", func(t *testing.T) {
+		in := invoice{Total: MustParse("123456789012345678.000000001"), Tax: NullDecimal{Decimal: MustParse("0.10"), Valid: true}}
+		data, _ := json.Marshal(in)
+		var out invoice
+		if err := json.Unmarshal(data, &out); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if out.Total.String() != in.Total.String() || !out.Tax.Valid || out.Tax.Decimal.String() != "0.10" {
+			t.Errorf("Expected %+v, but got %+v", in, out)
+		}
+	})
+
+	t.Run("TestJSON_Number", func(t *testing.T) {
+		var out invoice
+		if err := json.Unmarshal([]byte(`{"total":1.50,"tax":2}`), &out); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if out.Total.String() != "1.50" || out.Tax.Decimal.String() != "2" {
+			t.Errorf("Expected 1.50 and 2, but got %+v", out)
+		}
+	})
+
+	t.Run("TestJSON_Null", func(t *testing.T) {
+		out := invoice{Total: MustParse("1"), Tax: NullDecimal{Decimal: MustParse("1"), Valid: true}}
+		if err := json.Unmarshal([]byte(`{"total":null,"tax":null}`), &out); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if out.Total.String() != "1" || out.Tax.Valid {
+			t.Errorf("Expected total unchanged and tax NULL, but got %+v", out)
+		}
+	})
+
+	t.Run("TestJSON_Invalid", func(t *testing.T) {
+		var d Decimal
+		for _, data := range []string{`"abc"`, `true`, `"1.2.3"`} {
+			if err := json.Unmarshal([]byte(data), &d); err == nil {
+				t.Errorf("Expected error for %s", data)
+			}
+		}
+	})
+}
+
+func TestText(t *testing.T) {
+	var d Decimal
+	if err := d.UnmarshalText([]byte("-0.050")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	text, _ := d.MarshalText()
+	if string(text) != "-0.050" {
+		t.Errorf("Expected %s, but got %s", "-0.050", text)
+	}
+	if err := d.UnmarshalText([]byte("x")); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("Expected %v, but got %v", ErrInvalidFormat, err)
+	}
+	if d.String() != "-0.050" {
+		t.Errorf("Expected value unchanged after error, but got %s", d)
+	}
+}
+
+func TestSQL(t *testing.T) {
+	t.Run("TestSQL_Value", func(t *testing.T) {
+		v, err := MustParse("99.90").Value()
+		if err != nil || v != "99.90" {
+			t.Errorf("Expected %v, but got %v (%v)", "99.90", v, err)
+		}
+	})
+
+	t.Run("TestSQL_Scan", func(t *testing.T) {
+		testCases := []struct {
+			src  any
+			want string
+		}{
+			{"12.340", "12.340"},
+			{[]byte("-7.5"), "-7.5"},
+			{int64(42), "42"},
+			{0.1, "0.1"},
+		}
+		for _, tc := range testCases {
+			var d Decimal
+			if err := d.Scan(tc.src); err != nil || d.String() != tc.want {
+				t.Errorf("Scan(%v): Expected %s, but got %s (%v)", tc.src, tc.want, d, err)
+			}
+		}
+	})
+
+	t.Run("TestSQL_ScanErrors", func(t *testing.T) {
+		var d Decimal
+		if err := d.Scan(nil); !errors.Is(err, ErrNull) {
+			t.Errorf("Expected %v, but got %v", ErrNull, err)
+		}
+		if err := d.Scan(true); err == nil {
+			t.Errorf("Expected error for bool")
+		}
+		if err := d.Scan("bad"); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Expected %v, but got %v", ErrInvalidFormat, err)
+		}
+	})
+
+	t.Run("TestSQL_NullDecimal", func(t *testing.T) {
+		var n NullDecimal
+		if err := n.Scan(nil); err != nil || n.Valid {
+			t.Errorf("Expected NULL, but got %+v (%v)", n, err)
+		}
+		if v, _ := n.Value(); v != nil {
+			t.Errorf("Expected nil, but got %v", v)
+		}
+		if err := n.Scan("3.14"); err != nil || !n.Valid || n.Decimal.String() != "3.14" {
+			t.Errorf("Expected 3.14, but got %+v (%v)", n, err)
+		}
+		if v, _ := n.Value(); v != "3.14" {
+			t.Errorf("Expected %v, but got %v", "3.14", v)
+		}
+	})
+}
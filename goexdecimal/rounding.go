@@ -0,0 +1,140 @@
+package goexdecimal
+
+import "math/big"
+
+// RoundingMode 是舍入模式。
+type RoundingMode int
+
+const (
+	// HalfUp 四舍五入，恰好一半时远离零，例如 2.5 -> 3，-2.5 -> -3。
+	HalfUp RoundingMode = iota
+	// HalfEven 四舍六入五成双（银行家舍入），恰好一半时取偶数，例如 2.5 -> 2，3.5 -> 4。
+	HalfEven
+	// HalfDown 五舍六入，恰好一半时趋向零，例如 2.5 -> 2，-2.5 -> -2。
+	HalfDown
+	// Up 远离零舍入，例如 2.1 -> 3，-2.1 -> -3。
+	Up
+	// Down 趋向零舍入（截断），例如 2.9 -> 2，-2.9 -> -2。
+	Down
+	// Ceiling 向正无穷舍入，例如 2.1 -> 3，-2.9 -> -2。
+	Ceiling
+	// Floor 向负无穷舍入，例如 2.9 -> 2，-2.1 -> -3。
+	Floor
+)
+
+// String 返回舍入模式的名称。
+func (m RoundingMode) String() string {
+	switch m {
+	case HalfUp:
+		return "half-up"
+	case HalfEven:
+		return "half-even"
+	case HalfDown:
+		return "half-down"
+	case Up:
+		return "up"
+	case Down:
+		return "down"
+	case Ceiling:
+		return "ceiling"
+	case Floor:
+		return "floor"
+	default:
+		return "unknown"
+	}
+}
+
+// quotient 返回按 mode 舍入到整数的 num / den，den 不能为 0。
+func quotient(num, den *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	// 精确结果的符号，q 为 0 时无法从 q 得到
+	sign := num.Sign() * den.Sign()
+	half := new(big.Int).Abs(r)
+	half.Lsh(half, 1)
+	cmpHalf := half.Cmp(new(big.Int).Abs(den))
+
+	var away bool
+	switch mode {
+	case HalfUp:
+		away = cmpHalf >= 0
+	case HalfEven:
+		away = cmpHalf > 0 || (cmpHalf == 0 && q.Bit(0) == 1)
+	case HalfDown:
+		away = cmpHalf > 0
+	case Up:
+		away = true
+	case Down:
+		away = false
+	case Ceiling:
+		away = sign > 0
+	case Floor:
+		away = sign < 0
+	}
+	if away {
+		q.Add(q, big.NewInt(int64(sign)))
+	}
+	return q
+}
+
+// Round 将 d 按 mode 舍入到 scale 位小数，结果的小数位数恰好为 scale。
+//
+// scale 大于 d 的小数位数时只补零，不改变数值；scale 为负数时舍入到十位、百位等。
+//
+// 示例：
+//   - MustParse("1.005").Round(2, HalfUp) 返回 1.01。
+//   - MustParse("2.345").Round(2, HalfEven) 返回 2.34。
+//   - MustParse("1.5").Round(3, HalfUp) 返回 1.500。
+func (d Decimal) Round(scale int32, mode RoundingMode) Decimal {
+	switch {
+	case scale == d.scale:
+		return d
+	case scale > d.scale:
+		return Decimal{value: new(big.Int).Mul(d.unscaled(), pow10(scale-d.scale)), scale: scale}
+	}
+	value := quotient(d.unscaled(), pow10(d.scale-scale), mode)
+	if scale < 0 {
+		return newFromBig(value, scale)
+	}
+	return Decimal{value: value, scale: scale}
+}
+
+// Truncate 截断 d 到 scale 位小数，等价于 Round(scale, Down)。
+func (d Decimal) Truncate(scale int32) Decimal {
+	return d.Round(scale, Down)
+}
+
+// Div 返回 d ÷ other，结果按 mode 舍入到 scale 位小数。
+//
+// 十进制除法通常无法精确表示（例如 1 ÷ 3），因此必须指定结果的小数位数与舍入模式。
+//
+// 返回值：
+//   - Decimal: 小数位数为 scale 的商。
+//   - error: other 为 0 时返回 ErrDivisionByZero。
+//
+// 示例：
+//   - MustParse("10").Div(MustParse("3"), 4, HalfUp) 返回 3.3333。
+//   - MustParse("-1").Div(MustParse("8"), 2, HalfEven) 返回 -0.12。
+func (d Decimal) Div(other Decimal, scale int32, mode RoundingMode) (Decimal, error) {
+	if other.IsZero() {
+		return Zero, ErrDivisionByZero
+	}
+
+	// d / other = (a × 10^-sa) / (b × 10^-sb)，要得到 q × 10^-scale，需要计算 a × 10^(scale+sb-sa) / b
+	num := new(big.Int).Set(d.unscaled())
+	den := new(big.Int).Set(other.unscaled())
+	if shift := int64(scale) + int64(other.scale) - int64(d.scale); shift >= 0 {
+		num.Mul(num, pow10(int32(shift)))
+	} else {
+		den.Mul(den, pow10(int32(-shift)))
+	}
+
+	value := quotient(num, den, mode)
+	if scale < 0 {
+		return newFromBig(value, scale), nil
+	}
+	return Decimal{value: value, scale: scale}, nil
+}
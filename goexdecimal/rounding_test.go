@@ -0,0 +1,108 @@
+package goexdecimal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRoundingMode_String(t *testing.T) {
+	testCases := []struct {
+		mode RoundingMode
+		want string
+	}{
+		{HalfUp, "half-up"},
+		{HalfEven, "half-even"},
+		{HalfDown, "half-down"},
+		{Up, "up"},
+		{Down, "down"},
+		{Ceiling, "ceiling"},
+		{Floor, "floor"},
+		{RoundingMode(99), "unknown"},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.mode.String(); got != tc.want {
+			t.Errorf("Expected %v, but got %v", tc.want, got)
+		}
+	}
+}
+
+func TestRound(t *testing.T) {
+	inputs := []string{"5.5", "2.5", "1.6", "1.1", "1.0", "-1.0", "-1.1", "-1.6", "-2.5", "-5.5"}
+	// 与 java.math.RoundingMode 文档中的对照表一致
+	expected := map[RoundingMode][]string{
+		Up:       {"6", "3", "2", "2", "1", "-1", "-2", "-2", "-3", "-6"},
+		Down:     {"5", "2", "1", "1", "1", "-1", "-1", "-1", "-2", "-5"},
+		Ceiling:  {"6", "3", "2", "2", "1", "-1", "-1", "-1", "-2", "-5"},
+		Floor:    {"5", "2", "1", "1", "1", "-1", "-2", "-2", "-3", "-6"},
+		HalfUp:   {"6", "3", "2", "1", "1", "-1", "-1", "-2", "-3", "-6"},
+		HalfDown: {"5", "2", "2", "1", "1", "-1", "-1", "-2", "-2", "-5"},
+		HalfEven: {"6", "2", "2", "1", "1", "-1", "-1", "-2", "-2", "-6"},
+	}
+
+	for mode, wants := range expected {
+		for i, input := range inputs {
+			if got := MustParse(input).Round(0, mode).String(); got != wants[i] {
+				t.Errorf("Round(%s, %v): Expected %s, but got %s", input, mode, wants[i], got)
+			}
+		}
+	}
+}
+
+func TestRound_Scale(t *testing.T) {
+	testCases := []struct {
+		name string
+		got  Decimal
+		want string
+	}{
+		{"HalfUp", MustParse("1.005").Round(2, HalfUp), "1.01"},
+		{"HalfEven", MustParse("2.345").Round(2, HalfEven), "2.34"},
+		{"Pad", MustParse("1.5").Round(3, HalfUp), "1.500"},
+		{"Same", MustParse("1.50").Round(2, Up), "1.50"},
+		{"SmallToZero", MustParse("0.004").Round(2, HalfUp), "0.00"},
+		{"Carry", MustParse("9.995").Round(2, HalfUp), "10.00"},
+		{"NegativeScale", MustParse("1250").Round(-2, HalfEven), "1200"},
+		{"NegativeScaleUp", MustParse("1251.7").Round(-2, HalfUp), "1300"},
+		{"Truncate", MustParse("-2.999").Truncate(1), "-2.9"},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestRound_"+tc.name, func(t *testing.T) {
+			if got := tc.got.String(); got != tc.want {
+				t.Errorf("Expected %s, but got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDiv(t *testing.T) {
+	testCases := []struct {
+		a, b  string
+		scale int32
+		mode  RoundingMode
+		want  string
+	}{
+		{"10", "3", 4, HalfUp, "3.3333"},
+		{"20", "3", 2, HalfUp, "6.67"},
+		{"20", "3", 2, Down, "6.66"},
+		{"-1", "8", 2, HalfEven, "-0.12"},
+		{"-1", "8", 2, HalfUp, "-0.13"},
+		{"1", "-8", 3, HalfUp, "-0.125"},
+		{"1.5", "0.5", 0, HalfUp, "3"},
+		{"0.001", "1000", 2, Ceiling, "0.01"},
+		{"123.456", "0.001", 2, HalfUp, "123456.00"},
+		{"1000", "3", -1, HalfUp, "330"},
+		{"0", "7", 2, Up, "0.00"},
+	}
+
+	for _, tc := range testCases {
+		got, err := MustParse(tc.a).Div(MustParse(tc.b), tc.scale, tc.mode)
+		if err != nil || got.String() != tc.want {
+			t.Errorf("%s / %s: Expected %s, but got %s (%v)", tc.a, tc.b, tc.want, got, err)
+		}
+	}
+
+	if _, err := MustParse("1").Div(MustParse("0.00"), 2, HalfUp); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("Expected %v, but got %v", ErrDivisionByZero, err)
+	}
+}
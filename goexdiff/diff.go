@@ -0,0 +1,364 @@
+package goexdiff
+
+// Op 是编辑操作的类型。
+type Op int
+
+const (
+	// OpEqual 表示元素在两个序列中相同。
+	OpEqual Op = iota
+	// OpDelete 表示元素从旧序列中删除。
+	OpDelete
+	// OpInsert 表示元素插入到新序列中。
+	OpInsert
+)
+
+// String 返回操作的名称。
+func (o Op) String() string {
+	switch o {
+	case OpEqual:
+		return "equal"
+	case OpDelete:
+		return "delete"
+	case OpInsert:
+		return "insert"
+	default:
+		return "unknown"
+	}
+}
+
+// Hunk 是编辑脚本中的一段连续操作。
+//
+// 对于 OpEqual 与 OpDelete，Values 是旧序列 a[OldStart:OldStart+len(Values)] 中的元素；
+// 对于 OpInsert，Values 是新序列 b[NewStart:NewStart+len(Values)] 中的元素。
+// 另一侧的起始位置表示该段在另一个序列中对应的位置。
+type Hunk[E any] struct {
+	Op       Op
+	OldStart int
+	NewStart int
+	Values   []E
+}
+
+// MARK: - Diff
+
+// Diff 使用 Myers 算法计算把 a 变为 b 的最短编辑脚本。
+//
+// 返回的 Hunk 按顺序覆盖 a 与 b 的全部元素；同一处改动中删除段总是排在插入段之前，相邻的 Hunk 操作类型不同。
+//
+// 参数：
+//   - a: 旧序列。
+//   - b: 新序列。
+//
+// 返回值：
+//   - []Hunk[E]: 编辑脚本，两个序列都为空时返回 nil。
+//
+// 示例：
+//   - Diff([]string{"a", "b", "c"}, []string{"a", "c", "d"}) 返回
+//     [{equal 0 0 [a]} {delete 1 1 [b]} {equal 2 1 [c]} {insert 3 2 [d]}]。
+func Diff[E comparable](a, b []E) []Hunk[E] {
+	return buildHunks(a, b, myers(a, b))
+}
+
+// DiffFunc 与 Diff 相同，但通过 key 提取的键判断元素是否相同，适合比较结构体等不可比较或只需按 ID 比较的元素。
+//
+// 键相同的元素被视为相等，OpEqual 段中保存的是旧序列中的元素。
+//
+// 示例：
+//   - DiffFunc(oldUsers, newUsers, func(u User) int { return u.ID })
+func DiffFunc[E any, K comparable](a, b []E, key func(E) K) []Hunk[E] {
+	return buildHunks(a, b, myers(keys(a, key), keys(b, key)))
+}
+
+// PatienceDiff 使用 patience 算法计算把 a 变为 b 的编辑脚本。
+//
+// patience 算法先以两个序列中都只出现一次的元素作为锚点对齐，再在锚点之间递归比较，
+// 剩余部分退回到 Myers 算法。对源代码这类含有大量重复行（空行、括号）的文本，结果通常更符合人的阅读习惯，
+// 但不保证是最短的编辑脚本。
+func PatienceDiff[E comparable](a, b []E) []Hunk[E] {
+	return buildHunks(a, b, patience(a, b))
+}
+
+// PatienceDiffFunc 与 PatienceDiff 相同，但通过 key 提取的键判断元素是否相同。
+func PatienceDiffFunc[E any, K comparable](a, b []E, key func(E) K) []Hunk[E] {
+	return buildHunks(a, b, patience(keys(a, key), keys(b, key)))
+}
+
+func keys[E any, K comparable](values []E, key func(E) K) []K {
+	result := make([]K, len(values))
+	for i, value := range values {
+		result[i] = key(value)
+	}
+	return result
+}
+
+// buildHunks 将逐元素的操作序列合并为 Hunk，并保证每处改动中删除段在插入段之前。
+func buildHunks[E any](a, b []E, ops []Op) []Hunk[E] {
+	var hunks []Hunk[E]
+	oldIndex, newIndex := 0, 0
+	for i := 0; i < len(ops); {
+		if ops[i] == OpEqual {
+			start := i
+			for i < len(ops) && ops[i] == OpEqual {
+				i++
+			}
+			n := i - start
+			hunks = append(hunks, Hunk[E]{Op: OpEqual, OldStart: oldIndex, NewStart: newIndex, Values: a[oldIndex : oldIndex+n]})
+			oldIndex += n
+			newIndex += n
+			continue
+		}
+
+		deletes, inserts := 0, 0
+		for ; i < len(ops) && ops[i] != OpEqual; i++ {
+			if ops[i] == OpDelete {
+				deletes++
+			} else {
+				inserts++
+			}
+		}
+		if deletes > 0 {
+			hunks = append(hunks, Hunk[E]{Op: OpDelete, OldStart: oldIndex, NewStart: newIndex, Values: a[oldIndex : oldIndex+deletes]})
+		}
+		if inserts > 0 {
+			hunks = append(hunks, Hunk[E]{Op: OpInsert, OldStart: oldIndex + deletes, NewStart: newIndex, Values: b[newIndex : newIndex+inserts]})
+		}
+		oldIndex += deletes
+		newIndex += inserts
+	}
+	return hunks
+}
+
+// MARK: - Myers
+
+// myers 返回把 a 变为 b 的逐元素操作序列。
+func myers[K comparable](a, b []K) []Op {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]Op, 0, len(a)+len(b))
+	for i := 0; i < prefix; i++ {
+		ops = append(ops, OpEqual)
+	}
+	ops = append(ops, myersCore(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for i := 0; i < suffix; i++ {
+		ops = append(ops, OpEqual)
+	}
+	return ops
+}
+
+// myersCore 是线性空间的 Myers 算法：找到最短编辑路径的中间蛇形后分治求解两侧，
+// 内存占用为 O(N+M)，时间复杂度为 O((N+M)·D)。
+func myersCore[K comparable](a, b []K) []Op {
+	size := 2*((len(a)+len(b)+1)/2) + 3
+	s := &myersState[K]{
+		forward:  make([]int, size),
+		backward: make([]int, size),
+		ops:      make([]Op, 0, len(a)+len(b)),
+	}
+	s.compare(a, b)
+	return s.ops
+}
+
+// myersState 保存分治过程中复用的前沿数组与生成的操作序列。
+type myersState[K comparable] struct {
+	forward  []int
+	backward []int
+	ops      []Op
+}
+
+func (s *myersState[K]) compare(a, b []K) {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	s.repeat(OpEqual, prefix)
+	a, b = a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+
+	switch {
+	case len(a) == 0:
+		s.repeat(OpInsert, len(b))
+	case len(b) == 0:
+		s.repeat(OpDelete, len(a))
+	default:
+		x, y, u, v := s.middleSnake(a, b)
+		s.compare(a[:x], b[:y])
+		s.repeat(OpEqual, u-x)
+		s.compare(a[u:], b[v:])
+	}
+	s.repeat(OpEqual, suffix)
+}
+
+func (s *myersState[K]) repeat(op Op, count int) {
+	for i := 0; i < count; i++ {
+		s.ops = append(s.ops, op)
+	}
+}
+
+// middleSnake 同时从起点正向、从终点反向搜索，返回两个方向的路径首次重叠处的蛇形 (x, y) → (u, v)。
+// 调用方保证 a、b 非空且首尾元素均不相等，因此编辑距离至少为 2，两侧的子问题都严格变小。
+func (s *myersState[K]) middleSnake(a, b []K) (x, y, u, v int) {
+	n, m := len(a), len(b)
+	delta := n - m
+	odd := delta%2 != 0
+	limit := (n + m + 1) / 2
+	offset := limit + 1
+	// forward[offset+k] 是正向对角线 k 上能到达的最远 x；
+	// backward[offset+k] 是从终点反向、在反转序列的对角线 k 上能到达的最远 x
+	forward, backward := s.forward, s.backward
+	forward[offset+1], backward[offset+1] = 0, 0
+
+	for d := 0; d <= limit; d++ {
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && forward[offset+k-1] < forward[offset+k+1]) {
+				x = forward[offset+k+1]
+			} else {
+				x = forward[offset+k-1] + 1
+			}
+			y := x - k
+			startX, startY := x, y
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			forward[offset+k] = x
+			// 正向对角线 k 对应反向对角线 delta-k
+			if rk := delta - k; odd && rk >= -(d-1) && rk <= d-1 && x+backward[offset+rk] >= n {
+				return startX, startY, x, y
+			}
+		}
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && backward[offset+k-1] < backward[offset+k+1]) {
+				x = backward[offset+k+1]
+			} else {
+				x = backward[offset+k-1] + 1
+			}
+			y := x - k
+			startX, startY := x, y
+			for x < n && y < m && a[n-1-x] == b[m-1-y] {
+				x++
+				y++
+			}
+			backward[offset+k] = x
+			if fk := delta - k; !odd && fk >= -d && fk <= d && x+forward[offset+fk] >= n {
+				return n - x, m - y, n - startX, m - startY
+			}
+		}
+	}
+	panic("goexdiff: middle snake not found")
+}
+
+// MARK: - Patience
+
+// patience 返回把 a 变为 b 的逐元素操作序列。
+func patience[K comparable](a, b []K) []Op {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]Op, 0, len(a)+len(b))
+	for i := 0; i < prefix; i++ {
+		ops = append(ops, OpEqual)
+	}
+
+	midA, midB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	anchors := uniqueAnchors(midA, midB)
+	if len(anchors) == 0 {
+		ops = append(ops, myersCore(midA, midB)...)
+	} else {
+		lastA, lastB := 0, 0
+		for _, anchor := range anchors {
+			ops = append(ops, patience(midA[lastA:anchor[0]], midB[lastB:anchor[1]])...)
+			ops = append(ops, OpEqual)
+			lastA, lastB = anchor[0]+1, anchor[1]+1
+		}
+		ops = append(ops, patience(midA[lastA:], midB[lastB:])...)
+	}
+
+	for i := 0; i < suffix; i++ {
+		ops = append(ops, OpEqual)
+	}
+	return ops
+}
+
+// uniqueAnchors 找出在 a 与 b 中都恰好出现一次的元素，并返回其中按两侧位置都递增的最长序列。
+func uniqueAnchors[K comparable](a, b []K) [][2]int {
+	type occurrence struct {
+		countA, countB int
+		indexA, indexB int
+	}
+	table := make(map[K]*occurrence)
+	for i, key := range a {
+		o, ok := table[key]
+		if !ok {
+			o = &occurrence{}
+			table[key] = o
+		}
+		o.countA++
+		o.indexA = i
+	}
+	for j, key := range b {
+		if o, ok := table[key]; ok {
+			o.countB++
+			o.indexB = j
+		}
+	}
+
+	// 按在 a 中的位置排列的候选锚点
+	var candidates [][2]int
+	for _, key := range a {
+		if o := table[key]; o.countA == 1 && o.countB == 1 {
+			candidates = append(candidates, [2]int{o.indexA, o.indexB})
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	// 耐心排序求 b 位置的最长递增子序列
+	var tails []int
+	prev := make([]int, len(candidates))
+	for i, candidate := range candidates {
+		lo, hi := 0, len(tails)
+		for lo < hi {
+			mid := (lo + hi) / 2
+			if candidates[tails[mid]][1] < candidate[1] {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+		if lo > 0 {
+			prev[i] = tails[lo-1]
+		} else {
+			prev[i] = -1
+		}
+		if lo == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[lo] = i
+		}
+	}
+
+	result := make([][2]int, len(tails))
+	for i, k := len(tails)-1, tails[len(tails)-1]; i >= 0; i, k = i-1, prev[k] {
+		result[i] = candidates[k]
+	}
+	return result
+}
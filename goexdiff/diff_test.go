@@ -0,0 +1,228 @@
+package goexdiff
+
+import (
+	"math/rand"
+	"reflect"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// replay 依据编辑脚本重建两个序列，用于检查 Hunk 是否完整且位置正确。
+func replay[E any](t *testing.T, a, b []E, hunks []Hunk[E]) (oldSeq, newSeq []E) {
+	t.Helper()
+	oldIndex, newIndex := 0, 0
+	for i, hunk := range hunks {
+		if i > 0 && hunks[i-1].Op == hunk.Op {
+			t.Fatalf("adjacent hunks share op %v: %v", hunk.Op, hunks)
+		}
+		if hunk.OldStart != oldIndex || hunk.NewStart != newIndex {
+			t.Fatalf("hunk %d starts at (%d, %d), want (%d, %d)", i, hunk.OldStart, hunk.NewStart, oldIndex, newIndex)
+		}
+		switch hunk.Op {
+		case OpEqual:
+			oldSeq = append(oldSeq, hunk.Values...)
+			newSeq = append(newSeq, b[newIndex:newIndex+len(hunk.Values)]...)
+			oldIndex += len(hunk.Values)
+			newIndex += len(hunk.Values)
+		case OpDelete:
+			oldSeq = append(oldSeq, hunk.Values...)
+			oldIndex += len(hunk.Values)
+		case OpInsert:
+			newSeq = append(newSeq, hunk.Values...)
+			newIndex += len(hunk.Values)
+		}
+	}
+	return oldSeq, newSeq
+}
+
+func editCount[E any](hunks []Hunk[E]) int {
+	count := 0
+	for _, hunk := range hunks {
+		if hunk.Op != OpEqual {
+			count += len(hunk.Values)
+		}
+	}
+	return count
+}
+
+// lcsLength 是用于对照的动态规划实现。
+func lcsLength(a, b []int) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				dp[i][j] = dp[i-1][j-1] + 1
+			} else {
+				dp[i][j] = max(dp[i-1][j], dp[i][j-1])
+			}
+		}
+	}
+	return dp[len(a)][len(b)]
+}
+
+func TestOp_String(t *testing.T) {
+	testCases := []struct {
+		op   Op
+		want string
+	}{
+		{OpEqual, "equal"},
+		{OpDelete, "delete"},
+		{OpInsert, "insert"},
+		{Op(9), "unknown"},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.op.String(); got != tc.want {
+			t.Errorf("Expected %v, but got %v", tc.want, got)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	testCases := []struct {
+		name string
+		a, b []string
+		want []Hunk[string]
+	}{
+		{"Empty", nil, nil, nil},
+		{"Equal", []string{"a", "b"}, []string{"a", "b"}, []Hunk[string]{
+			{Op: OpEqual, OldStart: 0, NewStart: 0, Values: []string{"a", "b"}},
+		}},
+		{"InsertAll", nil, []string{"a"}, []Hunk[string]{
+			{Op: OpInsert, OldStart: 0, NewStart: 0, Values: []string{"a"}},
+		}},
+		{"DeleteAll", []string{"a", "b"}, nil, []Hunk[string]{
+			{Op: OpDelete, OldStart: 0, NewStart: 0, Values: []string{"a", "b"}},
+		}},
+		{"Mixed", []string{"a", "b", "c"}, []string{"a", "c", "d"}, []Hunk[string]{
+			{Op: OpEqual, OldStart: 0, NewStart: 0, Values: []string{"a"}},
+			{Op: OpDelete, OldStart: 1, NewStart: 1, Values: []string{"b"}},
+			{Op: OpEqual, OldStart: 2, NewStart: 1, Values: []string{"c"}},
+			{Op: OpInsert, OldStart: 3, NewStart: 2, Values: []string{"d"}},
+		}},
+		{"Replace", []string{"a", "b", "c"}, []string{"a", "x", "y", "c"}, []Hunk[string]{
+			{Op: OpEqual, OldStart: 0, NewStart: 0, Values: []string{"a"}},
+			{Op: OpDelete, OldStart: 1, NewStart: 1, Values: []string{"b"}},
+			{Op: OpInsert, OldStart: 2, NewStart: 1, Values: []string{"x", "y"}},
+			{Op: OpEqual, OldStart: 2, NewStart: 3, Values: []string{"c"}},
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestDiff_"+tc.name, func(t *testing.T) {
+			if got := Diff(tc.a, tc.b); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Expected %v, but got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDiff_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := func() []int {
+		s := make([]int, r.Intn(30))
+		for i := range s {
+			s[i] = r.Intn(5)
+		}
+		return s
+	}
+
+	for i := 0; i < 2000; i++ {
+		a, b := random(), random()
+
+		hunks := Diff(a, b)
+		oldSeq, newSeq := replay(t, a, b, hunks)
+		if !slices.Equal(oldSeq, a) || !slices.Equal(newSeq, b) {
+			t.Fatalf("Diff(%v, %v) does not cover both sequences: %v", a, b, hunks)
+		}
+		if want := len(a) + len(b) - 2*lcsLength(a, b); editCount(hunks) != want {
+			t.Fatalf("Diff(%v, %v): Expected %d edits, but got %d", a, b, want, editCount(hunks))
+		}
+
+		hunks = PatienceDiff(a, b)
+		oldSeq, newSeq = replay(t, a, b, hunks)
+		if !slices.Equal(oldSeq, a) || !slices.Equal(newSeq, b) {
+			t.Fatalf("PatienceDiff(%v, %v) does not cover both sequences: %v", a, b, hunks)
+		}
+	}
+}
+
+// TestDiff_LinearSpace 验证完全不同的长序列的内存占用与长度成线性关系，而不是随编辑距离平方增长。
+func TestDiff_LinearSpace(t *testing.T) {
+	a, b := make([]int, 4000), make([]int, 4000)
+	for i := range a {
+		a[i], b[i] = i, -i-1
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	hunks := Diff(a, b)
+	runtime.ReadMemStats(&after)
+
+	if editCount(hunks) != len(a)+len(b) {
+		t.Errorf("Expected %d edits, but got %d", len(a)+len(b), editCount(hunks))
+	}
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 4<<20 {
+		t.Errorf("Expected at most 4MB allocated, but got %d bytes", allocated)
+	}
+}
+
+func TestDiffFunc(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	a := []user{{1, "alice"}, {2, "bob"}, {3, "carol"}}
+	b := []user{{1, "Alice"}, {3, "carol"}, {4, "dave"}}
+
+	got := DiffFunc(a, b, func(u user) int { return u.ID })
+	want := []Hunk[user]{
+		{Op: OpEqual, OldStart: 0, NewStart: 0, Values: []user{{1, "alice"}}},
+		{Op: OpDelete, OldStart: 1, NewStart: 1, Values: []user{{2, "bob"}}},
+		{Op: OpEqual, OldStart: 2, NewStart: 1, Values: []user{{3, "carol"}}},
+		{Op: OpInsert, OldStart: 3, NewStart: 2, Values: []user{{4, "dave"}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, but got %v", want, got)
+	}
+}
+
+func TestPatienceDiff(t *testing.T) {
+	// 经典示例：Myers 会把两个函数的结尾括号对齐，patience 则以唯一的函数签名为锚点
+	a := strings.Split("void a() {\n  foo();\n}\n\nvoid b() {\n  bar();\n}", "\n")
+	b := strings.Split("void b() {\n  bar();\n}\n\nvoid a() {\n  foo();\n}", "\n")
+
+	hunks := PatienceDiff(a, b)
+	oldSeq, newSeq := replay(t, a, b, hunks)
+	if !reflect.DeepEqual(oldSeq, a) || !reflect.DeepEqual(newSeq, b) {
+		t.Fatalf("PatienceDiff does not cover both sequences: %v", hunks)
+	}
+	var equal []string
+	for _, hunk := range hunks {
+		if hunk.Op == OpEqual {
+			equal = append(equal, hunk.Values...)
+		}
+	}
+	if want := []string{"void b() {", "  bar();", "}"}; !reflect.DeepEqual(equal, want) {
+		t.Errorf("Expected anchors %q, but got %q", want, equal)
+	}
+}
+
+func TestPatienceDiffFunc(t *testing.T) {
+	a := []string{"A", "b", "C"}
+	b := []string{"a", "B", "d"}
+	got := PatienceDiffFunc(a, b, strings.ToLower)
+	want := []Hunk[string]{
+		{Op: OpEqual, OldStart: 0, NewStart: 0, Values: []string{"A", "b"}},
+		{Op: OpDelete, OldStart: 2, NewStart: 2, Values: []string{"C"}},
+		{Op: OpInsert, OldStart: 3, NewStart: 2, Values: []string{"d"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, but got %v", want, got)
+	}
+}
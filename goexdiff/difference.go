@@ -0,0 +1,150 @@
+package goexdiff
+
+import "errors"
+
+// ErrNotApplicable 表示差异无法应用到给定的序列上，通常是因为序列不是计算差异时使用的旧序列。
+var ErrNotApplicable = errors.New("goexdiff: difference is not applicable to the collection")
+
+// Change 是 Difference 中的一次插入或删除，相当于 Swift 中的 CollectionDifference.Change。
+type Change[E any] struct {
+	// Op 是 OpInsert 或 OpDelete。
+	Op Op
+	// Offset 对删除而言是元素在旧序列中的位置，对插入而言是元素在新序列中的位置。
+	Offset int
+	// Element 是被插入或删除的元素。
+	Element E
+	// AssociatedWith 是与之配对的另一侧变更的 Offset，表示元素被移动；没有配对时为 -1。
+	AssociatedWith int
+}
+
+// Difference 描述把一个序列变为另一个序列所需的删除与插入，相当于 Swift 中的 CollectionDifference。
+//
+// Removals 按 Offset 升序排列，Offset 指向旧序列；Insertions 按 Offset 升序排列，Offset 指向新序列。
+type Difference[E any] struct {
+	Removals   []Change[E]
+	Insertions []Change[E]
+}
+
+// NewDifference 使用 Myers 算法计算把 a 变为 b 的 Difference。
+//
+// 示例：
+//   - NewDifference([]int{1, 2, 3}, []int{1, 3, 4}) 返回删除 offset 1 处的 2、插入 offset 2 处的 4。
+func NewDifference[E comparable](a, b []E) Difference[E] {
+	return FromHunks(Diff(a, b))
+}
+
+// FromHunks 将编辑脚本转换为 Difference，OpEqual 段会被忽略。
+func FromHunks[E any](hunks []Hunk[E]) Difference[E] {
+	var d Difference[E]
+	for _, hunk := range hunks {
+		for i, value := range hunk.Values {
+			switch hunk.Op {
+			case OpDelete:
+				d.Removals = append(d.Removals, Change[E]{Op: OpDelete, Offset: hunk.OldStart + i, Element: value, AssociatedWith: -1})
+			case OpInsert:
+				d.Insertions = append(d.Insertions, Change[E]{Op: OpInsert, Offset: hunk.NewStart + i, Element: value, AssociatedWith: -1})
+			}
+		}
+	}
+	return d
+}
+
+// IsEmpty 判断差异是否不包含任何变更。
+func (d Difference[E]) IsEmpty() bool {
+	return len(d.Removals) == 0 && len(d.Insertions) == 0
+}
+
+// Len 返回变更的总数。
+func (d Difference[E]) Len() int {
+	return len(d.Removals) + len(d.Insertions)
+}
+
+// Apply 将差异应用到 base 上并返回新的切片，base 不会被修改。
+//
+// 返回值：
+//   - []E: 应用后的序列。
+//   - error: 删除的 Offset 超出 base 范围，或插入的 Offset 超出结果范围时返回 ErrNotApplicable。
+//
+// 示例：
+//   - d := NewDifference(a, b); d.Apply(a) 返回与 b 相同的切片。
+func (d Difference[E]) Apply(base []E) ([]E, error) {
+	size := len(base) - len(d.Removals) + len(d.Insertions)
+	if size < 0 {
+		return nil, ErrNotApplicable
+	}
+	result := make([]E, 0, size)
+	baseIndex, removal, insertion := 0, 0, 0
+	for len(result) < size {
+		if insertion < len(d.Insertions) && d.Insertions[insertion].Offset == len(result) {
+			result = append(result, d.Insertions[insertion].Element)
+			insertion++
+			continue
+		}
+		if baseIndex >= len(base) {
+			return nil, ErrNotApplicable
+		}
+		if removal < len(d.Removals) && d.Removals[removal].Offset == baseIndex {
+			removal++
+		} else {
+			result = append(result, base[baseIndex])
+		}
+		baseIndex++
+	}
+	// 结果末尾之后可能还剩下需要删除的元素
+	for ; baseIndex < len(base) && removal < len(d.Removals) && d.Removals[removal].Offset == baseIndex; baseIndex++ {
+		removal++
+	}
+	if baseIndex != len(base) || removal != len(d.Removals) || insertion != len(d.Insertions) {
+		return nil, ErrNotApplicable
+	}
+	return result, nil
+}
+
+// Inverse 返回相反的差异：应用到新序列上可以得到旧序列。
+func (d Difference[E]) Inverse() Difference[E] {
+	inverse := Difference[E]{
+		Removals:   make([]Change[E], len(d.Insertions)),
+		Insertions: make([]Change[E], len(d.Removals)),
+	}
+	for i, change := range d.Insertions {
+		change.Op = OpDelete
+		inverse.Removals[i] = change
+	}
+	for i, change := range d.Removals {
+		change.Op = OpInsert
+		inverse.Insertions[i] = change
+	}
+	return inverse
+}
+
+// InferMoves 返回推断了移动关系的差异：当一个元素只被删除一次、也只被插入一次时，
+// 这对删除与插入会通过 AssociatedWith 互相关联，相当于 Swift 中的 inferringMoves()。
+//
+// 示例：
+//   - InferMoves(NewDifference([]string{"a", "b", "c"}, []string{"b", "c", "a"})) 中，
+//     删除 offset 0 的 "a" 与插入 offset 2 的 "a" 互相关联。
+func InferMoves[E comparable](d Difference[E]) Difference[E] {
+	removed := make(map[E][]int)
+	for i, change := range d.Removals {
+		removed[change.Element] = append(removed[change.Element], i)
+	}
+	inserted := make(map[E][]int)
+	for i, change := range d.Insertions {
+		inserted[change.Element] = append(inserted[change.Element], i)
+	}
+
+	result := Difference[E]{
+		Removals:   append([]Change[E](nil), d.Removals...),
+		Insertions: append([]Change[E](nil), d.Insertions...),
+	}
+	for element, removals := range removed {
+		insertions := inserted[element]
+		if len(removals) != 1 || len(insertions) != 1 {
+			continue
+		}
+		r, i := removals[0], insertions[0]
+		result.Removals[r].AssociatedWith = result.Insertions[i].Offset
+		result.Insertions[i].AssociatedWith = result.Removals[r].Offset
+	}
+	return result
+}
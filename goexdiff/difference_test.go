@@ -0,0 +1,98 @@
+package goexdiff
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestNewDifference(t *testing.T) {
+	got := NewDifference([]int{1, 2, 3}, []int{1, 3, 4})
+	want := Difference[int]{
+		Removals:   []Change[int]{{Op: OpDelete, Offset: 1, Element: 2, AssociatedWith: -1}},
+		Insertions: []Change[int]{{Op: OpInsert, Offset: 2, Element: 4, AssociatedWith: -1}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, but got %v", want, got)
+	}
+	if got.IsEmpty() || got.Len() != 2 {
+		t.Errorf("Expected 2 changes, but got %d", got.Len())
+	}
+	if !NewDifference([]int{1}, []int{1}).IsEmpty() {
+		t.Errorf("Expected empty difference")
+	}
+}
+
+func TestDifference_ApplyInverse(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	random := func() []int {
+		s := make([]int, r.Intn(20))
+		for i := range s {
+			s[i] = r.Intn(6)
+		}
+		return s
+	}
+
+	for i := 0; i < 300; i++ {
+		a, b := random(), random()
+		d := NewDifference(a, b)
+
+		got, err := d.Apply(a)
+		if err != nil || !slices.Equal(got, b) {
+			t.Fatalf("Apply(%v) with diff to %v: got %v, %v", a, b, got, err)
+		}
+		back, err := d.Inverse().Apply(b)
+		if err != nil || !slices.Equal(back, a) {
+			t.Fatalf("Inverse().Apply(%v) with diff to %v: got %v, %v", b, a, back, err)
+		}
+	}
+}
+
+func TestDifference_ApplyDoesNotModifyBase(t *testing.T) {
+	base := []string{"a", "b", "c"}
+	d := NewDifference(base, []string{"c", "b"})
+	if _, err := d.Apply(base); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(base, want) {
+		t.Errorf("Expected %v, but got %v", want, base)
+	}
+}
+
+func TestDifference_ApplyNotApplicable(t *testing.T) {
+	d := NewDifference([]int{1, 2, 3, 4}, []int{1, 4, 5})
+	for _, base := range [][]int{nil, {1}, {1, 2}} {
+		if _, err := d.Apply(base); !errors.Is(err, ErrNotApplicable) {
+			t.Errorf("Apply(%v): Expected %v, but got %v", base, ErrNotApplicable, err)
+		}
+	}
+
+	unsorted := Difference[int]{Removals: []Change[int]{
+		{Op: OpDelete, Offset: 2, AssociatedWith: -1},
+		{Op: OpDelete, Offset: 0, AssociatedWith: -1},
+	}}
+	if _, err := unsorted.Apply([]int{1, 2, 3}); !errors.Is(err, ErrNotApplicable) {
+		t.Errorf("Expected %v, but got %v", ErrNotApplicable, err)
+	}
+}
+
+func TestInferMoves(t *testing.T) {
+	d := InferMoves(NewDifference([]string{"a", "b", "c", "x", "x"}, []string{"b", "c", "a", "y"}))
+
+	want := Difference[string]{
+		Removals: []Change[string]{
+			{Op: OpDelete, Offset: 0, Element: "a", AssociatedWith: 2},
+			{Op: OpDelete, Offset: 3, Element: "x", AssociatedWith: -1},
+			{Op: OpDelete, Offset: 4, Element: "x", AssociatedWith: -1},
+		},
+		Insertions: []Change[string]{
+			{Op: OpInsert, Offset: 2, Element: "a", AssociatedWith: 0},
+			{Op: OpInsert, Offset: 3, Element: "y", AssociatedWith: -1},
+		},
+	}
+	if !reflect.DeepEqual(d, want) {
+		t.Errorf("Expected %v, but got %v", want, d)
+	}
+}
@@ -0,0 +1,130 @@
+package goexdiff
+
+import (
+	"strconv"
+	"strings"
+)
+
+// DefaultContext 是统一格式差异中每处改动前后默认保留的上下文行数，与 diff -u 相同。
+const DefaultContext = 3
+
+// line 是展开后的单行操作，old 与 new 是该行之前（含）在两个序列中的位置。
+type line struct {
+	op       Op
+	old, new int
+	text     string
+}
+
+// Unified 以统一格式（diff -u / git diff 的格式）渲染两组文本行之间的差异。
+//
+// 参数：
+//   - oldName: 旧文件名，写在 "---" 行中。
+//   - newName: 新文件名，写在 "+++" 行中。
+//   - a: 旧文本的各行，不含换行符，例如 strings.Split(text, "\n") 的结果。
+//   - b: 新文本的各行。
+//   - context: 每处改动前后保留的上下文行数，小于 0 时按 0 处理，通常使用 DefaultContext。
+//
+// 返回值：
+//   - string: 统一格式的差异文本，两组文本相同时返回空字符串。
+//
+// 示例：
+//
+//	Unified("a.txt", "b.txt", []string{"a", "b", "c"}, []string{"a", "c", "d"}, 3) 返回
+//
+//	--- a.txt
+//	+++ b.txt
+//	@@ -1,3 +1,3 @@
+//	 a
+//	-b
+//	 c
+//	+d
+func Unified(oldName, newName string, a, b []string, context int) string {
+	return renderUnified(oldName, newName, Diff(a, b), context)
+}
+
+// UnifiedHunks 与 Unified 相同，但渲染已经计算好的编辑脚本，例如 PatienceDiff 的结果。
+func UnifiedHunks(oldName, newName string, hunks []Hunk[string], context int) string {
+	return renderUnified(oldName, newName, hunks, context)
+}
+
+func renderUnified(oldName, newName string, hunks []Hunk[string], context int) string {
+	context = max(context, 0)
+
+	var lines []line
+	var changes []int
+	for _, hunk := range hunks {
+		for i, text := range hunk.Values {
+			l := line{op: hunk.Op, old: hunk.OldStart, new: hunk.NewStart, text: text}
+			switch hunk.Op {
+			case OpEqual:
+				l.old += i
+				l.new += i
+			case OpDelete:
+				l.old += i
+			case OpInsert:
+				l.new += i
+			}
+			if hunk.Op != OpEqual {
+				changes = append(changes, len(lines))
+			}
+			lines = append(lines, l)
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+
+	var builder strings.Builder
+	builder.WriteString("--- " + oldName + "\n")
+	builder.WriteString("+++ " + newName + "\n")
+	for i := 0; i < len(changes); {
+		// 相邻改动之间的相同行不超过 2*context 时合并为同一段
+		j := i
+		for j+1 < len(changes) && changes[j+1]-changes[j]-1 <= 2*context {
+			j++
+		}
+		start := max(changes[i]-context, 0)
+		end := min(changes[j]+context+1, len(lines))
+		writeUnifiedHunk(&builder, lines[start:end])
+		i = j + 1
+	}
+	return builder.String()
+}
+
+func writeUnifiedHunk(builder *strings.Builder, lines []line) {
+	oldCount, newCount := 0, 0
+	for _, l := range lines {
+		if l.op != OpInsert {
+			oldCount++
+		}
+		if l.op != OpDelete {
+			newCount++
+		}
+	}
+	builder.WriteString("@@ -" + unifiedRange(lines[0].old, oldCount) + " +" + unifiedRange(lines[0].new, newCount) + " @@\n")
+	for _, l := range lines {
+		switch l.op {
+		case OpEqual:
+			builder.WriteByte(' ')
+		case OpDelete:
+			builder.WriteByte('-')
+		case OpInsert:
+			builder.WriteByte('+')
+		}
+		builder.WriteString(l.text)
+		builder.WriteByte('\n')
+	}
+}
+
+// unifiedRange 按 diff -u 的约定格式化行范围：行号从 1 开始，只有一行时省略行数，
+// 范围为空时行号指向其前一行。
+func unifiedRange(start, count int) string {
+	switch count {
+	case 0:
+		return strconv.Itoa(start) + ",0"
+	case 1:
+		return strconv.Itoa(start + 1)
+	default:
+		return strconv.Itoa(start+1) + "," + strconv.Itoa(count)
+	}
+}
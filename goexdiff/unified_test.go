@@ -0,0 +1,80 @@
+package goexdiff
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnified(t *testing.T) {
+	testCases := []struct {
+		name    string
+		a, b    string
+		context int
+		want    string
+	}{
+		{
+			name: "Identical",
+			a:    "a\nb", b: "a\nb", context: 3,
+			want: "",
+		},
+		{
+			name: "Simple",
+			a:    "a\nb\nc", b: "a\nc\nd", context: 3,
+			want: "--- old\n+++ new\n@@ -1,3 +1,3 @@\n a\n-b\n c\n+d\n",
+		},
+		{
+			name:    "SeparateHunks",
+			a:       "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15",
+			b:       "1\n2\nx\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n15\n16",
+			context: 2,
+			want: "--- old\n+++ new\n" +
+				"@@ -1,5 +1,5 @@\n 1\n 2\n-3\n+x\n 4\n 5\n" +
+				"@@ -12,4 +12,4 @@\n 12\n 13\n-14\n 15\n+16\n",
+		},
+		{
+			name: "MergedHunks",
+			a:    "1\n2\n3\n4\n5\n6", b: "x\n2\n3\n4\n5\ny", context: 2,
+			want: "--- old\n+++ new\n@@ -1,6 +1,6 @@\n-1\n+x\n 2\n 3\n 4\n 5\n-6\n+y\n",
+		},
+		{
+			name: "ZeroContext",
+			a:    "a\nb\nc", b: "a\nB\nc", context: 0,
+			want: "--- old\n+++ new\n@@ -2 +2 @@\n-b\n+B\n",
+		},
+		{
+			name: "FromEmpty",
+			a:    "", b: "x", context: 3,
+			want: "--- old\n+++ new\n@@ -1 +1 @@\n-\n+x\n",
+		},
+		{
+			name: "PureInsertion",
+			a:    "a\nb", b: "a\nnew\nb", context: 0,
+			want: "--- old\n+++ new\n@@ -1,0 +2 @@\n+new\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestUnified_"+tc.name, func(t *testing.T) {
+			got := Unified("old", "new", strings.Split(tc.a, "\n"), strings.Split(tc.b, "\n"), tc.context)
+			if got != tc.want {
+				t.Errorf("Expected %q, but got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestUnified_EmptyOld(t *testing.T) {
+	want := "--- /dev/null\n+++ b.txt\n@@ -0,0 +1,2 @@\n+x\n+y\n"
+	if got := Unified("/dev/null", "b.txt", nil, []string{"x", "y"}, DefaultContext); got != want {
+		t.Errorf("Expected %q, but got %q", want, got)
+	}
+}
+
+func TestUnifiedHunks(t *testing.T) {
+	a := []string{"a", "b"}
+	b := []string{"b", "a"}
+	want := "--- old\n+++ new\n@@ -1,2 +1,2 @@\n-a\n b\n+a\n"
+	if got := UnifiedHunks("old", "new", PatienceDiff(a, b), DefaultContext); got != want {
+		t.Errorf("Expected %q, but got %q", want, got)
+	}
+}
@@ -0,0 +1,102 @@
+package goexfsm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DOT 将状态机导出为 Graphviz DOT 文本，当前状态以填充色标出。
+//
+// 示例：
+//   - dot -Tsvg 可以将结果渲染为图片。
+func (m *Machine[S, E]) DOT() string {
+	current := m.Current()
+	var b strings.Builder
+	b.WriteString("digraph fsm {\n")
+	b.WriteString("\trankdir=LR;\n")
+	b.WriteString("\tnode [shape=box, style=rounded];\n")
+	b.WriteString("\t\"\" [shape=point];\n")
+	for _, s := range m.states(current) {
+		if s == current {
+			fmt.Fprintf(&b, "\t%s [style=\"rounded,filled\", fillcolor=lightgrey];\n", strconv.Quote(fmt.Sprint(s)))
+		}
+	}
+	fmt.Fprintf(&b, "\t\"\" -> %s;\n", strconv.Quote(fmt.Sprint(m.def.Initial)))
+	for _, t := range m.def.Transitions {
+		for _, from := range t.From {
+			fmt.Fprintf(&b, "\t%s -> %s [label=%s];\n",
+				strconv.Quote(fmt.Sprint(from)), strconv.Quote(fmt.Sprint(t.To)), strconv.Quote(edgeLabel(t)))
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Mermaid 将状态机导出为 Mermaid stateDiagram-v2 文本，当前状态使用 current 样式类标出。
+//
+// 每个状态都使用 s0、s1 等别名作为标识符，状态名只作为转义后的显示文本，
+// 因此状态名中的引号、换行或 note、end 等关键字不会破坏输出。
+func (m *Machine[S, E]) Mermaid() string {
+	current := m.Current()
+	states := m.states(current)
+	ids := make(map[S]string, len(states))
+
+	var b strings.Builder
+	b.WriteString("stateDiagram-v2\n")
+	for i, s := range states {
+		ids[s] = "s" + strconv.Itoa(i)
+		fmt.Fprintf(&b, "\tstate \"%s\" as %s\n", mermaidEscaper.Replace(fmt.Sprint(s)), ids[s])
+	}
+	fmt.Fprintf(&b, "\t[*] --> %s\n", ids[m.def.Initial])
+	for _, t := range m.def.Transitions {
+		for _, from := range t.From {
+			fmt.Fprintf(&b, "\t%s --> %s: %s\n", ids[from], ids[t.To], mermaidEscaper.Replace(edgeLabel(t)))
+		}
+	}
+	b.WriteString("\tclassDef current font-weight:bold,stroke-width:3px\n")
+	fmt.Fprintf(&b, "\tclass %s current\n", ids[current])
+	return b.String()
+}
+
+// mermaidEscaper 将对 Mermaid 语法有意义的字符替换为实体编码，换行替换为 <br>。
+var mermaidEscaper = strings.NewReplacer(
+	"#", "#35;",
+	`"`, "#quot;",
+	";", "#59;",
+	"<", "#lt;",
+	">", "#gt;",
+	"\r\n", "<br>",
+	"\n", "<br>",
+	"\r", "<br>",
+)
+
+// states 返回初始状态、转换表中出现的所有状态以及 current，按首次出现的顺序排列。
+//
+// current 由调用方读取一次后传入，避免并发转换时列出的状态与标出的当前状态不一致。
+func (m *Machine[S, E]) states(current S) []S {
+	seen := map[S]bool{m.def.Initial: true}
+	states := []S{m.def.Initial}
+	add := func(s S) {
+		if !seen[s] {
+			seen[s] = true
+			states = append(states, s)
+		}
+	}
+	for _, t := range m.def.Transitions {
+		for _, from := range t.From {
+			add(from)
+		}
+		add(t.To)
+	}
+	add(current)
+	return states
+}
+
+func edgeLabel[S, E comparable](t Transition[S, E]) string {
+	label := fmt.Sprint(t.Event)
+	if t.Guard != nil {
+		label += " [guard]"
+	}
+	return label
+}
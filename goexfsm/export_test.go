@@ -0,0 +1,79 @@
+package goexfsm
+
+import (
+	"testing"
+)
+
+func TestMachine_DOT(t *testing.T) {
+	def := orderDefinition()
+	def.Transitions = append(def.Transitions, Transition[orderState, orderEvent]{
+		From: []orderState{shipped}, Event: "return", To: "returned",
+		Guard: func(c Change[orderState, orderEvent]) bool { return true },
+	})
+	m := New(def)
+	m.Fire(pay)
+
+	expected := `digraph fsm {
+	rankdir=LR;
+	node [shape=box, style=rounded];
+	"" [shape=point];
+	"paid" [style="rounded,filled", fillcolor=lightgrey];
+	"" -> "pending";
+	"pending" -> "paid" [label="pay"];
+	"paid" -> "shipped" [label="ship"];
+	"pending" -> "cancelled" [label="cancel"];
+	"paid" -> "cancelled" [label="cancel"];
+	"shipped" -> "returned" [label="return [guard]"];
+}
+`
+	if got := m.DOT(); got != expected {
+		t.Errorf("Expected:\n%s\nbut got:\n%s", expected, got)
+	}
+}
+
+func TestMachine_Mermaid(t *testing.T) {
+	m := New(Definition[string, int]{
+		Initial: "idle",
+		Transitions: []Transition[string, int]{
+			{From: []string{"idle"}, Event: 1, To: "in progress"},
+			{From: []string{"in progress"}, Event: 2, To: "idle"},
+		},
+	})
+
+	expected := `stateDiagram-v2
+	state "idle" as s0
+	state "in progress" as s1
+	[*] --> s0
+	s0 --> s1: 1
+	s1 --> s0: 2
+	classDef current font-weight:bold,stroke-width:3px
+	class s0 current
+`
+	if got := m.Mermaid(); got != expected {
+		t.Errorf("Expected:\n%s\nbut got:\n%s", expected, got)
+	}
+}
+
+func TestMachine_MermaidEscape(t *testing.T) {
+	m := New(Definition[string, string]{
+		Initial: "s1",
+		Transitions: []Transition[string, string]{
+			{From: []string{"s1"}, Event: "go; now", To: "s0"},
+			{From: []string{"s0"}, Event: "a -> b", To: `say "hi"` + "\n#1"},
+		},
+	})
+
+	expected := `stateDiagram-v2
+	state "s1" as s0
+	state "s0" as s1
+	state "say #quot;hi#quot;<br>#35;1" as s2
+	[*] --> s0
+	s0 --> s1: go#59; now
+	s1 --> s2: a -#gt; b
+	classDef current font-weight:bold,stroke-width:3px
+	class s0 current
+`
+	if got := m.Mermaid(); got != expected {
+		t.Errorf("Expected:\n%s\nbut got:\n%s", expected, got)
+	}
+}
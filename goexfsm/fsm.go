@@ -0,0 +1,250 @@
+package goexfsm
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+	"github.com/birdmichael/GoEx/goexslice"
+)
+
+var (
+	// ErrInvalidTransition 表示当前状态下没有定义该事件的转换。
+	ErrInvalidTransition = errors.New("goexfsm: invalid transition")
+	// ErrGuardRejected 表示当前状态下定义了该事件的转换，但所有转换的守卫都拒绝了。
+	ErrGuardRejected = errors.New("goexfsm: transition rejected by guard")
+)
+
+// Transition 描述转换表中的一行：在 From 中的任意状态下收到 Event 时转换到 To。
+//
+// 参数：
+//   - S: 状态的类型。
+//   - E: 事件的类型。
+type Transition[S, E comparable] struct {
+	From  []S
+	Event E
+	To    S
+	// Guard 决定转换能否进行，为 nil 时总是允许。
+	Guard goexslice.Predicate[Change[S, E]]
+}
+
+// Change 描述一次（将要发生或已经发生的）状态转换，传给守卫与钩子。
+type Change[S, E comparable] struct {
+	From  S
+	To    S
+	Event E
+}
+
+// Record 是状态历史中的一条记录。
+type Record[S, E comparable] struct {
+	Change[S, E]
+	At time.Time
+}
+
+// Definition 是状态机的声明式定义。
+//
+// 参数：
+//   - S: 状态的类型。
+//   - E: 事件的类型。
+type Definition[S, E comparable] struct {
+	// Initial 是初始状态。
+	Initial S
+	// Transitions 是转换表。同一状态与事件匹配多行时，按声明顺序选择第一个守卫通过的转换。
+	Transitions []Transition[S, E]
+	// OnExit 在离开某个状态时被调用。
+	OnExit map[S]func(c Change[S, E])
+	// OnEnter 在进入某个状态时被调用。
+	OnEnter map[S]func(c Change[S, E])
+	// OnTransition 在每次转换时被调用，调用顺序为 OnExit、OnTransition、OnEnter。
+	OnTransition func(c Change[S, E])
+	// HistorySize 是保留的历史记录条数，为 0 时不记录，小于 0 时不限制。
+	HistorySize int
+	// Clock 用于记录历史的时间，为 nil 时使用真实时钟。
+	Clock goexclock.Clock
+}
+
+// TransitionError 表示一次无法进行的状态转换。
+//
+// 可以使用 errors.Is 判断其原因是 ErrInvalidTransition 还是 ErrGuardRejected。
+type TransitionError[S, E comparable] struct {
+	State   S    // 收到事件时的状态
+	Event   E    // 收到的事件
+	Guarded bool // 转换已定义但被守卫拒绝时为 true
+}
+
+// Error 实现 error 接口。
+func (e *TransitionError[S, E]) Error() string {
+	if e.Guarded {
+		return fmt.Sprintf("goexfsm: event %v in state %v rejected by guard", e.Event, e.State)
+	}
+	return fmt.Sprintf("goexfsm: event %v is not valid in state %v", e.Event, e.State)
+}
+
+// Is 使 errors.Is 能够将 TransitionError 与 ErrInvalidTransition、ErrGuardRejected 匹配。
+func (e *TransitionError[S, E]) Is(target error) bool {
+	if e.Guarded {
+		return target == ErrGuardRejected
+	}
+	return target == ErrInvalidTransition
+}
+
+// Machine 是一个并发安全的有限状态机。
+//
+// 参数：
+//   - S: 状态的类型。
+//   - E: 事件的类型。
+type Machine[S, E comparable] struct {
+	def   Definition[S, E]
+	clock goexclock.Clock
+	index map[S]map[E][]int
+
+	// fireMu 保证转换及其钩子按顺序执行
+	fireMu  sync.Mutex
+	mu      sync.RWMutex
+	current S
+	history []Record[S, E]
+}
+
+// New 根据定义创建一个处于初始状态的状态机。
+//
+// 示例：
+//
+//	m := New(Definition[string, string]{
+//		Initial: "pending",
+//		Transitions: []Transition[string, string]{
+//			{From: []string{"pending"}, Event: "pay", To: "paid"},
+//			{From: []string{"pending", "paid"}, Event: "cancel", To: "cancelled"},
+//		},
+//	})
+//	err := m.Fire("pay")
+func New[S, E comparable](def Definition[S, E]) *Machine[S, E] {
+	m := &Machine[S, E]{
+		def:     def,
+		clock:   goexclock.OrReal(def.Clock),
+		index:   make(map[S]map[E][]int),
+		current: def.Initial,
+	}
+	for i, t := range def.Transitions {
+		for _, from := range t.From {
+			if m.index[from] == nil {
+				m.index[from] = make(map[E][]int)
+			}
+			m.index[from][t.Event] = append(m.index[from][t.Event], i)
+		}
+	}
+	return m
+}
+
+// MARK: - Read
+
+// Current 返回当前状态。
+func (m *Machine[S, E]) Current() S {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.current
+}
+
+// Is 判断当前状态是否为 state。
+func (m *Machine[S, E]) Is(state S) bool {
+	return m.Current() == state
+}
+
+// Can 判断当前状态下能否处理事件 event，会执行对应转换的守卫。
+func (m *Machine[S, E]) Can(event E) bool {
+	_, err := m.resolve(m.Current(), event)
+	return err == nil
+}
+
+// AvailableEvents 返回当前状态下定义了转换的事件，按转换表中的顺序排列，不执行守卫。
+func (m *Machine[S, E]) AvailableEvents() []E {
+	current := m.Current()
+	var events []E
+	for _, t := range m.def.Transitions {
+		if goexslice.Contain(t.From, current) && !goexslice.Contain(events, t.Event) {
+			events = append(events, t.Event)
+		}
+	}
+	return events
+}
+
+// History 返回最近的状态转换记录，按发生顺序排列。
+func (m *Machine[S, E]) History() []Record[S, E] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return append([]Record[S, E](nil), m.history...)
+}
+
+// MARK: - Write
+
+// Fire 向状态机发送事件 event 并执行对应的转换。
+//
+// 钩子在转换过程中同步调用，钩子中不能调用同一个状态机的 Fire，否则会死锁。
+//
+// 返回值：
+//   - 转换无法进行时为 *TransitionError。
+func (m *Machine[S, E]) Fire(event E) error {
+	m.fireMu.Lock()
+	defer m.fireMu.Unlock()
+
+	c, err := m.resolve(m.Current(), event)
+	if err != nil {
+		return err
+	}
+
+	if hook := m.def.OnExit[c.From]; hook != nil {
+		hook(c)
+	}
+	if m.def.OnTransition != nil {
+		m.def.OnTransition(c)
+	}
+
+	m.mu.Lock()
+	m.current = c.To
+	m.record(c)
+	m.mu.Unlock()
+
+	if hook := m.def.OnEnter[c.To]; hook != nil {
+		hook(c)
+	}
+	return nil
+}
+
+// Reset 将状态机设置为状态 state，不执行守卫与钩子，也不记录历史。
+func (m *Machine[S, E]) Reset(state S) {
+	m.fireMu.Lock()
+	defer m.fireMu.Unlock()
+
+	m.mu.Lock()
+	m.current = state
+	m.mu.Unlock()
+}
+
+func (m *Machine[S, E]) resolve(from S, event E) (Change[S, E], error) {
+	candidates := m.index[from][event]
+	if len(candidates) == 0 {
+		return Change[S, E]{}, &TransitionError[S, E]{State: from, Event: event}
+	}
+	for _, i := range candidates {
+		t := m.def.Transitions[i]
+		c := Change[S, E]{From: from, To: t.To, Event: event}
+		if t.Guard == nil || t.Guard(c) {
+			return c, nil
+		}
+	}
+	return Change[S, E]{}, &TransitionError[S, E]{State: from, Event: event, Guarded: true}
+}
+
+// record 追加一条历史记录。调用方必须持有 mu。
+func (m *Machine[S, E]) record(c Change[S, E]) {
+	if m.def.HistorySize == 0 {
+		return
+	}
+	m.history = append(m.history, Record[S, E]{Change: c, At: m.clock.Now()})
+	if m.def.HistorySize > 0 && len(m.history) > m.def.HistorySize {
+		m.history = append(m.history[:0], m.history[len(m.history)-m.def.HistorySize:]...)
+	}
+}
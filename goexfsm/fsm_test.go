@@ -0,0 +1,175 @@
+package goexfsm
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+)
+
+type orderState string
+type orderEvent string
+
+const (
+	pending   orderState = "pending"
+	paid      orderState = "paid"
+	shipped   orderState = "shipped"
+	cancelled orderState = "cancelled"
+
+	pay    orderEvent = "pay"
+	ship   orderEvent = "ship"
+	cancel orderEvent = "cancel"
+)
+
+func orderDefinition() Definition[orderState, orderEvent] {
+	return Definition[orderState, orderEvent]{
+		Initial: pending,
+		Transitions: []Transition[orderState, orderEvent]{
+			{From: []orderState{pending}, Event: pay, To: paid},
+			{From: []orderState{paid}, Event: ship, To: shipped},
+			{From: []orderState{pending, paid}, Event: cancel, To: cancelled},
+		},
+	}
+}
+
+func TestMachine_Fire(t *testing.T) {
+	m := New(orderDefinition())
+
+	if !m.Is(pending) {
+		t.Errorf("Expected %v, but got %v", pending, m.Current())
+	}
+	if err := m.Fire(pay); err != nil {
+		t.Fatalf("Expected nil, but got %v", err)
+	}
+	if err := m.Fire(ship); err != nil {
+		t.Fatalf("Expected nil, but got %v", err)
+	}
+	if m.Current() != shipped {
+		t.Errorf("Expected %v, but got %v", shipped, m.Current())
+	}
+
+	err := m.Fire(cancel)
+	if !errors.Is(err, ErrInvalidTransition) || errors.Is(err, ErrGuardRejected) {
+		t.Errorf("Expected %v, but got %v", ErrInvalidTransition, err)
+	}
+	var te *TransitionError[orderState, orderEvent]
+	if !errors.As(err, &te) || te.State != shipped || te.Event != cancel {
+		t.Errorf("Unexpected error %#v", err)
+	}
+	if m.Current() != shipped {
+		t.Errorf("Expected state to be unchanged, but got %v", m.Current())
+	}
+}
+
+func TestMachine_Guard(t *testing.T) {
+	balance := 0
+	def := Definition[orderState, orderEvent]{
+		Initial: pending,
+		Transitions: []Transition[orderState, orderEvent]{
+			{From: []orderState{pending}, Event: pay, To: paid, Guard: func(c Change[orderState, orderEvent]) bool { return balance >= 100 }},
+			{From: []orderState{pending}, Event: pay, To: cancelled, Guard: func(c Change[orderState, orderEvent]) bool { return balance < 0 }},
+		},
+	}
+
+	m := New(def)
+	if m.Can(pay) {
+		t.Errorf("Expected guard to reject pay")
+	}
+	if err := m.Fire(pay); !errors.Is(err, ErrGuardRejected) {
+		t.Errorf("Expected %v, but got %v", ErrGuardRejected, err)
+	}
+
+	balance = -1
+	m.Fire(pay)
+	if m.Current() != cancelled {
+		t.Errorf("Expected second matching row to apply, but got %v", m.Current())
+	}
+
+	balance = 100
+	m.Reset(pending)
+	m.Fire(pay)
+	if m.Current() != paid {
+		t.Errorf("Expected %v, but got %v", paid, m.Current())
+	}
+}
+
+func TestMachine_Hooks(t *testing.T) {
+	var calls []string
+	def := orderDefinition()
+	def.OnExit = map[orderState]func(Change[orderState, orderEvent]){
+		pending: func(c Change[orderState, orderEvent]) { calls = append(calls, "exit "+string(c.From)) },
+	}
+	def.OnEnter = map[orderState]func(Change[orderState, orderEvent]){
+		paid: func(c Change[orderState, orderEvent]) { calls = append(calls, "enter "+string(c.To)) },
+	}
+	var m *Machine[orderState, orderEvent]
+	def.OnTransition = func(c Change[orderState, orderEvent]) {
+		calls = append(calls, "transition "+string(c.Event))
+		// 钩子中读取状态不会死锁，此时仍为原状态
+		calls = append(calls, "current "+string(m.Current()))
+	}
+	m = New(def)
+
+	m.Fire(pay)
+	m.Fire(ship)
+	expected := []string{"exit pending", "transition pay", "current pending", "enter paid", "transition ship", "current paid"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected %v, but got %v", expected, calls)
+	}
+}
+
+func TestMachine_History(t *testing.T) {
+	clock := goexclock.NewFake(time.Time{})
+	def := orderDefinition()
+	def.HistorySize = 2
+	def.Clock = clock
+	m := New(def)
+
+	m.Fire(pay)
+	clock.Advance(time.Minute)
+	m.Fire(ship)
+	m.Fire(cancel)
+
+	start := clock.Now().Add(-time.Minute)
+	expected := []Record[orderState, orderEvent]{
+		{Change: Change[orderState, orderEvent]{From: pending, To: paid, Event: pay}, At: start},
+		{Change: Change[orderState, orderEvent]{From: paid, To: shipped, Event: ship}, At: clock.Now()},
+	}
+	if got := m.History(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+
+	m.Reset(pending)
+	m.Fire(cancel)
+	if got := m.History(); len(got) != 2 || got[1].To != cancelled || got[0].To != shipped {
+		t.Errorf("Expected history to keep the latest 2 records, but got %v", got)
+	}
+
+	if got := New(orderDefinition()); len(got.History()) != 0 {
+		t.Errorf("Expected no history by default")
+	}
+}
+
+func TestMachine_AvailableEvents(t *testing.T) {
+	m := New(orderDefinition())
+	if got := m.AvailableEvents(); !reflect.DeepEqual(got, []orderEvent{pay, cancel}) {
+		t.Errorf("Expected %v, but got %v", []orderEvent{pay, cancel}, got)
+	}
+	m.Fire(cancel)
+	if got := m.AvailableEvents(); len(got) != 0 {
+		t.Errorf("Expected no events, but got %v", got)
+	}
+}
+
+func TestTransitionError_Error(t *testing.T) {
+	err := &TransitionError[orderState, orderEvent]{State: shipped, Event: pay}
+	if got := err.Error(); got != "goexfsm: event pay is not valid in state shipped" {
+		t.Errorf("Unexpected message %q", got)
+	}
+	err.Guarded = true
+	if got := err.Error(); got != "goexfsm: event pay in state shipped rejected by guard" {
+		t.Errorf("Unexpected message %q", got)
+	}
+}
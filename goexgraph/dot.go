@@ -0,0 +1,33 @@
+package goexgraph
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DOT 将图导出为 Graphviz DOT 文本，边权作为边的标签。
+//
+// 参数：
+//   - name: 图的名称。
+func (g *Graph[K, W]) DOT(name string) string {
+	kind, arrow := "graph", "--"
+	if g.directed {
+		kind, arrow = "digraph", "->"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s {\n", kind, strconv.Quote(name))
+	for _, v := range g.vertices {
+		// 孤立的顶点需要单独声明
+		if g.OutDegree(v) == 0 && g.InDegree(v) == 0 {
+			fmt.Fprintf(&b, "\t%s;\n", strconv.Quote(fmt.Sprint(v)))
+		}
+	}
+	for _, e := range g.Edges() {
+		fmt.Fprintf(&b, "\t%s %s %s [label=%s];\n",
+			strconv.Quote(fmt.Sprint(e.From)), arrow, strconv.Quote(fmt.Sprint(e.To)), strconv.Quote(fmt.Sprint(e.Weight)))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
@@ -0,0 +1,31 @@
+package goexgraph
+
+import (
+	"testing"
+)
+
+func TestGraph_DOT(t *testing.T) {
+	d := NewDirected[string, int]()
+	d.AddEdge("a", "b", 1)
+	d.AddVertex("c")
+
+	expected := `digraph "deps" {
+	"c";
+	"a" -> "b" [label="1"];
+}
+`
+	if got := d.DOT("deps"); got != expected {
+		t.Errorf("Expected:\n%s\nbut got:\n%s", expected, got)
+	}
+
+	u := NewUndirected[int, float64]()
+	u.AddEdge(1, 2, 0.5)
+
+	expected = `graph "g" {
+	"1" -- "2" [label="0.5"];
+}
+`
+	if got := u.DOT("g"); got != expected {
+		t.Errorf("Expected:\n%s\nbut got:\n%s", expected, got)
+	}
+}
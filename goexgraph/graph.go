@@ -0,0 +1,279 @@
+package goexgraph
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/birdmichael/GoEx/constraintsext"
+)
+
+var (
+	// ErrDirected 表示算法只适用于无向图。
+	ErrDirected = errors.New("goexgraph: graph is directed")
+	// ErrUndirected 表示算法只适用于有向图。
+	ErrUndirected = errors.New("goexgraph: graph is undirected")
+	// ErrVertexNotFound 表示顶点不存在。
+	ErrVertexNotFound = errors.New("goexgraph: vertex not found")
+	// ErrNoPath 表示两个顶点之间不存在路径。
+	ErrNoPath = errors.New("goexgraph: no path")
+	// ErrNegativeWeight 表示图中存在负权边，最短路径算法无法处理。
+	ErrNegativeWeight = errors.New("goexgraph: negative edge weight")
+)
+
+// Edge 是图中的一条边。
+//
+// 参数：
+//   - K: 顶点的类型。
+//   - W: 边权的类型。
+type Edge[K comparable, W constraintsext.Number] struct {
+	From   K
+	To     K
+	Weight W
+}
+
+// Graph 是一个带权的有向图或无向图。
+//
+// 顶点与每个顶点的邻居都按加入的顺序排列，因此所有遍历与算法的结果都是确定的。
+// Graph 不是并发安全的。
+//
+// 参数：
+//   - K: 顶点的类型。
+//   - W: 边权的类型；不关心边权时可以使用 int 并传入 1。
+type Graph[K comparable, W constraintsext.Number] struct {
+	directed bool
+	vertices []K
+	out      map[K]*adjacency[K, W]
+	in       map[K]*adjacency[K, W]
+	size     int
+}
+
+// adjacency 是按加入顺序排列的邻接表。
+type adjacency[K comparable, W constraintsext.Number] struct {
+	keys    []K
+	weights map[K]W
+}
+
+func newAdjacency[K comparable, W constraintsext.Number]() *adjacency[K, W] {
+	return &adjacency[K, W]{weights: make(map[K]W)}
+}
+
+func (a *adjacency[K, W]) set(k K, w W) bool {
+	_, ok := a.weights[k]
+	if !ok {
+		a.keys = append(a.keys, k)
+	}
+	a.weights[k] = w
+	return !ok
+}
+
+func (a *adjacency[K, W]) remove(k K) bool {
+	if _, ok := a.weights[k]; !ok {
+		return false
+	}
+	delete(a.weights, k)
+	a.keys = slices.DeleteFunc(a.keys, func(x K) bool { return x == k })
+	return true
+}
+
+// NewDirected 创建一个空的有向图。
+func NewDirected[K comparable, W constraintsext.Number]() *Graph[K, W] {
+	return &Graph[K, W]{directed: true, out: make(map[K]*adjacency[K, W]), in: make(map[K]*adjacency[K, W])}
+}
+
+// NewUndirected 创建一个空的无向图。
+func NewUndirected[K comparable, W constraintsext.Number]() *Graph[K, W] {
+	g := &Graph[K, W]{out: make(map[K]*adjacency[K, W])}
+	// 无向图的入边与出边相同
+	g.in = g.out
+	return g
+}
+
+// MARK: - Read
+
+// IsDirected 判断是否为有向图。
+func (g *Graph[K, W]) IsDirected() bool {
+	return g.directed
+}
+
+// Order 返回顶点的个数。
+func (g *Graph[K, W]) Order() int {
+	return len(g.vertices)
+}
+
+// Size 返回边的条数，无向边只计一次。
+func (g *Graph[K, W]) Size() int {
+	return g.size
+}
+
+// HasVertex 判断顶点 v 是否存在。
+func (g *Graph[K, W]) HasVertex(v K) bool {
+	_, ok := g.out[v]
+	return ok
+}
+
+// HasEdge 判断是否存在从 from 到 to 的边；无向图中与方向无关。
+func (g *Graph[K, W]) HasEdge(from, to K) bool {
+	_, ok := g.Weight(from, to)
+	return ok
+}
+
+// Weight 返回从 from 到 to 的边的权重。
+//
+// 返回值：
+//   - weight: 边的权重。
+//   - ok: 边是否存在。
+func (g *Graph[K, W]) Weight(from, to K) (weight W, ok bool) {
+	a, exists := g.out[from]
+	if !exists {
+		return weight, false
+	}
+	weight, ok = a.weights[to]
+	return weight, ok
+}
+
+// Vertices 返回所有顶点，按加入的顺序排列。
+func (g *Graph[K, W]) Vertices() []K {
+	return slices.Clone(g.vertices)
+}
+
+// Neighbors 返回从 v 出发可以直接到达的顶点，按边加入的顺序排列。
+func (g *Graph[K, W]) Neighbors(v K) []K {
+	if a, ok := g.out[v]; ok {
+		return slices.Clone(a.keys)
+	}
+	return nil
+}
+
+// Predecessors 返回可以直接到达 v 的顶点；无向图中与 Neighbors 相同。
+func (g *Graph[K, W]) Predecessors(v K) []K {
+	if a, ok := g.in[v]; ok {
+		return slices.Clone(a.keys)
+	}
+	return nil
+}
+
+// OutDegree 返回 v 的出度；无向图中为 v 的度。
+func (g *Graph[K, W]) OutDegree(v K) int {
+	if a, ok := g.out[v]; ok {
+		return len(a.keys)
+	}
+	return 0
+}
+
+// InDegree 返回 v 的入度；无向图中为 v 的度。
+func (g *Graph[K, W]) InDegree(v K) int {
+	if a, ok := g.in[v]; ok {
+		return len(a.keys)
+	}
+	return 0
+}
+
+// Edges 返回所有的边，按起点加入的顺序及边加入的顺序排列。无向边只返回一次。
+func (g *Graph[K, W]) Edges() []Edge[K, W] {
+	edges := make([]Edge[K, W], 0, g.size)
+	position := make(map[K]int, len(g.vertices))
+	for i, v := range g.vertices {
+		position[v] = i
+	}
+	for i, from := range g.vertices {
+		a := g.out[from]
+		for _, to := range a.keys {
+			if !g.directed && position[to] < i {
+				continue
+			}
+			edges = append(edges, Edge[K, W]{From: from, To: to, Weight: a.weights[to]})
+		}
+	}
+	return edges
+}
+
+// MARK: - Write
+
+// AddVertex 加入顶点 v。
+//
+// 返回值：
+//   - 如果 v 原本不存在，返回 true。
+func (g *Graph[K, W]) AddVertex(v K) bool {
+	if g.HasVertex(v) {
+		return false
+	}
+	g.vertices = append(g.vertices, v)
+	g.out[v] = newAdjacency[K, W]()
+	if g.directed {
+		g.in[v] = newAdjacency[K, W]()
+	}
+	return true
+}
+
+// AddEdge 加入一条从 from 到 to、权重为 weight 的边，不存在的顶点会被自动加入。
+// 边已存在时更新其权重。
+func (g *Graph[K, W]) AddEdge(from, to K, weight W) {
+	g.AddVertex(from)
+	g.AddVertex(to)
+	added := g.out[from].set(to, weight)
+	if g.directed {
+		g.in[to].set(from, weight)
+	} else if from != to {
+		g.out[to].set(from, weight)
+	}
+	if added {
+		g.size++
+	}
+}
+
+// RemoveEdge 删除从 from 到 to 的边。
+//
+// 返回值：
+//   - 如果边存在，返回 true。
+func (g *Graph[K, W]) RemoveEdge(from, to K) bool {
+	a, ok := g.out[from]
+	if !ok || !a.remove(to) {
+		return false
+	}
+	if g.directed {
+		g.in[to].remove(from)
+	} else if from != to {
+		g.out[to].remove(from)
+	}
+	g.size--
+	return true
+}
+
+// RemoveVertex 删除顶点 v 以及与它相连的所有边。
+//
+// 返回值：
+//   - 如果 v 存在，返回 true。
+func (g *Graph[K, W]) RemoveVertex(v K) bool {
+	if !g.HasVertex(v) {
+		return false
+	}
+	for _, to := range g.Neighbors(v) {
+		g.RemoveEdge(v, to)
+	}
+	if g.directed {
+		for _, from := range g.Predecessors(v) {
+			g.RemoveEdge(from, v)
+		}
+		delete(g.in, v)
+	}
+	delete(g.out, v)
+	g.vertices = slices.DeleteFunc(g.vertices, func(x K) bool { return x == v })
+	return true
+}
+
+// Clone 返回图的深拷贝。
+func (g *Graph[K, W]) Clone() *Graph[K, W] {
+	var c *Graph[K, W]
+	if g.directed {
+		c = NewDirected[K, W]()
+	} else {
+		c = NewUndirected[K, W]()
+	}
+	for _, v := range g.vertices {
+		c.AddVertex(v)
+	}
+	for _, e := range g.Edges() {
+		c.AddEdge(e.From, e.To, e.Weight)
+	}
+	return c
+}
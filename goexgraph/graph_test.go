@@ -0,0 +1,84 @@
+package goexgraph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGraph_Directed(t *testing.T) {
+	g := NewDirected[string, int]()
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("a", "c", 2)
+	g.AddEdge("c", "b", 3)
+	g.AddVertex("d")
+
+	if g.Order() != 4 || g.Size() != 3 || !g.IsDirected() {
+		t.Errorf("Expected 4 vertices and 3 edges, but got %d and %d", g.Order(), g.Size())
+	}
+	if !reflect.DeepEqual(g.Vertices(), []string{"a", "b", "c", "d"}) {
+		t.Errorf("Unexpected vertices %v", g.Vertices())
+	}
+	if !reflect.DeepEqual(g.Neighbors("a"), []string{"b", "c"}) {
+		t.Errorf("Unexpected neighbors %v", g.Neighbors("a"))
+	}
+	if !reflect.DeepEqual(g.Predecessors("b"), []string{"a", "c"}) {
+		t.Errorf("Unexpected predecessors %v", g.Predecessors("b"))
+	}
+	if g.HasEdge("b", "a") || !g.HasEdge("a", "b") {
+		t.Errorf("Expected edges to be directed")
+	}
+	if g.InDegree("b") != 2 || g.OutDegree("b") != 0 {
+		t.Errorf("Unexpected degree of b")
+	}
+
+	g.AddEdge("a", "b", 10)
+	if w, ok := g.Weight("a", "b"); !ok || w != 10 || g.Size() != 3 {
+		t.Errorf("Expected AddEdge to update weight, but got %v", w)
+	}
+
+	expected := []Edge[string, int]{{"a", "b", 10}, {"a", "c", 2}, {"c", "b", 3}}
+	if !reflect.DeepEqual(g.Edges(), expected) {
+		t.Errorf("Expected %v, but got %v", expected, g.Edges())
+	}
+
+	if !g.RemoveVertex("c") || g.RemoveVertex("c") {
+		t.Errorf("Expected RemoveVertex to report existence")
+	}
+	if g.Size() != 1 || !reflect.DeepEqual(g.Predecessors("b"), []string{"a"}) {
+		t.Errorf("Expected edges of c to be removed, but got %v", g.Edges())
+	}
+	if !g.RemoveEdge("a", "b") || g.RemoveEdge("a", "b") || g.Size() != 0 {
+		t.Errorf("Expected RemoveEdge to report existence")
+	}
+}
+
+func TestGraph_Undirected(t *testing.T) {
+	g := NewUndirected[int, float64]()
+	g.AddEdge(1, 2, 0.5)
+	g.AddEdge(3, 1, 1.5)
+	g.AddEdge(2, 2, 1)
+
+	if g.Size() != 3 || g.IsDirected() {
+		t.Errorf("Expected 3 edges, but got %d", g.Size())
+	}
+	if !g.HasEdge(2, 1) || !g.HasEdge(1, 3) {
+		t.Errorf("Expected edges to be undirected")
+	}
+	if !reflect.DeepEqual(g.Neighbors(1), []int{2, 3}) || !reflect.DeepEqual(g.Predecessors(1), []int{2, 3}) {
+		t.Errorf("Unexpected neighbors %v", g.Neighbors(1))
+	}
+
+	expected := []Edge[int, float64]{{1, 2, 0.5}, {1, 3, 1.5}, {2, 2, 1}}
+	if !reflect.DeepEqual(g.Edges(), expected) {
+		t.Errorf("Expected %v, but got %v", expected, g.Edges())
+	}
+
+	c := g.Clone()
+	g.RemoveEdge(3, 1)
+	if g.HasEdge(1, 3) || g.Size() != 2 {
+		t.Errorf("Expected RemoveEdge to remove both directions")
+	}
+	if !reflect.DeepEqual(c.Edges(), expected) {
+		t.Errorf("Expected clone to be independent, but got %v", c.Edges())
+	}
+}
@@ -0,0 +1,81 @@
+package goexgraph
+
+import (
+	"slices"
+)
+
+// MinimumSpanningTree 使用 Kruskal 算法计算无向图的最小生成树。
+//
+// 图不连通时返回最小生成森林，即每个连通分量的最小生成树的并集。
+//
+// 返回值：
+//   - 生成树的边，按权重从小到大排列，权重相同时按 Edges 的顺序排列。
+//   - 有向图返回 ErrDirected。
+func (g *Graph[K, W]) MinimumSpanningTree() ([]Edge[K, W], error) {
+	if g.directed {
+		return nil, ErrDirected
+	}
+
+	edges := g.Edges()
+	slices.SortStableFunc(edges, func(a, b Edge[K, W]) int {
+		switch {
+		case a.Weight < b.Weight:
+			return -1
+		case a.Weight > b.Weight:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	uf := newUnionFind[K]()
+	tree := make([]Edge[K, W], 0, max(len(g.vertices)-1, 0))
+	for _, e := range edges {
+		if uf.union(e.From, e.To) {
+			tree = append(tree, e)
+		}
+	}
+	return tree, nil
+}
+
+// unionFind 是带路径压缩与按秩合并的并查集。
+type unionFind[K comparable] struct {
+	parent map[K]K
+	rank   map[K]int
+}
+
+func newUnionFind[K comparable]() *unionFind[K] {
+	return &unionFind[K]{parent: make(map[K]K), rank: make(map[K]int)}
+}
+
+func (u *unionFind[K]) find(v K) K {
+	p, ok := u.parent[v]
+	if !ok {
+		u.parent[v] = v
+		return v
+	}
+	if p == v {
+		return v
+	}
+	root := u.find(p)
+	u.parent[v] = root
+	return root
+}
+
+// union 合并 a 与 b 所在的集合，两者原本属于不同集合时返回 true。
+func (u *unionFind[K]) union(a, b K) bool {
+	ra, rb := u.find(a), u.find(b)
+	if ra == rb {
+		return false
+	}
+	switch {
+	case u.rank[ra] < u.rank[rb]:
+		u.parent[ra] = rb
+	case u.rank[ra] > u.rank[rb]:
+		u.parent[rb] = ra
+	default:
+		u.parent[rb] = ra
+		u.rank[ra]++
+	}
+	return true
+}
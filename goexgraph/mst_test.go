@@ -0,0 +1,33 @@
+package goexgraph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestGraph_MinimumSpanningTree(t *testing.T) {
+	g := newRoadMap()
+	tree, err := g.MinimumSpanningTree()
+	if err != nil {
+		t.Fatalf("Expected nil, but got %v", err)
+	}
+
+	expected := []Edge[string, float64]{
+		{"c", "f", 2}, {"d", "e", 6}, {"a", "b", 7}, {"a", "c", 9}, {"f", "e", 9},
+	}
+	if !reflect.DeepEqual(tree, expected) {
+		t.Errorf("Expected %v, but got %v", expected, tree)
+	}
+
+	g.AddEdge("x", "y", 1)
+	g.AddVertex("z")
+	tree, _ = g.MinimumSpanningTree()
+	if len(tree) != 6 {
+		t.Errorf("Expected spanning forest with 6 edges, but got %v", tree)
+	}
+
+	if _, err := NewDirected[int, int]().MinimumSpanningTree(); !errors.Is(err, ErrDirected) {
+		t.Errorf("Expected %v, but got %v", ErrDirected, err)
+	}
+}
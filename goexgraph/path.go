@@ -0,0 +1,155 @@
+package goexgraph
+
+import (
+	"container/heap"
+	"slices"
+
+	"github.com/birdmichael/GoEx/constraintsext"
+)
+
+// ShortestPaths 使用 Dijkstra 算法计算从 from 到所有可达顶点的最短距离。
+//
+// 返回值：
+//   - dist: 每个可达顶点的最短距离，包括 from 自身（距离为 0）。
+//   - prev: 最短路径树，prev[v] 为最短路径上 v 的前一个顶点。
+//   - err: from 不存在时为 ErrVertexNotFound；遇到负权边时为 ErrNegativeWeight。
+func (g *Graph[K, W]) ShortestPaths(from K) (dist map[K]W, prev map[K]K, err error) {
+	if !g.HasVertex(from) {
+		return nil, nil, ErrVertexNotFound
+	}
+	s := g.search(from, nil, nil)
+	return s.dist, s.prev, s.err
+}
+
+// ShortestPath 使用 Dijkstra 算法计算从 from 到 to 的最短路径。
+//
+// 返回值：
+//   - path: 最短路径上的顶点，首尾分别为 from 与 to。
+//   - dist: 路径的总权重。
+//   - err: 顶点不存在时为 ErrVertexNotFound；不可达时为 ErrNoPath；遇到负权边时为 ErrNegativeWeight。
+//
+// 示例：
+//
+//	path, dist, err := g.ShortestPath("home", "office")
+func (g *Graph[K, W]) ShortestPath(from, to K) (path []K, dist W, err error) {
+	return g.AStar(from, to, nil)
+}
+
+// AStar 使用 A* 算法计算从 from 到 to 的最短路径。
+//
+// 参数：
+//   - from: 起点。
+//   - to: 终点。
+//   - heuristic: 估计 v 到 to 的距离。为保证结果最短，估计值必须满足一致性：对任意边 u→v 有
+//     heuristic(u) <= w(u, v) + heuristic(v)，且 heuristic(to) == 0。为 nil 时等同于 Dijkstra 算法。
+//
+// 返回值：
+//   - 与 ShortestPath 相同。
+func (g *Graph[K, W]) AStar(from, to K, heuristic func(v K) W) (path []K, dist W, err error) {
+	if !g.HasVertex(from) || !g.HasVertex(to) {
+		return nil, dist, ErrVertexNotFound
+	}
+	s := g.search(from, &to, heuristic)
+	if s.err != nil {
+		return nil, dist, s.err
+	}
+	dist, ok := s.dist[to]
+	if !ok {
+		return nil, dist, ErrNoPath
+	}
+
+	for v := to; ; v = s.prev[v] {
+		path = append(path, v)
+		if v == from {
+			break
+		}
+	}
+	slices.Reverse(path)
+	return path, dist, nil
+}
+
+type searchResult[K comparable, W constraintsext.Number] struct {
+	dist map[K]W
+	prev map[K]K
+	err  error
+}
+
+// search 是 Dijkstra 与 A* 的共同实现；target 不为 nil 时到达目标即停止。
+func (g *Graph[K, W]) search(from K, target *K, heuristic func(v K) W) searchResult[K, W] {
+	var zero W
+	r := searchResult[K, W]{dist: map[K]W{from: zero}, prev: make(map[K]K)}
+	done := make(map[K]bool)
+	pq := &priorityQueue[K, W]{}
+	heap.Push(pq, &pqItem[K, W]{v: from})
+
+	for pq.Len() > 0 {
+		cur := heap.Pop(pq).(*pqItem[K, W])
+		if done[cur.v] {
+			continue
+		}
+		done[cur.v] = true
+		if target != nil && cur.v == *target {
+			break
+		}
+
+		a := g.out[cur.v]
+		for _, n := range a.keys {
+			w := a.weights[n]
+			if w < 0 {
+				r.err = ErrNegativeWeight
+				return r
+			}
+			if done[n] {
+				continue
+			}
+			d := r.dist[cur.v] + w
+			if old, ok := r.dist[n]; ok && old <= d {
+				continue
+			}
+			r.dist[n] = d
+			r.prev[n] = cur.v
+			priority := d
+			if heuristic != nil {
+				priority += heuristic(n)
+			}
+			pq.seq++
+			heap.Push(pq, &pqItem[K, W]{v: n, priority: priority, seq: pq.seq})
+		}
+	}
+	return r
+}
+
+// MARK: - Priority Queue
+
+type pqItem[K comparable, W constraintsext.Number] struct {
+	v        K
+	priority W
+	seq      int
+}
+
+// priorityQueue 是按 priority 排序的最小堆，priority 相同时先加入的先出队。
+type priorityQueue[K comparable, W constraintsext.Number] struct {
+	items []*pqItem[K, W]
+	seq   int
+}
+
+func (q *priorityQueue[K, W]) Len() int { return len(q.items) }
+
+func (q *priorityQueue[K, W]) Less(i, j int) bool {
+	a, b := q.items[i], q.items[j]
+	if a.priority != b.priority {
+		return a.priority < b.priority
+	}
+	return a.seq < b.seq
+}
+
+func (q *priorityQueue[K, W]) Swap(i, j int) { q.items[i], q.items[j] = q.items[j], q.items[i] }
+func (q *priorityQueue[K, W]) Push(x any)    { q.items = append(q.items, x.(*pqItem[K, W])) }
+
+func (q *priorityQueue[K, W]) Pop() any {
+	n := len(q.items)
+	item := q.items[n-1]
+	q.items[n-1] = nil
+	q.items = q.items[:n-1]
+	return item
+}
@@ -0,0 +1,111 @@
+package goexgraph
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func newRoadMap() *Graph[string, float64] {
+	g := NewUndirected[string, float64]()
+	g.AddEdge("a", "b", 7)
+	g.AddEdge("a", "c", 9)
+	g.AddEdge("a", "f", 14)
+	g.AddEdge("b", "c", 10)
+	g.AddEdge("b", "d", 15)
+	g.AddEdge("c", "d", 11)
+	g.AddEdge("c", "f", 2)
+	g.AddEdge("d", "e", 6)
+	g.AddEdge("e", "f", 9)
+	return g
+}
+
+func TestGraph_ShortestPath(t *testing.T) {
+	g := newRoadMap()
+
+	path, dist, err := g.ShortestPath("a", "e")
+	if err != nil || dist != 20 || !reflect.DeepEqual(path, []string{"a", "c", "f", "e"}) {
+		t.Errorf("Unexpected result %v, %v, %v", path, dist, err)
+	}
+
+	path, dist, err = g.ShortestPath("a", "a")
+	if err != nil || dist != 0 || !reflect.DeepEqual(path, []string{"a"}) {
+		t.Errorf("Unexpected result %v, %v, %v", path, dist, err)
+	}
+
+	g.AddVertex("z")
+	if _, _, err := g.ShortestPath("a", "z"); !errors.Is(err, ErrNoPath) {
+		t.Errorf("Expected %v, but got %v", ErrNoPath, err)
+	}
+	if _, _, err := g.ShortestPath("a", "missing"); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("Expected %v, but got %v", ErrVertexNotFound, err)
+	}
+
+	g.AddEdge("z", "a", -1)
+	if _, _, err := g.ShortestPath("z", "e"); !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("Expected %v, but got %v", ErrNegativeWeight, err)
+	}
+}
+
+func TestGraph_ShortestPaths(t *testing.T) {
+	g := newRoadMap()
+	dist, prev, err := g.ShortestPaths("a")
+	if err != nil {
+		t.Fatalf("Expected nil, but got %v", err)
+	}
+
+	expected := map[string]float64{"a": 0, "b": 7, "c": 9, "d": 20, "e": 20, "f": 11}
+	if !reflect.DeepEqual(dist, expected) {
+		t.Errorf("Expected %v, but got %v", expected, dist)
+	}
+	if prev["e"] != "f" || prev["f"] != "c" {
+		t.Errorf("Unexpected shortest path tree %v", prev)
+	}
+	if _, _, err := g.ShortestPaths("missing"); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("Expected %v, but got %v", ErrVertexNotFound, err)
+	}
+}
+
+func TestGraph_AStar(t *testing.T) {
+	type point struct{ x, y int }
+
+	// 5x5 网格，中间有一堵墙
+	g := NewUndirected[point, int]()
+	wall := map[point]bool{{2, 0}: true, {2, 1}: true, {2, 2}: true, {2, 3}: true}
+	for x := 0; x < 5; x++ {
+		for y := 0; y < 5; y++ {
+			p := point{x, y}
+			if wall[p] {
+				continue
+			}
+			for _, q := range []point{{x + 1, y}, {x, y + 1}} {
+				if q.x < 5 && q.y < 5 && !wall[q] {
+					g.AddEdge(p, q, 1)
+				}
+			}
+		}
+	}
+
+	goal := point{4, 0}
+	visited := 0
+	manhattan := func(p point) int {
+		visited++
+		return int(math.Abs(float64(p.x-goal.x)) + math.Abs(float64(p.y-goal.y)))
+	}
+
+	path, dist, err := g.AStar(point{0, 0}, goal, manhattan)
+	if err != nil || dist != 12 || len(path) != 13 {
+		t.Fatalf("Unexpected result %v, %v, %v", path, dist, err)
+	}
+	for i := 0; i+1 < len(path); i++ {
+		if !g.HasEdge(path[i], path[i+1]) {
+			t.Errorf("Expected path to follow edges, but got %v", path)
+		}
+	}
+
+	_, expected, _ := g.ShortestPath(point{0, 0}, goal)
+	if dist != expected || visited == 0 {
+		t.Errorf("Expected A* to match Dijkstra distance %v, but got %v", expected, dist)
+	}
+}
@@ -0,0 +1,299 @@
+package goexgraph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/birdmichael/GoEx/constraintsext"
+)
+
+// Iterator 按需遍历图中的顶点。遍历期间不能修改图。
+//
+// 参数：
+//   - K: 顶点的类型。
+type Iterator[K comparable] struct {
+	next func() (K, int, bool)
+}
+
+// Next 返回下一个顶点。
+//
+// 返回值：
+//   - v: 顶点。
+//   - depth: 顶点距离起点的层数（BFS）或在遍历树中的深度（DFS），起点为 0。
+//   - ok: 遍历结束时为 false。
+func (it *Iterator[K]) Next() (v K, depth int, ok bool) {
+	return it.next()
+}
+
+// Collect 返回剩余的所有顶点。
+func (it *Iterator[K]) Collect() []K {
+	var result []K
+	for v, _, ok := it.Next(); ok; v, _, ok = it.Next() {
+		result = append(result, v)
+	}
+	return result
+}
+
+// BFS 返回一个从 start 开始广度优先遍历的迭代器；start 不存在时迭代器为空。
+//
+// 示例：
+//
+//	it := g.BFS("a")
+//	for v, depth, ok := it.Next(); ok; v, depth, ok = it.Next() {
+//		fmt.Println(v, depth)
+//	}
+func (g *Graph[K, W]) BFS(start K) *Iterator[K] {
+	type item struct {
+		v     K
+		depth int
+	}
+	var queue []item
+	visited := make(map[K]bool)
+	if g.HasVertex(start) {
+		queue = append(queue, item{start, 0})
+		visited[start] = true
+	}
+
+	return &Iterator[K]{next: func() (K, int, bool) {
+		if len(queue) == 0 {
+			var zero K
+			return zero, 0, false
+		}
+		cur := queue[0]
+		queue = queue[1:]
+		for _, n := range g.out[cur.v].keys {
+			if !visited[n] {
+				visited[n] = true
+				queue = append(queue, item{n, cur.depth + 1})
+			}
+		}
+		return cur.v, cur.depth, true
+	}}
+}
+
+// DFS 返回一个从 start 开始深度优先遍历的迭代器，顶点按先序返回；start 不存在时迭代器为空。
+func (g *Graph[K, W]) DFS(start K) *Iterator[K] {
+	type frame struct {
+		v    K
+		next int
+	}
+	var stack []frame
+	visited := make(map[K]bool)
+	started := !g.HasVertex(start)
+
+	return &Iterator[K]{next: func() (K, int, bool) {
+		if !started {
+			started = true
+			visited[start] = true
+			stack = append(stack, frame{v: start})
+			return start, 0, true
+		}
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			neighbors := g.out[top.v].keys
+			for top.next < len(neighbors) {
+				n := neighbors[top.next]
+				top.next++
+				if !visited[n] {
+					visited[n] = true
+					stack = append(stack, frame{v: n})
+					return n, len(stack) - 1, true
+				}
+			}
+			stack = stack[:len(stack)-1]
+		}
+		var zero K
+		return zero, 0, false
+	}}
+}
+
+// MARK: - Topological Sort
+
+// CycleError 表示有向图中存在环，无法进行拓扑排序。
+type CycleError[K comparable] struct {
+	// Cycle 是环上的顶点，首尾相同，例如 [a b c a]。
+	Cycle []K
+}
+
+// Error 实现 error 接口。
+func (e *CycleError[K]) Error() string {
+	parts := make([]string, len(e.Cycle))
+	for i, v := range e.Cycle {
+		parts[i] = fmt.Sprint(v)
+	}
+	return "goexgraph: cycle detected: " + strings.Join(parts, " -> ")
+}
+
+// TopologicalSort 返回有向图的一个拓扑序：每条边的起点都排在终点之前。
+//
+// 入度相同的顶点按加入的顺序排列，因此结果是确定的。
+//
+// 返回值：
+//   - 拓扑序。
+//   - 图中存在环时为 *CycleError；无向图返回 ErrUndirected。
+func (g *Graph[K, W]) TopologicalSort() ([]K, error) {
+	if !g.directed {
+		return nil, ErrUndirected
+	}
+
+	indegree := make(map[K]int, len(g.vertices))
+	var queue []K
+	for _, v := range g.vertices {
+		indegree[v] = len(g.in[v].keys)
+		if indegree[v] == 0 {
+			queue = append(queue, v)
+		}
+	}
+
+	order := make([]K, 0, len(g.vertices))
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		order = append(order, v)
+		for _, n := range g.out[v].keys {
+			indegree[n]--
+			if indegree[n] == 0 {
+				queue = append(queue, n)
+			}
+		}
+	}
+
+	if len(order) < len(g.vertices) {
+		return nil, &CycleError[K]{Cycle: g.findCycle(indegree)}
+	}
+	return order, nil
+}
+
+// findCycle 在 indegree 仍大于 0 的顶点中找出一个环。
+//
+// Kahn 算法结束后剩余的顶点都至少有一条来自剩余顶点的入边，沿入边反向行走必然会回到走过的顶点。
+func (g *Graph[K, W]) findCycle(indegree map[K]int) []K {
+	var start K
+	for _, v := range g.vertices {
+		if indegree[v] > 0 {
+			start = v
+			break
+		}
+	}
+
+	position := make(map[K]int)
+	var walk []K
+	v := start
+	for {
+		if i, ok := position[v]; ok {
+			walk = walk[i:]
+			break
+		}
+		position[v] = len(walk)
+		walk = append(walk, v)
+		for _, p := range g.in[v].keys {
+			if indegree[p] > 0 {
+				v = p
+				break
+			}
+		}
+	}
+
+	// walk 是沿入边行走的顺序，反转后即为沿边的方向
+	slices.Reverse(walk)
+	return append(walk, walk[0])
+}
+
+// MARK: - Components
+
+// StronglyConnectedComponents 返回有向图的强连通分量（Tarjan 算法）；无向图返回连通分量。
+//
+// 分量按拓扑序排列：如果存在从分量 A 到分量 B 的边，A 排在 B 之前。分量内的顶点按加入的顺序排列。
+func (g *Graph[K, W]) StronglyConnectedComponents() [][]K {
+	t := &tarjan[K, W]{g: g, index: make(map[K]int), low: make(map[K]int), onStack: make(map[K]bool)}
+	for _, v := range g.vertices {
+		if _, ok := t.index[v]; !ok {
+			t.connect(v)
+		}
+	}
+
+	position := make(map[K]int, len(g.vertices))
+	for i, v := range g.vertices {
+		position[v] = i
+	}
+	slices.Reverse(t.components)
+	for _, c := range t.components {
+		slices.SortFunc(c, func(a, b K) int { return position[a] - position[b] })
+	}
+	return t.components
+}
+
+type tarjan[K comparable, W constraintsext.Number] struct {
+	g          *Graph[K, W]
+	counter    int
+	index      map[K]int
+	low        map[K]int
+	stack      []K
+	onStack    map[K]bool
+	components [][]K
+}
+
+func (t *tarjan[K, W]) connect(v K) {
+	t.index[v] = t.counter
+	t.low[v] = t.counter
+	t.counter++
+	t.stack = append(t.stack, v)
+	t.onStack[v] = true
+
+	for _, n := range t.g.out[v].keys {
+		if _, ok := t.index[n]; !ok {
+			t.connect(n)
+			t.low[v] = min(t.low[v], t.low[n])
+		} else if t.onStack[n] {
+			t.low[v] = min(t.low[v], t.index[n])
+		}
+	}
+
+	if t.low[v] == t.index[v] {
+		var component []K
+		for {
+			n := t.stack[len(t.stack)-1]
+			t.stack = t.stack[:len(t.stack)-1]
+			t.onStack[n] = false
+			component = append(component, n)
+			if n == v {
+				break
+			}
+		}
+		t.components = append(t.components, component)
+	}
+}
+
+// ConnectedComponents 返回图的（弱）连通分量：忽略边的方向后互相可达的顶点组成一个分量。
+//
+// 分量按其第一个顶点加入的顺序排列，分量内的顶点也按加入的顺序排列。
+func (g *Graph[K, W]) ConnectedComponents() [][]K {
+	component := make(map[K]int, len(g.vertices))
+	var components [][]K
+	for _, v := range g.vertices {
+		if _, ok := component[v]; ok {
+			continue
+		}
+		id := len(components)
+		component[v] = id
+		stack := []K{v}
+		for len(stack) > 0 {
+			cur := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, adj := range []*adjacency[K, W]{g.out[cur], g.in[cur]} {
+				for _, n := range adj.keys {
+					if _, ok := component[n]; !ok {
+						component[n] = id
+						stack = append(stack, n)
+					}
+				}
+			}
+		}
+		components = append(components, nil)
+	}
+	for _, v := range g.vertices {
+		components[component[v]] = append(components[component[v]], v)
+	}
+	return components
+}
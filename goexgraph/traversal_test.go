@@ -0,0 +1,158 @@
+package goexgraph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// newDAG 构造如下的有向无环图：
+//
+//	a -> b -> d
+//	a -> c -> d -> e
+func newDAG() *Graph[string, int] {
+	g := NewDirected[string, int]()
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("a", "c", 1)
+	g.AddEdge("b", "d", 1)
+	g.AddEdge("c", "d", 1)
+	g.AddEdge("d", "e", 1)
+	return g
+}
+
+func TestGraph_BFS(t *testing.T) {
+	g := newDAG()
+
+	var order []string
+	var depths []int
+	it := g.BFS("a")
+	for v, depth, ok := it.Next(); ok; v, depth, ok = it.Next() {
+		order = append(order, v)
+		depths = append(depths, depth)
+	}
+	if !reflect.DeepEqual(order, []string{"a", "b", "c", "d", "e"}) {
+		t.Errorf("Unexpected order %v", order)
+	}
+	if !reflect.DeepEqual(depths, []int{0, 1, 1, 2, 3}) {
+		t.Errorf("Unexpected depths %v", depths)
+	}
+	if got := g.BFS("missing").Collect(); len(got) != 0 {
+		t.Errorf("Expected empty iterator, but got %v", got)
+	}
+}
+
+func TestGraph_DFS(t *testing.T) {
+	g := newDAG()
+	g.AddEdge("e", "a", 1)
+
+	var order []string
+	var depths []int
+	it := g.DFS("a")
+	for v, depth, ok := it.Next(); ok; v, depth, ok = it.Next() {
+		order = append(order, v)
+		depths = append(depths, depth)
+	}
+	if !reflect.DeepEqual(order, []string{"a", "b", "d", "e", "c"}) {
+		t.Errorf("Unexpected order %v", order)
+	}
+	if !reflect.DeepEqual(depths, []int{0, 1, 2, 3, 1}) {
+		t.Errorf("Unexpected depths %v", depths)
+	}
+	if got := g.DFS("missing").Collect(); len(got) != 0 {
+		t.Errorf("Expected empty iterator, but got %v", got)
+	}
+}
+
+func TestGraph_TopologicalSort(t *testing.T) {
+	g := newDAG()
+	g.AddVertex("f")
+	order, err := g.TopologicalSort()
+	if err != nil || !reflect.DeepEqual(order, []string{"a", "f", "b", "c", "d", "e"}) {
+		t.Errorf("Unexpected order %v, %v", order, err)
+	}
+
+	g.AddEdge("e", "b", 1)
+	_, err = g.TopologicalSort()
+	var cycle *CycleError[string]
+	if !errors.As(err, &cycle) {
+		t.Fatalf("Expected CycleError, but got %v", err)
+	}
+	if !reflect.DeepEqual(cycle.Cycle, []string{"d", "e", "b", "d"}) {
+		t.Errorf("Unexpected cycle %v", cycle.Cycle)
+	}
+	if err.Error() != "goexgraph: cycle detected: d -> e -> b -> d" {
+		t.Errorf("Unexpected message %q", err.Error())
+	}
+
+	self := NewDirected[int, int]()
+	self.AddEdge(1, 1, 1)
+	var selfCycle *CycleError[int]
+	if _, err := self.TopologicalSort(); !errors.As(err, &selfCycle) || !reflect.DeepEqual(selfCycle.Cycle, []int{1, 1}) {
+		t.Errorf("Expected self loop to be a cycle, but got %v", err)
+	}
+
+	if _, err := NewUndirected[int, int]().TopologicalSort(); !errors.Is(err, ErrUndirected) {
+		t.Errorf("Expected %v, but got %v", ErrUndirected, err)
+	}
+}
+
+func TestGraph_TopologicalSort_CycleSets(t *testing.T) {
+	for _, edges := range [][][2]int{
+		{{1, 2}, {2, 1}},
+		{{1, 2}, {2, 3}, {3, 4}, {4, 2}, {4, 5}},
+		{{5, 1}, {1, 2}, {2, 3}, {3, 1}, {3, 4}},
+	} {
+		g := NewDirected[int, int]()
+		for _, e := range edges {
+			g.AddEdge(e[0], e[1], 1)
+		}
+		_, err := g.TopologicalSort()
+		var cycle *CycleError[int]
+		if !errors.As(err, &cycle) {
+			t.Fatalf("Expected CycleError for %v, but got %v", edges, err)
+		}
+		c := cycle.Cycle
+		if c[0] != c[len(c)-1] {
+			t.Errorf("Expected cycle to be closed, but got %v", c)
+		}
+		for i := 0; i+1 < len(c); i++ {
+			if !g.HasEdge(c[i], c[i+1]) {
+				t.Errorf("Expected %v to follow edges, but %d -> %d is missing", c, c[i], c[i+1])
+			}
+		}
+	}
+}
+
+func TestGraph_StronglyConnectedComponents(t *testing.T) {
+	g := NewDirected[int, int]()
+	for _, e := range [][2]int{{1, 2}, {2, 3}, {3, 1}, {3, 4}, {4, 5}, {5, 4}, {6, 5}} {
+		g.AddEdge(e[0], e[1], 1)
+	}
+
+	expected := [][]int{{6}, {1, 2, 3}, {4, 5}}
+	if got := g.StronglyConnectedComponents(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+
+	expected = [][]int{{1, 2, 3, 4, 5, 6}}
+	if got := g.ConnectedComponents(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+}
+
+func TestGraph_ConnectedComponents(t *testing.T) {
+	g := NewUndirected[string, int]()
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("c", "d", 1)
+	g.AddEdge("d", "b", 1)
+	g.AddVertex("e")
+	g.AddEdge("f", "g", 1)
+
+	expected := [][]string{{"a", "b", "c", "d"}, {"e"}, {"f", "g"}}
+	if got := g.ConnectedComponents(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+	if got := g.StronglyConnectedComponents(); len(got) != 3 {
+		t.Errorf("Expected undirected SCCs to be connected components, but got %v", got)
+	}
+}
@@ -0,0 +1,189 @@
+package goexinterval
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// Bound 是区间端点的类型。
+type Bound int
+
+const (
+	// Inclusive 表示端点属于区间（闭端点）。
+	Inclusive Bound = iota
+	// Exclusive 表示端点不属于区间（开端点）。
+	Exclusive
+)
+
+// String 返回端点类型的名称。
+func (b Bound) String() string {
+	switch b {
+	case Inclusive:
+		return "inclusive"
+	case Exclusive:
+		return "exclusive"
+	default:
+		return "unknown"
+	}
+}
+
+// flip 返回相反的端点类型，用于求补集时的边界，例如去掉 [2, 5] 后剩下的左侧部分以 2) 结尾。
+func (b Bound) flip() Bound {
+	if b == Inclusive {
+		return Exclusive
+	}
+	return Inclusive
+}
+
+// Interval 是由下界 Lo 与上界 Hi 组成的区间，端点是否包含在区间内由 LoBound 与 HiBound 决定。
+//
+// 区间按连续区间处理：对整数类型而言，[1, 2] 与 [3, 4] 不相邻，不会被 Set 合并；
+// 需要按离散值合并时请使用半开区间，例如 [1, 3) 与 [3, 5)。
+//
+// 参数：
+//   - T: 端点的类型，例如整数、浮点数、字符串。
+type Interval[T cmp.Ordered] struct {
+	Lo      T
+	Hi      T
+	LoBound Bound
+	HiBound Bound
+}
+
+// New 返回一个指定端点类型的区间。
+func New[T cmp.Ordered](lo, hi T, loBound, hiBound Bound) Interval[T] {
+	return Interval[T]{Lo: lo, Hi: hi, LoBound: loBound, HiBound: hiBound}
+}
+
+// Closed 返回闭区间 [lo, hi]。
+func Closed[T cmp.Ordered](lo, hi T) Interval[T] {
+	return New(lo, hi, Inclusive, Inclusive)
+}
+
+// Open 返回开区间 (lo, hi)。
+func Open[T cmp.Ordered](lo, hi T) Interval[T] {
+	return New(lo, hi, Exclusive, Exclusive)
+}
+
+// ClosedOpen 返回左闭右开区间 [lo, hi)，适合表示时间段、数组下标范围等首尾相接的区间。
+func ClosedOpen[T cmp.Ordered](lo, hi T) Interval[T] {
+	return New(lo, hi, Inclusive, Exclusive)
+}
+
+// OpenClosed 返回左开右闭区间 (lo, hi]。
+func OpenClosed[T cmp.Ordered](lo, hi T) Interval[T] {
+	return New(lo, hi, Exclusive, Inclusive)
+}
+
+// Point 返回只包含 v 的区间 [v, v]。
+func Point[T cmp.Ordered](v T) Interval[T] {
+	return Closed(v, v)
+}
+
+// IsEmpty 判断区间是否不包含任何值，例如 [2, 1]、[1, 1)。
+func (iv Interval[T]) IsEmpty() bool {
+	if iv.Lo == iv.Hi {
+		return iv.LoBound == Exclusive || iv.HiBound == Exclusive
+	}
+	return iv.Lo > iv.Hi
+}
+
+// Contains 判断 v 是否属于区间。
+//
+// 示例：
+//   - ClosedOpen(1, 5).Contains(5) 返回 false。
+func (iv Interval[T]) Contains(v T) bool {
+	return !belowLower(v, iv) && !aboveUpper(v, iv)
+}
+
+// ContainsInterval 判断 other 是否完全属于区间；空区间属于任何区间。
+func (iv Interval[T]) ContainsInterval(other Interval[T]) bool {
+	if other.IsEmpty() {
+		return true
+	}
+	return compareLower(iv, other) <= 0 && compareUpper(iv, other) >= 0
+}
+
+// Overlaps 判断两个区间是否至少有一个公共值。
+//
+// 示例：
+//   - ClosedOpen(1, 2).Overlaps(Closed(2, 3)) 返回 false。
+//   - Closed(1, 2).Overlaps(Closed(2, 3)) 返回 true。
+func (iv Interval[T]) Overlaps(other Interval[T]) bool {
+	_, ok := iv.Intersect(other)
+	return ok
+}
+
+// Intersect 返回两个区间的交集。
+//
+// 返回值：
+//   - Interval[T]: 交集。
+//   - bool: 两个区间没有公共值时返回 false。
+//
+// 示例：
+//   - Closed(1, 5).Intersect(Open(3, 8)) 返回 (3, 5], true。
+func (iv Interval[T]) Intersect(other Interval[T]) (Interval[T], bool) {
+	result := iv
+	if compareLower(other, iv) > 0 {
+		result.Lo, result.LoBound = other.Lo, other.LoBound
+	}
+	if compareUpper(other, iv) < 0 {
+		result.Hi, result.HiBound = other.Hi, other.HiBound
+	}
+	if result.IsEmpty() {
+		return Interval[T]{}, false
+	}
+	return result, true
+}
+
+// String 返回数学记法的字符串，例如 "[1, 5)"。
+func (iv Interval[T]) String() string {
+	left, right := "[", "]"
+	if iv.LoBound == Exclusive {
+		left = "("
+	}
+	if iv.HiBound == Exclusive {
+		right = ")"
+	}
+	return fmt.Sprintf("%s%v, %v%s", left, iv.Lo, iv.Hi, right)
+}
+
+// MARK: - Bound Comparison
+
+// compareLower 比较两个区间下界的位置，a 的下界更靠左时返回负数。
+// 值相同时闭端点比开端点更靠左，[1 在 (1 之前。
+func compareLower[T cmp.Ordered](a, b Interval[T]) int {
+	if c := cmp.Compare(a.Lo, b.Lo); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.LoBound, b.LoBound)
+}
+
+// compareUpper 比较两个区间上界的位置，a 的上界更靠右时返回正数。
+// 值相同时闭端点比开端点更靠右，1] 在 1) 之后。
+func compareUpper[T cmp.Ordered](a, b Interval[T]) int {
+	if c := cmp.Compare(a.Hi, b.Hi); c != 0 {
+		return c
+	}
+	return cmp.Compare(b.HiBound, a.HiBound)
+}
+
+// belowLower 判断 v 是否在区间下界的左侧（不属于区间）。
+func belowLower[T cmp.Ordered](v T, iv Interval[T]) bool {
+	return v < iv.Lo || (v == iv.Lo && iv.LoBound == Exclusive)
+}
+
+// aboveUpper 判断 v 是否在区间上界的右侧（不属于区间）。
+func aboveUpper[T cmp.Ordered](v T, iv Interval[T]) bool {
+	return v > iv.Hi || (v == iv.Hi && iv.HiBound == Exclusive)
+}
+
+// before 判断 a 是否完全位于 b 的左侧，两者没有公共值。
+func before[T cmp.Ordered](a, b Interval[T]) bool {
+	return a.Hi < b.Lo || (a.Hi == b.Lo && (a.HiBound == Exclusive || b.LoBound == Exclusive))
+}
+
+// beforeApart 判断 a 是否完全位于 b 的左侧且两者之间有空隙，即合并后不连续。
+// [1, 2) 与 [2, 3] 首尾相接，不算有空隙；[1, 2) 与 (2, 3] 之间缺少 2，算有空隙。
+func beforeApart[T cmp.Ordered](a, b Interval[T]) bool {
+	return a.Hi < b.Lo || (a.Hi == b.Lo && a.HiBound == Exclusive && b.LoBound == Exclusive)
+}
@@ -0,0 +1,150 @@
+package goexinterval
+
+import (
+	"testing"
+)
+
+func TestIsEmpty(t *testing.T) {
+	tests := []struct {
+		name     string
+		iv       Interval[int]
+		expected bool
+	}{
+		{"closed", Closed(1, 5), false},
+		{"point", Point(1), false},
+		{"closed open point", ClosedOpen(1, 1), true},
+		{"open point", Open(1, 1), true},
+		{"reversed", Closed(5, 1), true},
+		{"open", Open(1, 2), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.iv.IsEmpty(); got != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestContains(t *testing.T) {
+	tests := []struct {
+		name     string
+		iv       Interval[int]
+		v        int
+		expected bool
+	}{
+		{"closed lower", Closed(1, 5), 1, true},
+		{"closed upper", Closed(1, 5), 5, true},
+		{"open lower", Open(1, 5), 1, false},
+		{"open upper", Open(1, 5), 5, false},
+		{"closed open upper", ClosedOpen(1, 5), 5, false},
+		{"inside", Open(1, 5), 3, true},
+		{"outside", Closed(1, 5), 6, false},
+		{"empty", ClosedOpen(1, 1), 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.iv.Contains(tt.v); got != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestContainsInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		iv       Interval[float64]
+		other    Interval[float64]
+		expected bool
+	}{
+		{"same", Closed(1.0, 5.0), Closed(1.0, 5.0), true},
+		{"open in closed", Closed(1.0, 5.0), Open(1.0, 5.0), true},
+		{"closed in open", Open(1.0, 5.0), Closed(1.0, 5.0), false},
+		{"inner", Open(1.0, 5.0), Closed(2.0, 3.0), true},
+		{"partial", Closed(1.0, 5.0), Closed(4.0, 6.0), false},
+		{"empty", Closed(1.0, 5.0), Open(9.0, 9.0), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.iv.ContainsInterval(tt.other); got != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestOverlaps(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     Interval[int]
+		expected bool
+	}{
+		{"touching closed", Closed(1, 2), Closed(2, 3), true},
+		{"touching half open", ClosedOpen(1, 2), Closed(2, 3), false},
+		{"touching open closed", Closed(1, 2), OpenClosed(2, 3), false},
+		{"nested", Closed(1, 10), Open(3, 4), true},
+		{"apart", Closed(1, 2), Closed(3, 4), false},
+		{"empty", Closed(1, 10), ClosedOpen(5, 5), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Overlaps(tt.b); got != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+			if got := tt.b.Overlaps(tt.a); got != tt.expected {
+				t.Errorf("Expected symmetric %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     Interval[int]
+		expected Interval[int]
+		ok       bool
+	}{
+		{"partial", Closed(1, 5), Open(3, 8), OpenClosed(3, 5), true},
+		{"nested", Closed(1, 10), ClosedOpen(2, 4), ClosedOpen(2, 4), true},
+		{"same value bounds", Closed(1, 5), Open(1, 5), Open(1, 5), true},
+		{"single point", Closed(1, 2), Closed(2, 3), Point(2), true},
+		{"touching", ClosedOpen(1, 2), Closed(2, 3), Interval[int]{}, false},
+		{"apart", Closed(1, 2), Closed(5, 6), Interval[int]{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.a.Intersect(tt.b)
+			if got != tt.expected || ok != tt.ok {
+				t.Errorf("Expected %v %v, but got %v %v", tt.expected, tt.ok, got, ok)
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		iv       Interval[int]
+		expected string
+	}{
+		{Closed(1, 5), "[1, 5]"},
+		{Open(1, 5), "(1, 5)"},
+		{ClosedOpen(1, 5), "[1, 5)"},
+		{OpenClosed(1, 5), "(1, 5]"},
+	}
+	for _, tt := range tests {
+		if got := tt.iv.String(); got != tt.expected {
+			t.Errorf("Expected %v, but got %v", tt.expected, got)
+		}
+	}
+	if got := Closed("a", "m").String(); got != "[a, m]" {
+		t.Errorf("Expected %v, but got %v", "[a, m]", got)
+	}
+	if got := Exclusive.String(); got != "exclusive" {
+		t.Errorf("Expected %v, but got %v", "exclusive", got)
+	}
+	if got := Bound(9).String(); got != "unknown" {
+		t.Errorf("Expected %v, but got %v", "unknown", got)
+	}
+}
@@ -0,0 +1,252 @@
+package goexinterval
+
+import (
+	"cmp"
+	"sort"
+	"strings"
+)
+
+// Set 是一组互不相交的区间，添加区间时会自动合并重叠或首尾相接的区间。
+//
+// 区间按下界升序保存，按值或按区间查找都通过二分查找完成，时间复杂度为 O(log n + k)，k 为结果数量。
+// 零值是一个可用的空集合；Set 不是并发安全的。
+//
+// 示例：
+//
+//	var booked goexinterval.Set[int]
+//	booked.Add(goexinterval.ClosedOpen(9, 11))
+//	booked.Add(goexinterval.ClosedOpen(10, 12))
+//	booked.Intervals() // [[9, 12)]
+type Set[T cmp.Ordered] struct {
+	intervals []Interval[T]
+}
+
+// NewSet 返回包含 intervals 中所有区间的集合，空区间会被忽略。
+func NewSet[T cmp.Ordered](intervals ...Interval[T]) *Set[T] {
+	s := &Set[T]{}
+	for _, iv := range intervals {
+		s.Add(iv)
+	}
+	return s
+}
+
+// Len 返回合并后的区间数量。
+func (s *Set[T]) Len() int {
+	return len(s.intervals)
+}
+
+// IsEmpty 判断集合是否不包含任何值。
+func (s *Set[T]) IsEmpty() bool {
+	return len(s.intervals) == 0
+}
+
+// Intervals 返回按下界升序排列的区间副本。
+func (s *Set[T]) Intervals() []Interval[T] {
+	return append([]Interval[T](nil), s.intervals...)
+}
+
+// Clone 返回集合的副本。
+func (s *Set[T]) Clone() *Set[T] {
+	return &Set[T]{intervals: s.Intervals()}
+}
+
+// Equal 判断两个集合是否包含完全相同的值。
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	if len(s.intervals) != len(other.intervals) {
+		return false
+	}
+	for i, iv := range s.intervals {
+		if iv != other.intervals[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// String 返回形如 "{[1, 3), [5, 8]}" 的字符串。
+func (s *Set[T]) String() string {
+	parts := make([]string, len(s.intervals))
+	for i, iv := range s.intervals {
+		parts[i] = iv.String()
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// MARK: - Mutation
+
+// Add 把区间加入集合，并与重叠或首尾相接的区间合并，空区间会被忽略。
+//
+// 示例：
+//   - {[1, 3)} 加入 [3, 5] 后为 {[1, 5]}。
+//   - {[1, 3)} 加入 (3, 5] 后为 {[1, 3), (3, 5]}，因为 3 不属于任何一个区间。
+func (s *Set[T]) Add(iv Interval[T]) {
+	if iv.IsEmpty() {
+		return
+	}
+	// [i, j) 是与 iv 重叠或相接、需要合并的区间
+	i := sort.Search(len(s.intervals), func(k int) bool {
+		return !beforeApart(s.intervals[k], iv)
+	})
+	j := i + sort.Search(len(s.intervals)-i, func(k int) bool {
+		return beforeApart(iv, s.intervals[i+k])
+	})
+
+	merged := iv
+	if i < j {
+		if compareLower(s.intervals[i], merged) < 0 {
+			merged.Lo, merged.LoBound = s.intervals[i].Lo, s.intervals[i].LoBound
+		}
+		if compareUpper(s.intervals[j-1], merged) > 0 {
+			merged.Hi, merged.HiBound = s.intervals[j-1].Hi, s.intervals[j-1].HiBound
+		}
+	}
+	s.intervals = append(s.intervals[:i], append([]Interval[T]{merged}, s.intervals[j:]...)...)
+}
+
+// Remove 从集合中移除区间内的所有值，被部分覆盖的区间会被截断或拆分。
+//
+// 示例：
+//   - {[1, 10]} 移除 [4, 6) 后为 {[1, 4), [6, 10]}。
+func (s *Set[T]) Remove(iv Interval[T]) {
+	if iv.IsEmpty() {
+		return
+	}
+	// [i, j) 是与 iv 至少有一个公共值的区间
+	i := sort.Search(len(s.intervals), func(k int) bool {
+		return !before(s.intervals[k], iv)
+	})
+	j := i + sort.Search(len(s.intervals)-i, func(k int) bool {
+		return before(iv, s.intervals[i+k])
+	})
+	if i == j {
+		return
+	}
+
+	var rest []Interval[T]
+	first, last := s.intervals[i], s.intervals[j-1]
+	if left := New(first.Lo, iv.Lo, first.LoBound, iv.LoBound.flip()); !left.IsEmpty() {
+		rest = append(rest, left)
+	}
+	if right := New(iv.Hi, last.Hi, iv.HiBound.flip(), last.HiBound); !right.IsEmpty() {
+		rest = append(rest, right)
+	}
+	s.intervals = append(s.intervals[:i], append(rest, s.intervals[j:]...)...)
+}
+
+// Clear 移除集合中的所有区间。
+func (s *Set[T]) Clear() {
+	s.intervals = nil
+}
+
+// MARK: - Lookup
+
+// Contains 判断 v 是否属于集合中的某个区间。
+func (s *Set[T]) Contains(v T) bool {
+	_, ok := s.Find(v)
+	return ok
+}
+
+// Find 返回包含 v 的区间。
+//
+// 返回值：
+//   - Interval[T]: 包含 v 的区间。
+//   - bool: v 不属于集合时返回 false。
+func (s *Set[T]) Find(v T) (Interval[T], bool) {
+	i := sort.Search(len(s.intervals), func(k int) bool {
+		return !aboveUpper(v, s.intervals[k])
+	})
+	if i < len(s.intervals) && s.intervals[i].Contains(v) {
+		return s.intervals[i], true
+	}
+	return Interval[T]{}, false
+}
+
+// ContainsInterval 判断区间内的所有值是否都属于集合。
+func (s *Set[T]) ContainsInterval(iv Interval[T]) bool {
+	if iv.IsEmpty() {
+		return true
+	}
+	i := sort.Search(len(s.intervals), func(k int) bool {
+		return !before(s.intervals[k], iv)
+	})
+	return i < len(s.intervals) && s.intervals[i].ContainsInterval(iv)
+}
+
+// Overlapping 返回集合中与 iv 至少有一个公共值的区间，按下界升序排列。
+//
+// 示例：
+//   - {[1, 3), [5, 8], [10, 12]} 查找 [2, 6) 返回 [[1, 3), [5, 8]]。
+func (s *Set[T]) Overlapping(iv Interval[T]) []Interval[T] {
+	if iv.IsEmpty() {
+		return nil
+	}
+	i := sort.Search(len(s.intervals), func(k int) bool {
+		return !before(s.intervals[k], iv)
+	})
+	var result []Interval[T]
+	for ; i < len(s.intervals) && !before(iv, s.intervals[i]); i++ {
+		result = append(result, s.intervals[i])
+	}
+	return result
+}
+
+// Overlaps 判断 iv 是否与集合中的某个区间有公共值，例如判断预约时段是否冲突。
+func (s *Set[T]) Overlaps(iv Interval[T]) bool {
+	if iv.IsEmpty() {
+		return false
+	}
+	i := sort.Search(len(s.intervals), func(k int) bool {
+		return !before(s.intervals[k], iv)
+	})
+	return i < len(s.intervals) && !before(iv, s.intervals[i])
+}
+
+// Gaps 返回 within 中不属于集合的部分，即集合在 within 内的补集。
+//
+// 示例：
+//   - {[9, 10), [11, 12)} 在 [8, 13) 内的空隙为 [[8, 9), [10, 11), [12, 13)]。
+func (s *Set[T]) Gaps(within Interval[T]) []Interval[T] {
+	gaps := NewSet(within)
+	for _, iv := range s.Overlapping(within) {
+		gaps.Remove(iv)
+	}
+	return gaps.Intervals()
+}
+
+// MARK: - Set Operations
+
+// Union 返回两个集合的并集。
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	result := s.Clone()
+	for _, iv := range other.intervals {
+		result.Add(iv)
+	}
+	return result
+}
+
+// Intersect 返回两个集合的交集。
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	result := &Set[T]{}
+	a, b := s.intervals, other.intervals
+	for len(a) > 0 && len(b) > 0 {
+		if iv, ok := a[0].Intersect(b[0]); ok {
+			result.intervals = append(result.intervals, iv)
+		}
+		// 上界更靠左的区间不会再与后面的区间相交
+		if compareUpper(a[0], b[0]) < 0 {
+			a = a[1:]
+		} else {
+			b = b[1:]
+		}
+	}
+	return result
+}
+
+// Difference 返回属于 s 但不属于 other 的值组成的集合。
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	result := s.Clone()
+	for _, iv := range other.intervals {
+		result.Remove(iv)
+	}
+	return result
+}
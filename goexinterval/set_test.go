@@ -0,0 +1,225 @@
+package goexinterval
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestSetAdd(t *testing.T) {
+	tests := []struct {
+		name     string
+		add      []Interval[int]
+		expected []Interval[int]
+	}{
+		{"disjoint unordered", []Interval[int]{Closed(5, 6), Closed(1, 2)}, []Interval[int]{Closed(1, 2), Closed(5, 6)}},
+		{"overlapping", []Interval[int]{ClosedOpen(9, 11), ClosedOpen(10, 12)}, []Interval[int]{ClosedOpen(9, 12)}},
+		{"touching", []Interval[int]{ClosedOpen(1, 3), Closed(3, 5)}, []Interval[int]{Closed(1, 5)}},
+		{"touching with gap point", []Interval[int]{ClosedOpen(1, 3), OpenClosed(3, 5)}, []Interval[int]{ClosedOpen(1, 3), OpenClosed(3, 5)}},
+		{"fill gap point", []Interval[int]{ClosedOpen(1, 3), OpenClosed(3, 5), Point(3)}, []Interval[int]{Closed(1, 5)}},
+		{"bridge", []Interval[int]{Closed(1, 2), Closed(4, 5), Closed(7, 8), Closed(2, 7)}, []Interval[int]{Closed(1, 8)}},
+		{"contained", []Interval[int]{Closed(1, 10), Open(2, 3)}, []Interval[int]{Closed(1, 10)}},
+		{"widen bound", []Interval[int]{Open(1, 5), Closed(1, 2)}, []Interval[int]{ClosedOpen(1, 5)}},
+		{"empty ignored", []Interval[int]{ClosedOpen(1, 1), Closed(3, 2)}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewSet(tt.add...).Intervals()
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSetRemove(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      []Interval[int]
+		remove   Interval[int]
+		expected []Interval[int]
+	}{
+		{"split", []Interval[int]{Closed(1, 10)}, ClosedOpen(4, 6), []Interval[int]{ClosedOpen(1, 4), Closed(6, 10)}},
+		{"trim left", []Interval[int]{Closed(1, 10)}, Closed(0, 3), []Interval[int]{OpenClosed(3, 10)}},
+		{"trim right", []Interval[int]{Closed(1, 10)}, Open(8, 20), []Interval[int]{Closed(1, 8)}},
+		{"keep point", []Interval[int]{Closed(1, 10)}, Open(1, 10), []Interval[int]{Point(1), Point(10)}},
+		{"across", []Interval[int]{Closed(1, 3), Closed(5, 6), Closed(8, 10)}, Closed(2, 9), []Interval[int]{ClosedOpen(1, 2), OpenClosed(9, 10)}},
+		{"all", []Interval[int]{Closed(1, 3), Closed(5, 6)}, Closed(0, 10), nil},
+		{"touching only", []Interval[int]{ClosedOpen(1, 3)}, Closed(3, 5), []Interval[int]{ClosedOpen(1, 3)}},
+		{"miss", []Interval[int]{Closed(1, 3)}, Closed(5, 6), []Interval[int]{Closed(1, 3)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSet(tt.set...)
+			s.Remove(tt.remove)
+			got := s.Intervals()
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSetLookup(t *testing.T) {
+	s := NewSet(ClosedOpen(1, 3), Closed(5, 8), Open(10, 12))
+
+	t.Run("TestSetLookup_Contains", func(t *testing.T) {
+		tests := map[int]bool{0: false, 1: true, 2: true, 3: false, 5: true, 8: true, 9: false, 10: false, 11: true, 12: false}
+		for v, expected := range tests {
+			if got := s.Contains(v); got != expected {
+				t.Errorf("Contains(%v): Expected %v, but got %v", v, expected, got)
+			}
+		}
+	})
+
+	t.Run("TestSetLookup_Find", func(t *testing.T) {
+		got, ok := s.Find(6)
+		if !ok || got != Closed(5, 8) {
+			t.Errorf("Expected %v, but got %v %v", Closed(5, 8), got, ok)
+		}
+		if _, ok := s.Find(4); ok {
+			t.Errorf("Expected no interval for 4")
+		}
+	})
+
+	t.Run("TestSetLookup_Overlapping", func(t *testing.T) {
+		got := s.Overlapping(ClosedOpen(2, 6))
+		expected := []Interval[int]{ClosedOpen(1, 3), Closed(5, 8)}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected %v, but got %v", expected, got)
+		}
+		if got := s.Overlapping(Closed(3, 4)); got != nil {
+			t.Errorf("Expected nil, but got %v", got)
+		}
+	})
+
+	t.Run("TestSetLookup_Overlaps", func(t *testing.T) {
+		if s.Overlaps(Closed(3, 5)) != true {
+			t.Errorf("Expected [3, 5] to overlap")
+		}
+		if s.Overlaps(ClosedOpen(3, 5)) != false {
+			t.Errorf("Expected [3, 5) not to overlap")
+		}
+		if s.Overlaps(Closed(8, 10)) != true {
+			t.Errorf("Expected [8, 10] to overlap")
+		}
+	})
+
+	t.Run("TestSetLookup_ContainsInterval", func(t *testing.T) {
+		if !s.ContainsInterval(Closed(6, 7)) {
+			t.Errorf("Expected [6, 7] to be contained")
+		}
+		if s.ContainsInterval(Closed(2, 6)) {
+			t.Errorf("Expected [2, 6] not to be contained")
+		}
+		if s.ContainsInterval(Closed(10, 11)) {
+			t.Errorf("Expected [10, 11] not to be contained")
+		}
+	})
+}
+
+func TestSetGaps(t *testing.T) {
+	s := NewSet(ClosedOpen(9, 10), ClosedOpen(11, 12))
+	tests := []struct {
+		name     string
+		within   Interval[int]
+		expected []Interval[int]
+	}{
+		{"around", ClosedOpen(8, 13), []Interval[int]{ClosedOpen(8, 9), ClosedOpen(10, 11), ClosedOpen(12, 13)}},
+		{"inside", ClosedOpen(9, 12), []Interval[int]{ClosedOpen(10, 11)}},
+		{"closed", Closed(9, 12), []Interval[int]{ClosedOpen(10, 11), Point(12)}},
+		{"full", ClosedOpen(9, 10), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := s.Gaps(tt.within)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	a := NewSet(Closed(1, 5), Closed(10, 15))
+	b := NewSet(Open(3, 12), Closed(20, 21))
+
+	t.Run("TestSetOperations_Union", func(t *testing.T) {
+		expected := NewSet(Closed(1, 15), Closed(20, 21))
+		if got := a.Union(b); !got.Equal(expected) {
+			t.Errorf("Expected %v, but got %v", expected, got)
+		}
+	})
+
+	t.Run("TestSetOperations_Intersect", func(t *testing.T) {
+		expected := NewSet(OpenClosed(3, 5), ClosedOpen(10, 12))
+		if got := a.Intersect(b); !got.Equal(expected) {
+			t.Errorf("Expected %v, but got %v", expected, got)
+		}
+	})
+
+	t.Run("TestSetOperations_Difference", func(t *testing.T) {
+		expected := NewSet(Closed(1, 3), Closed(12, 15))
+		if got := a.Difference(b); !got.Equal(expected) {
+			t.Errorf("Expected %v, but got %v", expected, got)
+		}
+	})
+
+	t.Run("TestSetOperations_Unchanged", func(t *testing.T) {
+		expected := "{[1, 5], [10, 15]}"
+		if got := a.String(); got != expected {
+			t.Errorf("Expected %v, but got %v", expected, got)
+		}
+	})
+}
+
+func TestSetZeroValue(t *testing.T) {
+	var s Set[string]
+	if !s.IsEmpty() || s.Contains("a") {
+		t.Errorf("Expected zero value to be empty")
+	}
+	s.Add(Closed("a", "f"))
+	s.Add(Closed("m", "z"))
+	if !s.Contains("cat") || s.Contains("hello") {
+		t.Errorf("Expected string lookups to follow lexical order, got %v", s.String())
+	}
+	s.Clear()
+	if s.Len() != 0 {
+		t.Errorf("Expected %v, but got %v", 0, s.Len())
+	}
+}
+
+// TestSetRandom 用布尔数组模拟半开整数区间，校验合并与移除后的结果。
+func TestSetRandom(t *testing.T) {
+	const size = 60
+	r := rand.New(rand.NewSource(1))
+	for round := 0; round < 200; round++ {
+		var s Set[int]
+		var model [size]bool
+		for op := 0; op < 20; op++ {
+			lo := r.Intn(size)
+			hi := lo + r.Intn(size-lo+1)
+			add := r.Intn(3) > 0
+			if add {
+				s.Add(ClosedOpen(lo, hi))
+			} else {
+				s.Remove(ClosedOpen(lo, hi))
+			}
+			for v := lo; v < hi; v++ {
+				model[v] = add
+			}
+		}
+
+		for v := 0; v < size; v++ {
+			if s.Contains(v) != model[v] {
+				t.Fatalf("Round %v: Contains(%v) Expected %v, but got %v in %v", round, v, model[v], !model[v], s.String())
+			}
+		}
+		ivs := s.intervals
+		for i := 1; i < len(ivs); i++ {
+			if !beforeApart(ivs[i-1], ivs[i]) {
+				t.Fatalf("Round %v: intervals not normalized %v", round, s.String())
+			}
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package goexlimit
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+)
+
+// State 是熔断器的状态。
+type State int
+
+const (
+	// StateClosed 表示熔断器闭合，请求正常通过。
+	StateClosed State = iota
+	// StateOpen 表示熔断器断开，请求被直接拒绝。
+	StateOpen
+	// StateHalfOpen 表示熔断器半开，允许少量试探请求通过。
+	StateHalfOpen
+)
+
+// String 返回状态的名称。
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+var (
+	// ErrOpen 表示熔断器处于断开状态，请求被拒绝。
+	ErrOpen = errors.New("goexlimit: circuit breaker is open")
+	// ErrTooManyRequests 表示熔断器处于半开状态，且试探请求数量已达上限。
+	ErrTooManyRequests = errors.New("goexlimit: too many requests in half-open state")
+)
+
+// BreakerOptions 是创建 Breaker 时的配置项，所有字段均可省略。
+type BreakerOptions struct {
+	// FailureThreshold 是闭合状态下连续失败多少次后断开，默认为 5。
+	FailureThreshold int
+	// OpenTimeout 是断开后经过多久进入半开状态，默认为 30 秒。
+	OpenTimeout time.Duration
+	// HalfOpenMaxCalls 是半开状态下允许同时进行的试探请求数，默认为 1。
+	HalfOpenMaxCalls int
+	// SuccessThreshold 是半开状态下连续成功多少次后闭合，默认为 1。
+	SuccessThreshold int
+	// IsFailure 判断请求返回的错误是否计为失败，为 nil 时所有非 nil 错误都计为失败。
+	IsFailure func(err error) bool
+	// OnStateChange 在状态改变后被调用，调用时不持有熔断器的锁。
+	OnStateChange func(from, to State)
+	// Clock 用于计时，为 nil 时使用真实时钟。
+	Clock goexclock.Clock
+}
+
+// Breaker 是一个熔断器，在依赖连续失败时快速拒绝请求，给依赖恢复的时间。
+//
+// 状态转换：
+//   - 闭合：连续失败达到 FailureThreshold 次后断开。
+//   - 断开：经过 OpenTimeout 后进入半开。
+//   - 半开：连续成功达到 SuccessThreshold 次后闭合，任意一次失败重新断开。
+type Breaker struct {
+	mu       sync.Mutex
+	opts     BreakerOptions
+	clock    goexclock.Clock
+	state    State
+	openedAt time.Time
+
+	// generation 在每次状态改变时递增，用于忽略上一个状态中发出的请求的结果
+	generation uint64
+	failures   int
+	successes  int
+	inflight   int
+}
+
+// NewBreaker 创建一个处于闭合状态的熔断器。
+func NewBreaker(opts BreakerOptions) *Breaker {
+	if opts.FailureThreshold <= 0 {
+		opts.FailureThreshold = 5
+	}
+	if opts.OpenTimeout <= 0 {
+		opts.OpenTimeout = 30 * time.Second
+	}
+	if opts.HalfOpenMaxCalls <= 0 {
+		opts.HalfOpenMaxCalls = 1
+	}
+	if opts.SuccessThreshold <= 0 {
+		opts.SuccessThreshold = 1
+	}
+	return &Breaker{opts: opts, clock: goexclock.OrReal(opts.Clock)}
+}
+
+// State 返回熔断器当前的状态。
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	var change func()
+	state := b.currentState(b.clock.Now(), &change)
+	b.mu.Unlock()
+
+	if change != nil {
+		change()
+	}
+	return state
+}
+
+// Execute 在熔断器允许时执行 fn，并记录其结果。
+//
+// 返回值：
+//   - 熔断器拒绝时为 ErrOpen 或 ErrTooManyRequests，fn 不会执行；否则为 fn 返回的错误。
+func (b *Breaker) Execute(fn func() error) error {
+	done, err := b.Allow()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			done(errors.New("goexlimit: panic in breaker call"))
+			panic(r)
+		}
+	}()
+	err = fn()
+	done(err)
+	return err
+}
+
+// ExecuteValue 与 Breaker.Execute 相同，但 fn 会返回一个值。
+func ExecuteValue[T any](b *Breaker, fn func() (T, error)) (T, error) {
+	var value T
+	err := b.Execute(func() error {
+		var err error
+		value, err = fn()
+		return err
+	})
+	return value, err
+}
+
+// Allow 判断现在能否发出请求。
+//
+// 返回值：
+//   - done: 请求结束后必须调用一次，传入请求返回的错误。
+//   - err: 熔断器拒绝时为 ErrOpen 或 ErrTooManyRequests。
+func (b *Breaker) Allow() (done func(err error), err error) {
+	b.mu.Lock()
+	var change func()
+	state := b.currentState(b.clock.Now(), &change)
+
+	switch {
+	case state == StateOpen:
+		err = ErrOpen
+	case state == StateHalfOpen && b.inflight >= b.opts.HalfOpenMaxCalls:
+		err = ErrTooManyRequests
+	default:
+		b.inflight++
+	}
+	generation := b.generation
+	b.mu.Unlock()
+
+	if change != nil {
+		change()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var once sync.Once
+	return func(err error) {
+		once.Do(func() { b.record(generation, err) })
+	}, nil
+}
+
+func (b *Breaker) record(generation uint64, err error) {
+	b.mu.Lock()
+	var change func()
+	if generation == b.generation {
+		b.inflight--
+		if b.isFailure(err) {
+			b.onFailure(&change)
+		} else {
+			b.onSuccess(&change)
+		}
+	}
+	b.mu.Unlock()
+
+	if change != nil {
+		change()
+	}
+}
+
+func (b *Breaker) isFailure(err error) bool {
+	if b.opts.IsFailure != nil {
+		return b.opts.IsFailure(err)
+	}
+	return err != nil
+}
+
+func (b *Breaker) onFailure(change *func()) {
+	switch b.state {
+	case StateClosed:
+		b.failures++
+		if b.failures >= b.opts.FailureThreshold {
+			b.setState(StateOpen, change)
+		}
+	case StateHalfOpen:
+		b.setState(StateOpen, change)
+	}
+}
+
+func (b *Breaker) onSuccess(change *func()) {
+	switch b.state {
+	case StateClosed:
+		b.failures = 0
+	case StateHalfOpen:
+		b.successes++
+		if b.successes >= b.opts.SuccessThreshold {
+			b.setState(StateClosed, change)
+		}
+	}
+}
+
+// currentState 返回当前状态，断开时间已到时转换为半开。调用方必须持有锁。
+func (b *Breaker) currentState(now time.Time, change *func()) State {
+	if b.state == StateOpen && !now.Before(b.openedAt.Add(b.opts.OpenTimeout)) {
+		b.setState(StateHalfOpen, change)
+	}
+	return b.state
+}
+
+// setState 切换状态并重置计数，change 被设置为通知回调。调用方必须持有锁。
+func (b *Breaker) setState(to State, change *func()) {
+	from := b.state
+	if from == to {
+		return
+	}
+	b.state = to
+	b.generation++
+	b.failures, b.successes, b.inflight = 0, 0, 0
+	if to == StateOpen {
+		b.openedAt = b.clock.Now()
+	}
+
+	if b.opts.OnStateChange != nil {
+		prev := *change
+		*change = func() {
+			if prev != nil {
+				prev()
+			}
+			b.opts.OnStateChange(from, to)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package goexlimit
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+)
+
+var errFailed = errors.New("failed")
+
+func TestBreaker(t *testing.T) {
+	clock := goexclock.NewFake(time.Time{})
+	var changes []string
+	b := NewBreaker(BreakerOptions{
+		FailureThreshold: 2,
+		OpenTimeout:      time.Second,
+		SuccessThreshold: 2,
+		Clock:            clock,
+		OnStateChange: func(from, to State) {
+			changes = append(changes, fmt.Sprintf("%v->%v", from, to))
+		},
+	})
+	fail := func() error { return errFailed }
+	succeed := func() error { return nil }
+
+	b.Execute(fail)
+	b.Execute(succeed)
+	b.Execute(fail)
+	if got := b.State(); got != StateClosed {
+		t.Errorf("Expected success to reset failure count, but got %v", got)
+	}
+
+	b.Execute(fail)
+	if got := b.State(); got != StateOpen {
+		t.Errorf("Expected %v, but got %v", StateOpen, got)
+	}
+	called := false
+	if err := b.Execute(func() error { called = true; return nil }); !errors.Is(err, ErrOpen) || called {
+		t.Errorf("Expected %v without calling fn, but got %v", ErrOpen, err)
+	}
+
+	clock.Advance(time.Second)
+	if got := b.State(); got != StateHalfOpen {
+		t.Errorf("Expected %v, but got %v", StateHalfOpen, got)
+	}
+	b.Execute(fail)
+	if got := b.State(); got != StateOpen {
+		t.Errorf("Expected half-open failure to reopen, but got %v", got)
+	}
+
+	clock.Advance(time.Second)
+	b.Execute(succeed)
+	if got := b.State(); got != StateHalfOpen {
+		t.Errorf("Expected %v, but got %v", StateHalfOpen, got)
+	}
+	b.Execute(succeed)
+	if got := b.State(); got != StateClosed {
+		t.Errorf("Expected %v, but got %v", StateClosed, got)
+	}
+
+	expected := []string{
+		"closed->open", "open->half-open", "half-open->open",
+		"open->half-open", "half-open->closed",
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Expected %v, but got %v", expected, changes)
+	}
+}
+
+func TestBreaker_HalfOpenMaxCalls(t *testing.T) {
+	clock := goexclock.NewFake(time.Time{})
+	b := NewBreaker(BreakerOptions{FailureThreshold: 1, OpenTimeout: time.Second, Clock: clock})
+	b.Execute(func() error { return errFailed })
+	clock.Advance(time.Second)
+
+	done, err := b.Allow()
+	if err != nil {
+		t.Fatalf("Expected probe to be allowed, but got %v", err)
+	}
+	if _, err := b.Allow(); !errors.Is(err, ErrTooManyRequests) {
+		t.Errorf("Expected %v, but got %v", ErrTooManyRequests, err)
+	}
+	done(nil)
+	done(errFailed)
+	if got := b.State(); got != StateClosed {
+		t.Errorf("Expected done to only count once, but got %v", got)
+	}
+}
+
+func TestBreaker_StaleGeneration(t *testing.T) {
+	clock := goexclock.NewFake(time.Time{})
+	b := NewBreaker(BreakerOptions{FailureThreshold: 1, OpenTimeout: time.Second, Clock: clock})
+
+	slow, _ := b.Allow()
+	b.Execute(func() error { return errFailed })
+	clock.Advance(time.Second)
+	b.State()
+
+	// 断开之前发出的请求，其结果不影响半开状态
+	slow(errFailed)
+	if got := b.State(); got != StateHalfOpen {
+		t.Errorf("Expected %v, but got %v", StateHalfOpen, got)
+	}
+}
+
+func TestBreaker_IsFailure(t *testing.T) {
+	errNotFound := errors.New("not found")
+	b := NewBreaker(BreakerOptions{
+		FailureThreshold: 1,
+		IsFailure:        func(err error) bool { return err != nil && !errors.Is(err, errNotFound) },
+		Clock:            goexclock.NewFake(time.Time{}),
+	})
+
+	if err := b.Execute(func() error { return errNotFound }); !errors.Is(err, errNotFound) {
+		t.Errorf("Expected %v, but got %v", errNotFound, err)
+	}
+	if got := b.State(); got != StateClosed {
+		t.Errorf("Expected %v, but got %v", StateClosed, got)
+	}
+}
+
+func TestExecuteValue(t *testing.T) {
+	b := NewBreaker(BreakerOptions{FailureThreshold: 1, Clock: goexclock.NewFake(time.Time{})})
+
+	v, err := ExecuteValue(b, func() (int, error) { return 42, nil })
+	if v != 42 || err != nil {
+		t.Errorf("Expected (42, nil), but got (%v, %v)", v, err)
+	}
+	ExecuteValue(b, func() (int, error) { return 0, errFailed })
+	if _, err := ExecuteValue(b, func() (int, error) { return 1, nil }); !errors.Is(err, ErrOpen) {
+		t.Errorf("Expected %v, but got %v", ErrOpen, err)
+	}
+}
+
+func TestState_String(t *testing.T) {
+	tests := []struct {
+		state    State
+		expected string
+	}{
+		{StateClosed, "closed"},
+		{StateOpen, "open"},
+		{StateHalfOpen, "half-open"},
+		{State(9), "unknown"},
+	}
+	for _, tt := range tests {
+		if got := tt.state.String(); got != tt.expected {
+			t.Errorf("Expected %v, but got %v", tt.expected, got)
+		}
+	}
+}
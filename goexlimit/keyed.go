@@ -0,0 +1,115 @@
+package goexlimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+)
+
+// Keyed 为每个 key（例如用户 ID 或客户端 IP）维护一个独立的限流器。
+//
+// 长时间未被访问的限流器会被自动回收：每次访问时，如果距离上次清理已超过 idleTimeout，
+// 就会移除所有空闲时间超过 idleTimeout 的限流器。
+//
+// 参数：
+//   - K: key 的类型。
+type Keyed[K comparable] struct {
+	mu          sync.Mutex
+	clock       goexclock.Clock
+	factory     func(key K) Limiter
+	idleTimeout time.Duration
+	limiters    map[K]*keyedEntry
+	lastSweep   time.Time
+}
+
+type keyedEntry struct {
+	limiter  Limiter
+	lastUsed time.Time
+}
+
+// NewKeyed 创建一个 Keyed 限流器。
+//
+// 参数：
+//   - factory: 为新 key 创建限流器的函数。
+//   - idleTimeout: 限流器空闲多久后被回收，小于等于 0 表示永不回收。
+//   - clock: 时钟，为 nil 时使用真实时钟。
+//
+// 示例：
+//
+//	perUser := NewKeyed(func(id string) Limiter { return NewTokenBucket(10, 20, nil) }, 10*time.Minute, nil)
+//	if !perUser.Allow(userID) { return ErrTooManyRequests }
+func NewKeyed[K comparable](factory func(key K) Limiter, idleTimeout time.Duration, clock goexclock.Clock) *Keyed[K] {
+	clock = goexclock.OrReal(clock)
+	return &Keyed[K]{
+		clock:       clock,
+		factory:     factory,
+		idleTimeout: idleTimeout,
+		limiters:    make(map[K]*keyedEntry),
+		lastSweep:   clock.Now(),
+	}
+}
+
+// Get 返回 key 对应的限流器，不存在时通过 factory 创建。
+func (k *Keyed[K]) Get(key K) Limiter {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	now := k.clock.Now()
+	if k.idleTimeout > 0 && now.Sub(k.lastSweep) >= k.idleTimeout {
+		k.evictIdle(now)
+	}
+
+	e, ok := k.limiters[key]
+	if !ok {
+		e = &keyedEntry{limiter: k.factory(key)}
+		k.limiters[key] = e
+	}
+	e.lastUsed = now
+	return e.limiter
+}
+
+// Allow 判断 key 现在能否执行一次操作。
+func (k *Keyed[K]) Allow(key K) bool {
+	return k.Get(key).Allow()
+}
+
+// Wait 阻塞直到 key 可以执行一次操作，或 ctx 被取消。
+func (k *Keyed[K]) Wait(ctx context.Context, key K) error {
+	return k.Get(key).Wait(ctx)
+}
+
+// Len 返回当前维护的限流器数量。
+func (k *Keyed[K]) Len() int {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return len(k.limiters)
+}
+
+// EvictIdle 立即移除所有空闲时间超过 idleTimeout 的限流器。
+//
+// 返回值：
+//   - 被移除的限流器数量。
+func (k *Keyed[K]) EvictIdle() int {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return k.evictIdle(k.clock.Now())
+}
+
+func (k *Keyed[K]) evictIdle(now time.Time) int {
+	k.lastSweep = now
+	if k.idleTimeout <= 0 {
+		return 0
+	}
+	evicted := 0
+	for key, e := range k.limiters {
+		if now.Sub(e.lastUsed) >= k.idleTimeout {
+			delete(k.limiters, key)
+			evicted++
+		}
+	}
+	return evicted
+}
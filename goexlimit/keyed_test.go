@@ -0,0 +1,59 @@
+package goexlimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+)
+
+func TestKeyed(t *testing.T) {
+	clock := goexclock.NewFake(time.Time{})
+	created := 0
+	k := NewKeyed(func(key string) Limiter {
+		created++
+		return NewTokenBucket(1, 1, clock)
+	}, time.Minute, clock)
+
+	if !k.Allow("a") || !k.Allow("b") {
+		t.Errorf("Expected first call of each key to be allowed")
+	}
+	if k.Allow("a") {
+		t.Errorf("Expected keys to be limited independently")
+	}
+	if k.Get("a") != k.Get("a") || created != 2 {
+		t.Errorf("Expected limiter to be reused, but created %d", created)
+	}
+
+	clock.Advance(30 * time.Second)
+	k.Get("a")
+	clock.Advance(30 * time.Second)
+	// 下一次访问触发清理，b 已空闲一分钟而 a 只空闲了 30 秒
+	k.Get("c")
+	if got := k.Len(); got != 2 {
+		t.Errorf("Expected %v, but got %v", 2, got)
+	}
+
+	clock.Advance(time.Minute)
+	if got := k.EvictIdle(); got != 2 {
+		t.Errorf("Expected %v, but got %v", 2, got)
+	}
+	if got := k.Len(); got != 0 {
+		t.Errorf("Expected %v, but got %v", 0, got)
+	}
+}
+
+func TestKeyed_NoIdleTimeout(t *testing.T) {
+	clock := goexclock.NewFake(time.Time{})
+	k := NewKeyed(func(key int) Limiter { return NewSlidingWindow(1, time.Second, clock) }, 0, clock)
+
+	k.Get(1)
+	clock.Advance(24 * time.Hour)
+	k.Get(2)
+	if got := k.EvictIdle(); got != 0 {
+		t.Errorf("Expected %v, but got %v", 0, got)
+	}
+	if got := k.Len(); got != 2 {
+		t.Errorf("Expected %v, but got %v", 2, got)
+	}
+}
@@ -0,0 +1,102 @@
+package goexlimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+)
+
+// Limiter 是限流器的通用接口。
+type Limiter interface {
+	// Allow 判断现在能否执行一次操作，可以时消耗一个配额并返回 true。
+	Allow() bool
+	// AllowN 判断现在能否执行 n 次操作，可以时消耗 n 个配额并返回 true。
+	AllowN(n int) bool
+	// Wait 阻塞直到可以执行一次操作，或 ctx 被取消。
+	Wait(ctx context.Context) error
+	// WaitN 阻塞直到可以执行 n 次操作，或 ctx 被取消。
+	WaitN(ctx context.Context, n int) error
+	// Reserve 预留一次操作的配额，并返回需要等待的时间。
+	Reserve() *Reservation
+	// ReserveN 预留 n 次操作的配额，并返回需要等待的时间。
+	ReserveN(n int) *Reservation
+}
+
+// ErrExceedsLimit 表示一次请求的数量超过了限流器的容量，永远无法满足。
+var ErrExceedsLimit = errors.New("goexlimit: requested count exceeds limiter capacity")
+
+// Reservation 表示一次已预留的配额。
+type Reservation struct {
+	ok        bool
+	timeToAct time.Time
+	clock     goexclock.Clock
+	cancel    func()
+}
+
+// OK 判断预留是否成功；请求的数量超过容量时预留失败。
+func (r *Reservation) OK() bool {
+	return r.ok
+}
+
+// Delay 返回距离可以执行操作还需等待的时间，预留失败时返回 math.MaxInt64。
+func (r *Reservation) Delay() time.Duration {
+	if !r.ok {
+		return math.MaxInt64
+	}
+	return max(r.timeToAct.Sub(r.clock.Now()), 0)
+}
+
+// Cancel 放弃预留并尽可能归还配额。操作已经可以执行时调用不会有任何效果。
+func (r *Reservation) Cancel() {
+	if !r.ok || r.cancel == nil {
+		return
+	}
+	if r.clock.Now().Before(r.timeToAct) {
+		r.cancel()
+	}
+	r.cancel = nil
+}
+
+// reserver 由具体的限流器实现，maxWait 为允许等待的最长时间。
+type reserver interface {
+	reserve(now time.Time, n int, maxWait time.Duration) *Reservation
+}
+
+// wait 为 WaitN 提供统一实现：按 ctx 的截止时间预留配额，然后等待到可以执行。
+func wait(ctx context.Context, clock goexclock.Clock, r reserver, n int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	now := clock.Now()
+	maxWait := time.Duration(math.MaxInt64)
+	if deadline, ok := ctx.Deadline(); ok {
+		maxWait = deadline.Sub(now)
+	}
+	res := r.reserve(now, n, maxWait)
+	if !res.ok {
+		if maxWait == math.MaxInt64 {
+			return ErrExceedsLimit
+		}
+		return fmt.Errorf("goexlimit: wait for %d would exceed context deadline", n)
+	}
+
+	delay := res.timeToAct.Sub(now)
+	if delay <= 0 {
+		return nil
+	}
+	timer := clock.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C():
+		return nil
+	case <-ctx.Done():
+		res.Cancel()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,132 @@
+package goexlimit
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+)
+
+// SlidingWindow 是滑动窗口限流器：任意长度为 window 的时间段内最多允许 limit 次操作。
+//
+// 限流器记录每次操作的时间戳，内存占用为 O(limit)，结果是精确的。
+type SlidingWindow struct {
+	mu     sync.Mutex
+	clock  goexclock.Clock
+	limit  int
+	window time.Duration
+	events []time.Time
+}
+
+// NewSlidingWindow 创建一个滑动窗口限流器。
+//
+// 参数：
+//   - limit: 每个窗口内允许的最大操作次数。
+//   - window: 窗口长度。
+//   - clock: 时钟，为 nil 时使用真实时钟。
+//
+// 返回值：
+//   - 新的 SlidingWindow。
+func NewSlidingWindow(limit int, window time.Duration, clock goexclock.Clock) *SlidingWindow {
+	return &SlidingWindow{clock: goexclock.OrReal(clock), limit: limit, window: window}
+}
+
+// Count 返回当前窗口内（包括已预留的）操作次数。
+func (w *SlidingWindow) Count() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.prune(w.clock.Now())
+	return len(w.events)
+}
+
+// Allow 实现 Limiter 接口。
+func (w *SlidingWindow) Allow() bool {
+	return w.AllowN(1)
+}
+
+// AllowN 实现 Limiter 接口。
+func (w *SlidingWindow) AllowN(n int) bool {
+	return w.reserve(w.clock.Now(), n, 0).ok
+}
+
+// Wait 实现 Limiter 接口。
+func (w *SlidingWindow) Wait(ctx context.Context) error {
+	return w.WaitN(ctx, 1)
+}
+
+// WaitN 实现 Limiter 接口。
+func (w *SlidingWindow) WaitN(ctx context.Context, n int) error {
+	return wait(ctx, w.clock, w, n)
+}
+
+// Reserve 实现 Limiter 接口。
+func (w *SlidingWindow) Reserve() *Reservation {
+	return w.ReserveN(1)
+}
+
+// ReserveN 实现 Limiter 接口。
+func (w *SlidingWindow) ReserveN(n int) *Reservation {
+	return w.reserve(w.clock.Now(), n, math.MaxInt64)
+}
+
+func (w *SlidingWindow) reserve(now time.Time, n int, maxWait time.Duration) *Reservation {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if n > w.limit {
+		return &Reservation{clock: w.clock}
+	}
+	w.prune(now)
+
+	at := now
+	if over := len(w.events) + n - w.limit; over > 0 {
+		// 需要等待最早的 over 个操作移出窗口
+		at = w.events[over-1].Add(w.window)
+	}
+	if at.Sub(now) > maxWait {
+		return &Reservation{clock: w.clock}
+	}
+
+	i := sort.Search(len(w.events), func(i int) bool { return w.events[i].After(at) })
+	inserted := make([]time.Time, n)
+	for j := range inserted {
+		inserted[j] = at
+	}
+	w.events = append(w.events[:i], append(inserted, w.events[i:]...)...)
+
+	return &Reservation{
+		ok:        true,
+		timeToAct: at,
+		clock:     w.clock,
+		cancel: func() {
+			w.mu.Lock()
+			defer w.mu.Unlock()
+
+			w.remove(at, n)
+		},
+	}
+}
+
+// prune 删除已经移出窗口的操作。调用方必须持有锁。
+func (w *SlidingWindow) prune(now time.Time) {
+	cutoff := now.Add(-w.window)
+	i := sort.Search(len(w.events), func(i int) bool { return w.events[i].After(cutoff) })
+	w.events = w.events[i:]
+}
+
+// remove 删除最多 n 个时间为 at 的操作。调用方必须持有锁。
+func (w *SlidingWindow) remove(at time.Time, n int) {
+	kept := w.events[:0]
+	for _, e := range w.events {
+		if n > 0 && e.Equal(at) {
+			n--
+			continue
+		}
+		kept = append(kept, e)
+	}
+	w.events = kept
+}
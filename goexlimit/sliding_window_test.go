@@ -0,0 +1,84 @@
+package goexlimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+)
+
+func TestSlidingWindow_Allow(t *testing.T) {
+	clock := goexclock.NewFake(time.Time{})
+	w := NewSlidingWindow(3, time.Minute, clock)
+
+	w.Allow()
+	clock.Advance(20 * time.Second)
+	w.AllowN(2)
+	if w.Allow() {
+		t.Errorf("Expected call to be rejected when window is full")
+	}
+
+	clock.Advance(40 * time.Second)
+	if !w.Allow() {
+		t.Errorf("Expected first call to leave the window after one minute")
+	}
+	if w.Allow() {
+		t.Errorf("Expected window to be full again")
+	}
+	if got := w.Count(); got != 3 {
+		t.Errorf("Expected %v, but got %v", 3, got)
+	}
+
+	clock.Advance(20 * time.Second)
+	if got := w.Count(); got != 1 {
+		t.Errorf("Expected %v, but got %v", 1, got)
+	}
+	if w.AllowN(4) {
+		t.Errorf("Expected AllowN above limit to be rejected")
+	}
+}
+
+func TestSlidingWindow_Reserve(t *testing.T) {
+	clock := goexclock.NewFake(time.Time{})
+	w := NewSlidingWindow(2, time.Minute, clock)
+
+	w.Allow()
+	clock.Advance(10 * time.Second)
+	w.Allow()
+
+	r1 := w.Reserve()
+	r2 := w.Reserve()
+	if !r1.OK() || r1.Delay() != 50*time.Second {
+		t.Errorf("Expected %v, but got %v", 50*time.Second, r1.Delay())
+	}
+	if r2.Delay() != time.Minute {
+		t.Errorf("Expected %v, but got %v", time.Minute, r2.Delay())
+	}
+
+	r2.Cancel()
+	if got := w.Count(); got != 3 {
+		t.Errorf("Expected %v, but got %v", 3, got)
+	}
+	if r := w.ReserveN(3); r.OK() {
+		t.Errorf("Expected reservation above limit to fail")
+	}
+}
+
+func TestSlidingWindow_Wait(t *testing.T) {
+	clock := goexclock.NewFake(time.Time{})
+	w := NewSlidingWindow(1, time.Second, clock)
+	w.Allow()
+
+	done := make(chan error, 1)
+	go func() { done <- w.Wait(context.Background()) }()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Second)
+	if err := <-done; err != nil {
+		t.Errorf("Expected nil, but got %v", err)
+	}
+	if w.Allow() {
+		t.Errorf("Expected the waited call to occupy the window")
+	}
+}
@@ -0,0 +1,133 @@
+package goexlimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+)
+
+// Every 将事件之间的最小间隔转换为每秒的速率。
+//
+// 示例：
+//   - NewTokenBucket(Every(100*time.Millisecond), 1, nil) 创建每秒 10 个令牌的限流器。
+func Every(interval time.Duration) float64 {
+	if interval <= 0 {
+		return math.Inf(1)
+	}
+	return 1 / interval.Seconds()
+}
+
+// TokenBucket 是令牌桶限流器：令牌以固定速率生成，桶中最多存放 burst 个令牌。
+type TokenBucket struct {
+	mu     sync.Mutex
+	clock  goexclock.Clock
+	rate   float64
+	burst  int
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket 创建一个初始为满的令牌桶。
+//
+// 参数：
+//   - rate: 每秒生成的令牌数，可以使用 Every 从时间间隔换算。
+//   - burst: 桶的容量，即允许的最大突发数量。
+//   - clock: 时钟，为 nil 时使用真实时钟。
+//
+// 返回值：
+//   - 新的 TokenBucket。
+func NewTokenBucket(rate float64, burst int, clock goexclock.Clock) *TokenBucket {
+	clock = goexclock.OrReal(clock)
+	return &TokenBucket{clock: clock, rate: rate, burst: burst, tokens: float64(burst), last: clock.Now()}
+}
+
+// Tokens 返回当前可用的令牌数，有预留等待时可能为负数。
+func (b *TokenBucket) Tokens() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance(b.clock.Now())
+	return b.tokens
+}
+
+// Allow 实现 Limiter 接口。
+func (b *TokenBucket) Allow() bool {
+	return b.AllowN(1)
+}
+
+// AllowN 实现 Limiter 接口。
+func (b *TokenBucket) AllowN(n int) bool {
+	return b.reserve(b.clock.Now(), n, 0).ok
+}
+
+// Wait 实现 Limiter 接口。
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	return b.WaitN(ctx, 1)
+}
+
+// WaitN 实现 Limiter 接口。
+func (b *TokenBucket) WaitN(ctx context.Context, n int) error {
+	return wait(ctx, b.clock, b, n)
+}
+
+// Reserve 实现 Limiter 接口。
+func (b *TokenBucket) Reserve() *Reservation {
+	return b.ReserveN(1)
+}
+
+// ReserveN 实现 Limiter 接口。
+func (b *TokenBucket) ReserveN(n int) *Reservation {
+	return b.reserve(b.clock.Now(), n, math.MaxInt64)
+}
+
+func (b *TokenBucket) reserve(now time.Time, n int, maxWait time.Duration) *Reservation {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if math.IsInf(b.rate, 1) {
+		return &Reservation{ok: true, timeToAct: now, clock: b.clock}
+	}
+	if n > b.burst {
+		return &Reservation{clock: b.clock}
+	}
+
+	b.advance(now)
+	tokens := b.tokens - float64(n)
+	var delay time.Duration
+	if tokens < 0 {
+		if b.rate <= 0 {
+			return &Reservation{clock: b.clock}
+		}
+		delay = time.Duration(math.Ceil(-tokens / b.rate * float64(time.Second)))
+	}
+	if delay > maxWait {
+		return &Reservation{clock: b.clock}
+	}
+
+	b.tokens = tokens
+	return &Reservation{
+		ok:        true,
+		timeToAct: now.Add(delay),
+		clock:     b.clock,
+		cancel: func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			b.advance(b.clock.Now())
+			b.tokens = math.Min(b.tokens+float64(n), float64(b.burst))
+		},
+	}
+}
+
+// advance 按经过的时间补充令牌。调用方必须持有锁。
+func (b *TokenBucket) advance(now time.Time) {
+	if now.Before(b.last) {
+		return
+	}
+	elapsed := now.Sub(b.last).Seconds()
+	b.tokens = math.Min(b.tokens+elapsed*b.rate, float64(b.burst))
+	b.last = now
+}
@@ -0,0 +1,143 @@
+package goexlimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+)
+
+func TestTokenBucket_Allow(t *testing.T) {
+	clock := goexclock.NewFake(time.Time{})
+	b := NewTokenBucket(2, 3, clock)
+
+	for i := 0; i < 3; i++ {
+		if !b.Allow() {
+			t.Errorf("Expected burst call %d to be allowed", i)
+		}
+	}
+	if b.Allow() {
+		t.Errorf("Expected call to be rejected when bucket is empty")
+	}
+
+	clock.Advance(500 * time.Millisecond)
+	if !b.Allow() {
+		t.Errorf("Expected one token after 500ms at rate 2")
+	}
+	if b.Allow() {
+		t.Errorf("Expected bucket to be empty again")
+	}
+
+	clock.Advance(time.Hour)
+	if got := b.Tokens(); got != 3 {
+		t.Errorf("Expected %v, but got %v", 3, got)
+	}
+	if b.AllowN(4) {
+		t.Errorf("Expected AllowN above burst to be rejected")
+	}
+}
+
+func TestTokenBucket_Every(t *testing.T) {
+	clock := goexclock.NewFake(time.Time{})
+	b := NewTokenBucket(Every(100*time.Millisecond), 1, clock)
+
+	b.Allow()
+	clock.Advance(99 * time.Millisecond)
+	if b.Allow() {
+		t.Errorf("Expected call before interval to be rejected")
+	}
+	clock.Advance(time.Millisecond)
+	if !b.Allow() {
+		t.Errorf("Expected call after interval to be allowed")
+	}
+}
+
+func TestTokenBucket_Reserve(t *testing.T) {
+	clock := goexclock.NewFake(time.Time{})
+	b := NewTokenBucket(1, 2, clock)
+
+	b.AllowN(2)
+	r1 := b.Reserve()
+	r2 := b.Reserve()
+	if !r1.OK() || r1.Delay() != time.Second {
+		t.Errorf("Expected %v, but got %v", time.Second, r1.Delay())
+	}
+	if r2.Delay() != 2*time.Second {
+		t.Errorf("Expected %v, but got %v", 2*time.Second, r2.Delay())
+	}
+
+	r2.Cancel()
+	if got := b.Tokens(); got != -1 {
+		t.Errorf("Expected %v, but got %v", -1, got)
+	}
+
+	if r := b.ReserveN(3); r.OK() {
+		t.Errorf("Expected reservation above burst to fail")
+	}
+}
+
+func TestTokenBucket_Wait(t *testing.T) {
+	t.Run("TestTokenBucket_Wait_Blocks", func(t *testing.T) {
+		clock := goexclock.NewFake(time.Time{})
+		b := NewTokenBucket(1, 1, clock)
+		b.Allow()
+
+		done := make(chan error, 1)
+		go func() { done <- b.Wait(context.Background()) }()
+
+		clock.BlockUntil(1)
+		select {
+		case <-done:
+			t.Fatalf("Expected Wait to block until a token is available")
+		default:
+		}
+		clock.Advance(time.Second)
+		if err := <-done; err != nil {
+			t.Errorf("Expected nil, but got %v", err)
+		}
+	})
+
+	t.Run("TestTokenBucket_Wait_Cancelled", func(t *testing.T) {
+		clock := goexclock.NewFake(time.Time{})
+		b := NewTokenBucket(1, 1, clock)
+		b.Allow()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() { done <- b.Wait(ctx) }()
+
+		clock.BlockUntil(1)
+		cancel()
+		if err := <-done; !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected %v, but got %v", context.Canceled, err)
+		}
+		// 取消等待后令牌被归还
+		if got := b.Tokens(); got != 0 {
+			t.Errorf("Expected %v, but got %v", 0, got)
+		}
+	})
+
+	t.Run("TestTokenBucket_Wait_ExceedsLimit", func(t *testing.T) {
+		b := NewTokenBucket(1, 1, goexclock.NewFake(time.Time{}))
+		if err := b.WaitN(context.Background(), 2); !errors.Is(err, ErrExceedsLimit) {
+			t.Errorf("Expected %v, but got %v", ErrExceedsLimit, err)
+		}
+	})
+
+	t.Run("TestTokenBucket_Wait_Deadline", func(t *testing.T) {
+		clock := goexclock.NewFake(time.Now())
+		b := NewTokenBucket(Every(time.Hour), 1, clock)
+		b.Allow()
+
+		ctx, cancel := context.WithDeadline(context.Background(), clock.Now().Add(time.Minute))
+		defer cancel()
+		if err := b.Wait(ctx); err == nil || errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected Wait to fail fast without waiting for the deadline, but got %v", err)
+		}
+		if got := b.Tokens(); got != 0 {
+			t.Errorf("Expected %v, but got %v", 0, got)
+		}
+	})
+}
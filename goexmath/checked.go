@@ -0,0 +1,113 @@
+package goexmath
+
+import (
+	"errors"
+
+	"github.com/birdmichael/GoEx/constraintsext"
+)
+
+// ErrOverflow 表示整数运算的结果超出了类型的取值范围。
+var ErrOverflow = errors.New("goexmath: integer overflow")
+
+// ErrDivideByZero 表示除数为零。
+var ErrDivideByZero = errors.New("goexmath: division by zero")
+
+// isSigned 判断类型 T 是否为有符号整数。
+func isSigned[T constraintsext.Integer]() bool {
+	return ^T(0) < 0
+}
+
+// isMinSigned 判断 v 是否为有符号整数类型的最小值，它是唯一一个取反后仍等于自身的负数。
+func isMinSigned[T constraintsext.Integer](v T) bool {
+	return v < 0 && -v == v
+}
+
+// AddChecked 返回 a + b，结果超出类型 T 的取值范围时返回 ErrOverflow。
+//
+// 支持所有有符号与无符号整数类型。
+//
+// 示例：
+//   - AddChecked[int8](100, 27) 返回 127, nil。
+//   - AddChecked[uint8](200, 56) 返回 0, ErrOverflow。
+func AddChecked[T constraintsext.Integer](a, b T) (T, error) {
+	result := a + b
+	if (b > 0 && result < a) || (b < 0 && result > a) {
+		return 0, ErrOverflow
+	}
+	return result, nil
+}
+
+// SubChecked 返回 a - b，结果超出类型 T 的取值范围时返回 ErrOverflow。
+//
+// 示例：
+//   - SubChecked[uint](1, 2) 返回 0, ErrOverflow。
+func SubChecked[T constraintsext.Integer](a, b T) (T, error) {
+	result := a - b
+	if (b > 0 && result > a) || (b < 0 && result < a) {
+		return 0, ErrOverflow
+	}
+	return result, nil
+}
+
+// MulChecked 返回 a * b，结果超出类型 T 的取值范围时返回 ErrOverflow。
+//
+// 示例：
+//   - MulChecked[int32](1<<16, 1<<15) 返回 0, ErrOverflow。
+//   - MulChecked[int8](-1, -128) 返回 0, ErrOverflow。
+func MulChecked[T constraintsext.Integer](a, b T) (T, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	result := a * b
+	if result/b != a {
+		return 0, ErrOverflow
+	}
+	// -1 * MinInt 的结果回绕为 MinInt，且 MinInt / -1 仍等于 MinInt，上面的检查无法发现
+	if isSigned[T]() && ((a == ^T(0) && isMinSigned(b)) || (b == ^T(0) && isMinSigned(a))) {
+		return 0, ErrOverflow
+	}
+	return result, nil
+}
+
+// DivChecked 返回 a / b，b 为 0 时返回 ErrDivideByZero，MinInt / -1 溢出时返回 ErrOverflow。
+func DivChecked[T constraintsext.Integer](a, b T) (T, error) {
+	if b == 0 {
+		return 0, ErrDivideByZero
+	}
+	if isSigned[T]() && b == ^T(0) && isMinSigned(a) {
+		return 0, ErrOverflow
+	}
+	return a / b, nil
+}
+
+// AbsChecked 返回 v 的绝对值，v 为有符号整数的最小值时返回 ErrOverflow。
+func AbsChecked[T constraintsext.Integer](v T) (T, error) {
+	if isMinSigned(v) {
+		return 0, ErrOverflow
+	}
+	return Abs(v), nil
+}
+
+// PowChecked 返回 base 的 exp 次方，结果超出类型 T 的取值范围时返回 ErrOverflow。
+//
+// 示例：
+//   - PowChecked[int64](10, 18) 返回 1e18, nil。
+//   - PowChecked[int64](10, 19) 返回 0, ErrOverflow。
+func PowChecked[T constraintsext.Integer](base T, exp uint) (T, error) {
+	result := T(1)
+	var err error
+	for exp > 0 {
+		if exp&1 == 1 {
+			if result, err = MulChecked(result, base); err != nil {
+				return 0, err
+			}
+		}
+		exp >>= 1
+		if exp > 0 {
+			if base, err = MulChecked(base, base); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,138 @@
+package goexmath
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestAddSubChecked(t *testing.T) {
+	testCases := []struct {
+		name string
+		op   func(a, b int8) (int8, error)
+		a, b int8
+		want int8
+		err  error
+	}{
+		{"AddMax", AddChecked[int8], 100, 27, 127, nil},
+		{"AddOverflow", AddChecked[int8], 100, 28, 0, ErrOverflow},
+		{"AddUnderflow", AddChecked[int8], -100, -29, 0, ErrOverflow},
+		{"SubMin", SubChecked[int8], -100, 28, -128, nil},
+		{"SubUnderflow", SubChecked[int8], -100, 29, 0, ErrOverflow},
+		{"SubNegateMin", SubChecked[int8], 0, -128, 0, ErrOverflow},
+		{"SubMinusMin", SubChecked[int8], -1, -128, 127, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestAddSubChecked_"+tc.name, func(t *testing.T) {
+			got, err := tc.op(tc.a, tc.b)
+			if got != tc.want || !errors.Is(err, tc.err) {
+				t.Errorf("Expected %v, %v, but got %v, %v", tc.want, tc.err, got, err)
+			}
+		})
+	}
+
+	t.Run("TestAddSubChecked_Unsigned", func(t *testing.T) {
+		if got, err := AddChecked[uint8](200, 55); got != 255 || err != nil {
+			t.Errorf("Expected %v, but got %v, %v", 255, got, err)
+		}
+		if _, err := AddChecked[uint8](200, 56); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Expected %v, but got %v", ErrOverflow, err)
+		}
+		if _, err := SubChecked[uint8](1, 2); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Expected %v, but got %v", ErrOverflow, err)
+		}
+	})
+}
+
+func TestMulChecked(t *testing.T) {
+	testCases := []struct {
+		a, b int8
+		want int8
+		err  error
+	}{
+		{0, -128, 0, nil},
+		{-1, 127, -127, nil},
+		{-1, -128, 0, ErrOverflow},
+		{-128, -1, 0, ErrOverflow},
+		{-128, 1, -128, nil},
+		{16, 8, 0, ErrOverflow},
+		{-16, 8, -128, nil},
+		{11, 11, 121, nil},
+		{12, 11, 0, ErrOverflow},
+	}
+
+	for _, tc := range testCases {
+		got, err := MulChecked(tc.a, tc.b)
+		if got != tc.want || !errors.Is(err, tc.err) {
+			t.Errorf("MulChecked(%d, %d): Expected %v, %v, but got %v, %v", tc.a, tc.b, tc.want, tc.err, got, err)
+		}
+	}
+
+	if _, err := MulChecked[uint64](math.MaxUint32+1, math.MaxUint32+1); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v, but got %v", ErrOverflow, err)
+	}
+	if got, err := MulChecked[uint8](255, 1); got != 255 || err != nil {
+		t.Errorf("Expected %v, but got %v, %v", 255, got, err)
+	}
+}
+
+func TestMulChecked_Exhaustive(t *testing.T) {
+	for a := math.MinInt8; a <= math.MaxInt8; a++ {
+		for b := math.MinInt8; b <= math.MaxInt8; b++ {
+			product := a * b
+			got, err := MulChecked(int8(a), int8(b))
+			if overflow := product < math.MinInt8 || product > math.MaxInt8; overflow != (err != nil) || (!overflow && int(got) != product) {
+				t.Fatalf("MulChecked(%d, %d): Expected %d (overflow %t), but got %d, %v", a, b, product, overflow, got, err)
+			}
+		}
+	}
+}
+
+func TestDivAbsChecked(t *testing.T) {
+	if _, err := DivChecked(1, 0); !errors.Is(err, ErrDivideByZero) {
+		t.Errorf("Expected %v, but got %v", ErrDivideByZero, err)
+	}
+	if _, err := DivChecked[int8](-128, -1); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v, but got %v", ErrOverflow, err)
+	}
+	if got, err := DivChecked[uint8](255, 255); got != 1 || err != nil {
+		t.Errorf("Expected %v, but got %v, %v", 1, got, err)
+	}
+	if got, err := DivChecked(-7, 2); got != -3 || err != nil {
+		t.Errorf("Expected %v, but got %v, %v", -3, got, err)
+	}
+
+	if _, err := AbsChecked[int64](math.MinInt64); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v, but got %v", ErrOverflow, err)
+	}
+	if got, err := AbsChecked[int64](-5); got != 5 || err != nil {
+		t.Errorf("Expected %v, but got %v, %v", 5, got, err)
+	}
+}
+
+func TestPowChecked(t *testing.T) {
+	testCases := []struct {
+		base int64
+		exp  uint
+		want int64
+		err  error
+	}{
+		{10, 18, 1e18, nil},
+		{10, 19, 0, ErrOverflow},
+		{2, 62, 1 << 62, nil},
+		{2, 63, 0, ErrOverflow},
+		{-2, 63, math.MinInt64, nil},
+		{-2, 64, 0, ErrOverflow},
+		{1, 1000, 1, nil},
+		{-1, 1001, -1, nil},
+		{0, 0, 1, nil},
+	}
+
+	for _, tc := range testCases {
+		got, err := PowChecked(tc.base, tc.exp)
+		if got != tc.want || !errors.Is(err, tc.err) {
+			t.Errorf("PowChecked(%d, %d): Expected %v, %v, but got %v, %v", tc.base, tc.exp, tc.want, tc.err, got, err)
+		}
+	}
+}
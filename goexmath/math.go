@@ -0,0 +1,89 @@
+package goexmath
+
+import (
+	"cmp"
+
+	"github.com/birdmichael/GoEx/constraintsext"
+)
+
+// Clamp 将 v 限制在闭区间 [lo, hi] 内，相当于 Swift 中的 clamped(to:)。
+//
+// lo 大于 hi 时会 panic。
+//
+// 示例：
+//   - Clamp(15, 0, 10) 返回 10。
+//   - Clamp(-3, 0, 10) 返回 0。
+func Clamp[T cmp.Ordered](v, lo, hi T) T {
+	if hi < lo {
+		panic("goexmath: Clamp called with lo > hi")
+	}
+	return min(max(v, lo), hi)
+}
+
+// Abs 返回 v 的绝对值。
+//
+// 对有符号整数的最小值（例如 math.MinInt64）取绝对值会溢出，结果仍为该最小值；需要检测时请使用 AbsChecked。
+//
+// 示例：
+//   - Abs(-5) 返回 5。
+//   - Abs(-2.5) 返回 2.5。
+func Abs[T constraintsext.Number](v T) T {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// Sign 返回 v 的符号：负数返回 -1，零返回 0，正数返回 1，NaN 返回 0。
+func Sign[T constraintsext.Number](v T) int {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// GCD 返回 a 与 b 的最大公约数，结果总是非负的；a 与 b 都为 0 时返回 0。
+//
+// 示例：
+//   - GCD(12, 18) 返回 6。
+//   - GCD(-4, 6) 返回 2。
+func GCD[T constraintsext.Integer](a, b T) T {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return Abs(a)
+}
+
+// LCM 返回 a 与 b 的最小公倍数，结果总是非负的；a 或 b 为 0 时返回 0。
+//
+// 结果超出类型 T 的取值范围时会溢出，需要检测时请使用 MulChecked 自行计算。
+//
+// 示例：
+//   - LCM(4, 6) 返回 12。
+func LCM[T constraintsext.Integer](a, b T) T {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return Abs(a / GCD(a, b) * b)
+}
+
+// Pow 返回 base 的 exp 次方，使用快速幂算法，结果溢出时会回绕；需要检测溢出时请使用 PowChecked。
+//
+// 示例：
+//   - Pow(2, 10) 返回 1024。
+//   - Pow(-3, 3) 返回 -27。
+func Pow[T constraintsext.Integer](base T, exp uint) T {
+	result := T(1)
+	for exp > 0 {
+		if exp&1 == 1 {
+			result *= base
+		}
+		base *= base
+		exp >>= 1
+	}
+	return result
+}
@@ -0,0 +1,117 @@
+package goexmath
+
+import (
+	"math"
+	"testing"
+)
+
+func TestClamp(t *testing.T) {
+	testCases := []struct {
+		v, lo, hi int
+		want      int
+	}{
+		{5, 0, 10, 5},
+		{15, 0, 10, 10},
+		{-3, 0, 10, 0},
+		{7, 7, 7, 7},
+	}
+
+	for _, tc := range testCases {
+		if got := Clamp(tc.v, tc.lo, tc.hi); got != tc.want {
+			t.Errorf("Clamp(%d, %d, %d): Expected %d, but got %d", tc.v, tc.lo, tc.hi, tc.want, got)
+		}
+	}
+
+	if got := Clamp("m", "a", "f"); got != "f" {
+		t.Errorf("Expected %q, but got %q", "f", got)
+	}
+
+	t.Run("TestClamp_InvalidBounds", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Expected panic")
+			}
+		}()
+		Clamp(1, 10, 0)
+	})
+}
+
+func TestAbsSign(t *testing.T) {
+	if got := Abs(-5); got != 5 {
+		t.Errorf("Expected %v, but got %v", 5, got)
+	}
+	if got := Abs(-2.5); got != 2.5 {
+		t.Errorf("Expected %v, but got %v", 2.5, got)
+	}
+	if got := Abs(uint(3)); got != 3 {
+		t.Errorf("Expected %v, but got %v", 3, got)
+	}
+
+	testCases := []struct {
+		v    float64
+		want int
+	}{
+		{-0.1, -1},
+		{0, 0},
+		{3, 1},
+		{math.NaN(), 0},
+		{math.Inf(-1), -1},
+	}
+	for _, tc := range testCases {
+		if got := Sign(tc.v); got != tc.want {
+			t.Errorf("Sign(%v): Expected %d, but got %d", tc.v, tc.want, got)
+		}
+	}
+}
+
+func TestGCDLCM(t *testing.T) {
+	testCases := []struct {
+		a, b     int
+		gcd, lcm int
+	}{
+		{12, 18, 6, 36},
+		{-4, 6, 2, 12},
+		{4, -6, 2, 12},
+		{0, 5, 5, 0},
+		{0, 0, 0, 0},
+		{7, 13, 1, 91},
+	}
+
+	for _, tc := range testCases {
+		if got := GCD(tc.a, tc.b); got != tc.gcd {
+			t.Errorf("GCD(%d, %d): Expected %d, but got %d", tc.a, tc.b, tc.gcd, got)
+		}
+		if got := LCM(tc.a, tc.b); got != tc.lcm {
+			t.Errorf("LCM(%d, %d): Expected %d, but got %d", tc.a, tc.b, tc.lcm, got)
+		}
+	}
+
+	if got := GCD(uint8(200), uint8(150)); got != 50 {
+		t.Errorf("Expected %v, but got %v", 50, got)
+	}
+}
+
+func TestPow(t *testing.T) {
+	testCases := []struct {
+		base int64
+		exp  uint
+		want int64
+	}{
+		{2, 10, 1024},
+		{-3, 3, -27},
+		{5, 0, 1},
+		{0, 0, 1},
+		{0, 3, 0},
+		{10, 18, 1e18},
+	}
+
+	for _, tc := range testCases {
+		if got := Pow(tc.base, tc.exp); got != tc.want {
+			t.Errorf("Pow(%d, %d): Expected %d, but got %d", tc.base, tc.exp, tc.want, got)
+		}
+	}
+
+	if got := Pow(uint8(2), 8); got != 0 {
+		t.Errorf("Expected wrapped result %v, but got %v", 0, got)
+	}
+}
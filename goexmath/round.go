@@ -0,0 +1,74 @@
+package goexmath
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Round 将 x 四舍五入到小数点后 decimals 位，恰好一半时远离零取整。
+//
+// 舍入基于 x 的最短十进制表示进行，因此 1.005 会按字面值舍入为 1.01，
+// 而不是像 math.Round(x*100)/100 那样因二进制误差得到 1.00。decimals 为负数时舍入到十位、百位等。
+//
+// 示例：
+//   - Round(1.005, 2) 返回 1.01。
+//   - Round(-2.5, 0) 返回 -3。
+//   - Round(1234.5, -2) 返回 1200。
+func Round(x float64, decimals int) float64 {
+	return roundDecimal(x, decimals, false)
+}
+
+// RoundHalfEven 将 x 舍入到小数点后 decimals 位，恰好一半时取偶数（银行家舍入），可以避免大量累加时的统计偏差。
+//
+// 示例：
+//   - RoundHalfEven(2.5, 0) 返回 2。
+//   - RoundHalfEven(3.5, 0) 返回 4。
+//   - RoundHalfEven(1.125, 2) 返回 1.12。
+func RoundHalfEven(x float64, decimals int) float64 {
+	return roundDecimal(x, decimals, true)
+}
+
+func roundDecimal(x float64, decimals int, halfEven bool) float64 {
+	if x == 0 || math.IsNaN(x) || math.IsInf(x, 0) {
+		return x
+	}
+
+	// 最短十进制表示 d.ddddde±xx，数值等于 0.dddddd × 10^(exp+1)
+	mantissa, exponent, _ := strings.Cut(strconv.FormatFloat(math.Abs(x), 'e', -1, 64), "e")
+	digits := []byte(strings.Replace(mantissa, ".", "", 1))
+	exp, _ := strconv.Atoi(exponent)
+
+	keep := exp + 1 + decimals
+	if keep >= len(digits) {
+		return x
+	}
+	if keep < 0 {
+		return math.Copysign(0, x)
+	}
+
+	next := digits[keep]
+	rest := strings.TrimRight(string(digits[keep+1:]), "0") != ""
+	up := next > '5' || (next == '5' && (rest || !halfEven || (keep > 0 && (digits[keep-1]-'0')%2 == 1)))
+
+	kept := digits[:keep]
+	if up {
+		i := len(kept) - 1
+		for ; i >= 0 && kept[i] == '9'; i-- {
+			kept[i] = '0'
+		}
+		if i >= 0 {
+			kept[i]++
+		} else {
+			// 进位到最高位，例如 999 -> 1000，数量级由 kept 的长度体现
+			kept = append([]byte{'1'}, kept...)
+		}
+	}
+	if len(kept) == 0 {
+		return math.Copysign(0, x)
+	}
+
+	// kept 是整数部分，乘以 10^(exp+1-keep) 还原数量级
+	result, _ := strconv.ParseFloat(string(kept)+"e"+strconv.Itoa(exp+1-keep), 64)
+	return math.Copysign(result, x)
+}
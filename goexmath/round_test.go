@@ -0,0 +1,72 @@
+package goexmath
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRound(t *testing.T) {
+	testCases := []struct {
+		x        float64
+		decimals int
+		want     float64
+	}{
+		{1.005, 2, 1.01},
+		{1.004, 2, 1},
+		{2.675, 2, 2.68},
+		{-2.5, 0, -3},
+		{2.5, 0, 3},
+		{0.5, 0, 1},
+		{0.05, 0, 0},
+		{9.995, 2, 10},
+		{99.5, 0, 100},
+		{1234.5, -2, 1200},
+		{1250, -2, 1300},
+		{49, -2, 0},
+		{123.456, 5, 123.456},
+		{1e-20, 2, 0},
+		{0.0001234, 5, 0.00012},
+	}
+
+	for _, tc := range testCases {
+		if got := Round(tc.x, tc.decimals); got != tc.want {
+			t.Errorf("Round(%v, %d): Expected %v, but got %v", tc.x, tc.decimals, tc.want, got)
+		}
+	}
+}
+
+func TestRoundHalfEven(t *testing.T) {
+	testCases := []struct {
+		x        float64
+		decimals int
+		want     float64
+	}{
+		{2.5, 0, 2},
+		{3.5, 0, 4},
+		{-2.5, 0, -2},
+		{0.5, 0, 0},
+		{1.125, 2, 1.12},
+		{1.135, 2, 1.14},
+		{1.1251, 2, 1.13},
+		{1250, -2, 1200},
+		{1350, -2, 1400},
+	}
+
+	for _, tc := range testCases {
+		if got := RoundHalfEven(tc.x, tc.decimals); got != tc.want {
+			t.Errorf("RoundHalfEven(%v, %d): Expected %v, but got %v", tc.x, tc.decimals, tc.want, got)
+		}
+	}
+}
+
+func TestRound_Special(t *testing.T) {
+	if got := Round(math.NaN(), 2); !math.IsNaN(got) {
+		t.Errorf("Expected NaN, but got %v", got)
+	}
+	if got := Round(math.Inf(1), 2); !math.IsInf(got, 1) {
+		t.Errorf("Expected +Inf, but got %v", got)
+	}
+	if got := Round(-0.001, 2); got != 0 || !math.Signbit(got) {
+		t.Errorf("Expected -0, but got %v", got)
+	}
+}
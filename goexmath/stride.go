@@ -0,0 +1,73 @@
+package goexmath
+
+import (
+	"github.com/birdmichael/GoEx/constraintsext"
+	"github.com/birdmichael/GoEx/goexslice"
+)
+
+// Stride 返回一个从 from 开始、每次增加 by、不包含 to 的惰性序列，相当于 Swift 中的 stride(from:to:by:)。
+//
+// by 可以为负数以生成递减序列，by 为 0 时会 panic。第 i 个值按 from + i*by 计算，
+// 因此浮点数步长不会累积误差；整数序列在到达类型的取值范围边界时结束，不会回绕。
+//
+// 需要切片时调用 Collect。
+//
+// 示例：
+//   - Stride(0, 10, 3).Collect() 返回 [0 3 6 9]。
+//   - Stride(1.0, 0.0, -0.25).Collect() 返回 [1 0.75 0.5 0.25]。
+func Stride[T constraintsext.Number](from, to, by T) *goexslice.Generator[T] {
+	return stride(from, to, by, false)
+}
+
+// StrideThrough 与 Stride 相同，但在恰好到达 through 时包含它，相当于 Swift 中的 stride(from:through:by:)。
+//
+// 示例：
+//   - StrideThrough(0, 9, 3).Collect() 返回 [0 3 6 9]。
+//   - StrideThrough(10, 0, -5).Collect() 返回 [10 5 0]。
+func StrideThrough[T constraintsext.Number](from, through, by T) *goexslice.Generator[T] {
+	return stride(from, through, by, true)
+}
+
+func stride[T constraintsext.Number](from, to, by T, inclusive bool) *goexslice.Generator[T] {
+	if by == 0 {
+		panic("goexmath: stride step must not be zero")
+	}
+
+	var (
+		index    int
+		previous T
+	)
+	return goexslice.NewGenerator(func() (T, bool) {
+		v := from + T(index)*by
+		if index > 0 {
+			// 数值没有按步长方向前进，说明整数已经回绕或浮点数精度不足以继续前进
+			if (by > 0 && v <= previous) || (by < 0 && v >= previous) {
+				return 0, false
+			}
+		}
+		inRange := (by > 0 && (v < to || (inclusive && v == to))) ||
+			(by < 0 && (v > to || (inclusive && v == to)))
+		if !inRange {
+			return 0, false
+		}
+		index++
+		previous = v
+		return v, true
+	})
+}
+
+// Range 返回 [start, end) 内步长为 1 的整数切片，start 不小于 end 时返回空切片。
+//
+// 示例：
+//   - Range(0, 5) 返回 [0 1 2 3 4]。
+func Range[T constraintsext.Integer](start, end T) []T {
+	if start >= end {
+		return []T{}
+	}
+	// 先转换为 int 再相减，避免 int8 等窄类型相减溢出
+	result := make([]T, 0, max(int(end)-int(start), 0))
+	for v := start; v < end; v++ {
+		result = append(result, v)
+	}
+	return result
+}
@@ -0,0 +1,83 @@
+package goexmath
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestStride(t *testing.T) {
+	testCases := []struct {
+		name string
+		got  []int
+		want []int
+	}{
+		{"Up", Stride(0, 10, 3).Collect(), []int{0, 3, 6, 9}},
+		{"UpExclusive", Stride(0, 9, 3).Collect(), []int{0, 3, 6}},
+		{"Down", Stride(10, 0, -5).Collect(), []int{10, 5}},
+		{"Empty", Stride(5, 0, 1).Collect(), nil},
+		{"ThroughUp", StrideThrough(0, 9, 3).Collect(), []int{0, 3, 6, 9}},
+		{"ThroughNotReached", StrideThrough(0, 10, 3).Collect(), []int{0, 3, 6, 9}},
+		{"ThroughDown", StrideThrough(10, 0, -5).Collect(), []int{10, 5, 0}},
+		{"ThroughSingle", StrideThrough(3, 3, 1).Collect(), []int{3}},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestStride_"+tc.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tc.got, tc.want) {
+				t.Errorf("Expected %v, but got %v", tc.want, tc.got)
+			}
+		})
+	}
+}
+
+func TestStride_Float(t *testing.T) {
+	got := Stride(1.0, 0.0, -0.25).Collect()
+	if want := []float64{1, 0.75, 0.5, 0.25}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, but got %v", want, got)
+	}
+
+	// 0.1 累加 10 次不等于 1，按 from + i*by 计算则恰好包含 1
+	got = StrideThrough(0.0, 1.0, 0.1).Collect()
+	if len(got) != 11 || got[10] != 1 {
+		t.Errorf("Expected 11 values ending with 1, but got %v", got)
+	}
+}
+
+func TestStride_NoWrap(t *testing.T) {
+	got := StrideThrough[int8](120, 127, 3).Collect()
+	if want := []int8{120, 123, 126}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, but got %v", want, got)
+	}
+
+	all := StrideThrough[uint8](0, math.MaxUint8, 1).Collect()
+	if len(all) != 256 || all[255] != math.MaxUint8 {
+		t.Errorf("Expected 256 values, but got %d", len(all))
+	}
+
+	down := StrideThrough[int8](-120, math.MinInt8, -7).Collect()
+	if want := []int8{-120, -127}; !reflect.DeepEqual(down, want) {
+		t.Errorf("Expected %v, but got %v", want, down)
+	}
+}
+
+func TestStride_ZeroStep(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected panic")
+		}
+	}()
+	Stride(0, 1, 0)
+}
+
+func TestRange(t *testing.T) {
+	if got, want := Range(0, 5), []int{0, 1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, but got %v", want, got)
+	}
+	if got := Range(5, 5); len(got) != 0 {
+		t.Errorf("Expected empty, but got %v", got)
+	}
+	if got := Range[int8](-100, 100); len(got) != 200 || got[199] != 99 {
+		t.Errorf("Expected 200 values ending with 99, but got %d", len(got))
+	}
+}
@@ -0,0 +1,414 @@
+package goexpersistent
+
+import (
+	"math/bits"
+
+	"github.com/birdmichael/GoEx/internal/hashutil"
+)
+
+// maxShift 是位图节点使用的最大位移，超过后 64 位哈希已全部用完，剩余冲突存放在冲突节点中。
+const maxShift = 60
+
+type hentry[K comparable, V any] struct {
+	hash  uint64
+	key   K
+	value V
+	child *hnode[K, V]
+}
+
+type hnode[K comparable, V any] struct {
+	edit      *owner
+	bitmap    uint32
+	entries   []hentry[K, V]
+	collision bool
+}
+
+// Map 是一个不可变的持久化 map，基于哈希数组映射前缀树（HAMT）实现。
+//
+// 所有修改操作都返回新的 Map，原 Map 保持不变；新旧版本之间共享未修改的节点，
+// Set、Delete 与 Get 的开销为 O(log32 n)。Map 的零值是一个使用内置哈希函数的空 map。
+//
+// 参数：
+//   - K: key 的类型。
+//   - V: value 的类型。
+type Map[K comparable, V any] struct {
+	root   *hnode[K, V]
+	count  int
+	hasher func(key K) uint64
+}
+
+// NewMap 创建一个空 Map。
+//
+// 参数：
+//   - hasher: key 的哈希函数，为 nil 时使用内置哈希；对结构体等复杂 key 提供自定义哈希可以显著提升性能。
+//
+// 返回值：
+//   - 空的 Map。
+func NewMap[K comparable, V any](hasher func(key K) uint64) Map[K, V] {
+	return Map[K, V]{hasher: hasher}
+}
+
+// MapFromMap 创建一个包含 m 中所有键值对的 Map。
+func MapFromMap[K comparable, V any](m map[K]V) Map[K, V] {
+	b := Map[K, V]{}.Builder()
+	for k, v := range m {
+		b.Set(k, v)
+	}
+	return b.Map()
+}
+
+// MARK: - Read
+
+// Len 返回键值对的个数。
+func (m Map[K, V]) Len() int {
+	return m.count
+}
+
+// IsEmpty 判断 map 是否为空。
+func (m Map[K, V]) IsEmpty() bool {
+	return m.count == 0
+}
+
+// Get 返回 key 对应的值。
+//
+// 返回值：
+//   - value: key 对应的值，不存在时为零值。
+//   - ok: key 是否存在。
+func (m Map[K, V]) Get(key K) (value V, ok bool) {
+	if m.root == nil {
+		return value, false
+	}
+	h := m.hash(key)
+	n := m.root
+	for shift := uint(0); ; shift += nodeBits {
+		if n.collision {
+			for _, e := range n.entries {
+				if e.key == key {
+					return e.value, true
+				}
+			}
+			return value, false
+		}
+		bit := uint32(1) << ((h >> shift) & mask)
+		if n.bitmap&bit == 0 {
+			return value, false
+		}
+		e := n.entries[index(n.bitmap, bit)]
+		if e.child == nil {
+			if e.key == key {
+				return e.value, true
+			}
+			return value, false
+		}
+		n = e.child
+	}
+}
+
+// Has 判断 key 是否存在。
+func (m Map[K, V]) Has(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Range 遍历所有键值对，回调返回 false 时停止遍历。遍历顺序由哈希值决定，但对同一个 Map 是稳定的。
+func (m Map[K, V]) Range(fn func(key K, value V) bool) {
+	if m.root != nil {
+		m.root.walk(fn)
+	}
+}
+
+// Keys 返回所有 key 组成的切片，顺序与 Range 一致。
+func (m Map[K, V]) Keys() []K {
+	keys := make([]K, 0, m.count)
+	m.Range(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values 返回所有 value 组成的切片，顺序与 Range 一致。
+func (m Map[K, V]) Values() []V {
+	values := make([]V, 0, m.count)
+	m.Range(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// ToMap 返回包含所有键值对的普通 map。
+func (m Map[K, V]) ToMap() map[K]V {
+	result := make(map[K]V, m.count)
+	m.Range(func(key K, value V) bool {
+		result[key] = value
+		return true
+	})
+	return result
+}
+
+// MARK: - Update
+
+// Set 返回将 key 设置为 value 后的新 Map。
+//
+// 示例：
+//   - m2 := m.Set("a", 1) 之后 m 不包含 "a"，m2 包含 "a"。
+func (m Map[K, V]) Set(key K, value V) Map[K, V] {
+	s := m.state(nil)
+	s.set(key, value)
+	return s.toMap()
+}
+
+// Delete 返回删除 key 后的新 Map，key 不存在时返回原 Map。
+func (m Map[K, V]) Delete(key K) Map[K, V] {
+	s := m.state(nil)
+	if !s.delete(key) {
+		return m
+	}
+	return s.toMap()
+}
+
+// Builder 返回一个以当前 Map 为初始内容的可变构建器，用于高效地批量修改。
+func (m Map[K, V]) Builder() *MapBuilder[K, V] {
+	return &MapBuilder[K, V]{s: m.state(&owner{})}
+}
+
+func (m Map[K, V]) hash(key K) uint64 {
+	if m.hasher == nil {
+		return hashutil.Hash(key)
+	}
+	return m.hasher(key)
+}
+
+func (m Map[K, V]) state(edit *owner) *mapState[K, V] {
+	return &mapState[K, V]{m: m, edit: edit}
+}
+
+// MARK: - Builder
+
+// MapBuilder 是 Map 的可变构建器（transient），用于批量 Set/Delete。
+//
+// MapBuilder 不是并发安全的；调用 Map 之后构建器失效，不能再使用。
+type MapBuilder[K comparable, V any] struct {
+	s *mapState[K, V]
+}
+
+// Len 返回构建器中键值对的个数。
+func (b *MapBuilder[K, V]) Len() int {
+	b.check()
+	return b.s.m.count
+}
+
+// Get 返回 key 对应的值。
+func (b *MapBuilder[K, V]) Get(key K) (V, bool) {
+	b.check()
+	return b.s.m.Get(key)
+}
+
+// Set 将 key 设置为 value。
+func (b *MapBuilder[K, V]) Set(key K, value V) *MapBuilder[K, V] {
+	b.check()
+	b.s.set(key, value)
+	return b
+}
+
+// Delete 删除 key。
+func (b *MapBuilder[K, V]) Delete(key K) *MapBuilder[K, V] {
+	b.check()
+	b.s.delete(key)
+	return b
+}
+
+// Map 返回构建结果，之后构建器失效。
+func (b *MapBuilder[K, V]) Map() Map[K, V] {
+	b.check()
+	m := b.s.toMap()
+	b.s = nil
+	return m
+}
+
+func (b *MapBuilder[K, V]) check() {
+	if b.s == nil {
+		panic("goexpersistent: MapBuilder used after Map()")
+	}
+}
+
+// MARK: - HAMT
+
+func index(bitmap, bit uint32) int {
+	return bits.OnesCount32(bitmap & (bit - 1))
+}
+
+// mapState 实现 HAMT 的修改操作，edit 的含义与 vecState 相同。
+type mapState[K comparable, V any] struct {
+	m    Map[K, V]
+	edit *owner
+}
+
+func (s *mapState[K, V]) toMap() Map[K, V] {
+	return s.m
+}
+
+func (s *mapState[K, V]) set(key K, value V) {
+	h := s.m.hash(key)
+	if s.m.root == nil {
+		s.m.root = &hnode[K, V]{edit: s.edit}
+	}
+	root, added := s.setIn(s.m.root, 0, hentry[K, V]{hash: h, key: key, value: value})
+	s.m.root = root
+	if added {
+		s.m.count++
+	}
+}
+
+func (s *mapState[K, V]) delete(key K) bool {
+	if s.m.root == nil {
+		return false
+	}
+	root, removed := s.deleteIn(s.m.root, 0, s.m.hash(key), key)
+	if !removed {
+		return false
+	}
+	s.m.root = root
+	s.m.count--
+	return true
+}
+
+func (s *mapState[K, V]) editable(n *hnode[K, V], extra int) *hnode[K, V] {
+	if s.edit != nil && n.edit == s.edit {
+		return n
+	}
+	c := &hnode[K, V]{edit: s.edit, bitmap: n.bitmap, collision: n.collision}
+	c.entries = make([]hentry[K, V], len(n.entries), len(n.entries)+extra)
+	copy(c.entries, n.entries)
+	return c
+}
+
+func (s *mapState[K, V]) setIn(n *hnode[K, V], shift uint, e hentry[K, V]) (*hnode[K, V], bool) {
+	if n.collision {
+		for i, item := range n.entries {
+			if item.key == e.key {
+				ret := s.editable(n, 0)
+				ret.entries[i].value = e.value
+				return ret, false
+			}
+		}
+		ret := s.editable(n, 1)
+		ret.entries = append(ret.entries, e)
+		return ret, true
+	}
+
+	bit := uint32(1) << ((e.hash >> shift) & mask)
+	idx := index(n.bitmap, bit)
+	if n.bitmap&bit == 0 {
+		ret := s.editable(n, 1)
+		ret.entries = append(ret.entries, hentry[K, V]{})
+		copy(ret.entries[idx+1:], ret.entries[idx:])
+		ret.entries[idx] = e
+		ret.bitmap |= bit
+		return ret, true
+	}
+
+	cur := n.entries[idx]
+	switch {
+	case cur.child != nil:
+		child, added := s.setIn(cur.child, shift+nodeBits, e)
+		ret := s.editable(n, 0)
+		ret.entries[idx].child = child
+		return ret, added
+	case cur.key == e.key:
+		ret := s.editable(n, 0)
+		ret.entries[idx].value = e.value
+		return ret, false
+	default:
+		ret := s.editable(n, 0)
+		ret.entries[idx] = hentry[K, V]{child: s.pair(shift+nodeBits, cur, e)}
+		return ret, true
+	}
+}
+
+// pair 创建一个同时包含 e1 与 e2 的子节点。
+func (s *mapState[K, V]) pair(shift uint, e1, e2 hentry[K, V]) *hnode[K, V] {
+	if shift > maxShift {
+		return &hnode[K, V]{edit: s.edit, collision: true, entries: []hentry[K, V]{e1, e2}}
+	}
+	i1 := (e1.hash >> shift) & mask
+	i2 := (e2.hash >> shift) & mask
+	n := &hnode[K, V]{edit: s.edit, bitmap: 1<<i1 | 1<<i2}
+	switch {
+	case i1 == i2:
+		n.entries = []hentry[K, V]{{child: s.pair(shift+nodeBits, e1, e2)}}
+	case i1 < i2:
+		n.entries = []hentry[K, V]{e1, e2}
+	default:
+		n.entries = []hentry[K, V]{e2, e1}
+	}
+	return n
+}
+
+// deleteIn 从 n 中删除 key，返回新节点（为空时返回 nil）以及 key 是否存在。
+func (s *mapState[K, V]) deleteIn(n *hnode[K, V], shift uint, h uint64, key K) (*hnode[K, V], bool) {
+	if n.collision {
+		for i, item := range n.entries {
+			if item.key == key {
+				return s.removeAt(n, i, 0), true
+			}
+		}
+		return n, false
+	}
+
+	bit := uint32(1) << ((h >> shift) & mask)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	idx := index(n.bitmap, bit)
+	cur := n.entries[idx]
+
+	if cur.child == nil {
+		if cur.key != key {
+			return n, false
+		}
+		return s.removeAt(n, idx, bit), true
+	}
+
+	child, removed := s.deleteIn(cur.child, shift+nodeBits, h, key)
+	if !removed {
+		return n, false
+	}
+	if child == nil {
+		return s.removeAt(n, idx, bit), true
+	}
+	ret := s.editable(n, 0)
+	if len(child.entries) == 1 && child.entries[0].child == nil {
+		// 子节点只剩一个键值对时将其上提，保持树的紧凑
+		ret.entries[idx] = child.entries[0]
+	} else {
+		ret.entries[idx].child = child
+	}
+	return ret, true
+}
+
+func (s *mapState[K, V]) removeAt(n *hnode[K, V], idx int, bit uint32) *hnode[K, V] {
+	if len(n.entries) == 1 {
+		return nil
+	}
+	ret := s.editable(n, 0)
+	copy(ret.entries[idx:], ret.entries[idx+1:])
+	ret.entries[len(ret.entries)-1] = hentry[K, V]{}
+	ret.entries = ret.entries[:len(ret.entries)-1]
+	ret.bitmap &^= bit
+	return ret
+}
+
+func (n *hnode[K, V]) walk(fn func(key K, value V) bool) bool {
+	for _, e := range n.entries {
+		if e.child != nil {
+			if !e.child.walk(fn) {
+				return false
+			}
+		} else if !fn(e.key, e.value) {
+			return false
+		}
+	}
+	return true
+}
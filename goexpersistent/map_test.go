@@ -0,0 +1,120 @@
+package goexpersistent
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	t.Run("TestMap_SetGetDelete", func(t *testing.T) {
+		m1 := Map[string, int]{}
+		m2 := m1.Set("a", 1).Set("b", 2)
+		m3 := m2.Delete("a")
+
+		if m1.Len() != 0 || m2.Len() != 2 || m3.Len() != 1 {
+			t.Errorf("Unexpected lengths %d, %d, %d", m1.Len(), m2.Len(), m3.Len())
+		}
+		if v, ok := m2.Get("a"); !ok || v != 1 {
+			t.Errorf("Expected m2 to contain a")
+		}
+		if m3.Has("a") {
+			t.Errorf("Expected m3 not to contain a")
+		}
+		if m4 := m3.Delete("missing"); m4.Len() != 1 {
+			t.Errorf("Expected deleting missing key to keep map")
+		}
+	})
+
+	t.Run("TestMap_Collisions", func(t *testing.T) {
+		m := NewMap[int, int](func(k int) uint64 { return uint64(k % 3) })
+		for i := 0; i < 30; i++ {
+			m = m.Set(i, i*i)
+		}
+		for i := 0; i < 30; i += 2 {
+			m = m.Delete(i)
+		}
+		expected := map[int]int{}
+		for i := 1; i < 30; i += 2 {
+			expected[i] = i * i
+		}
+		if got := m.ToMap(); !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected %v, but got %v", expected, got)
+		}
+	})
+
+	t.Run("TestMap_RandomOps", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		m := Map[int, int]{}
+		model := map[int]int{}
+		for i := 0; i < 20000; i++ {
+			k := r.Intn(2000)
+			if r.Intn(3) == 0 {
+				m = m.Delete(k)
+				delete(model, k)
+			} else {
+				m = m.Set(k, i)
+				model[k] = i
+			}
+		}
+		if got := m.ToMap(); !reflect.DeepEqual(got, model) || m.Len() != len(model) {
+			t.Errorf("Map diverged from model")
+		}
+	})
+
+	t.Run("TestMap_PointerAndSignedZeroKeys", func(t *testing.T) {
+		type node struct{ v int }
+		p := &node{v: 1}
+		pointers := Map[*node, int]{}.Set(p, 1)
+		p.v = 2
+		if v, ok := pointers.Get(p); !ok || v != 1 {
+			t.Errorf("Expected pointer key to be found after pointee changed, but got %v %v", v, ok)
+		}
+
+		type point struct{ F float64 }
+		points := Map[point, int]{}.Set(point{F: 0}, 1)
+		if v, ok := points.Get(point{F: math.Copysign(0, -1)}); !ok || v != 1 {
+			t.Errorf("Expected -0 key to find +0 entry, but got %v %v", v, ok)
+		}
+	})
+
+	t.Run("TestMap_Builder", func(t *testing.T) {
+		base := MapFromMap(map[string]int{"a": 1})
+		b := base.Builder()
+		b.Set("b", 2).Set("c", 3).Delete("a")
+		m := b.Map()
+
+		if got := m.ToMap(); !reflect.DeepEqual(got, map[string]int{"b": 2, "c": 3}) {
+			t.Errorf("Unexpected builder result %v", got)
+		}
+		if got := base.ToMap(); !reflect.DeepEqual(got, map[string]int{"a": 1}) {
+			t.Errorf("Expected base to be unchanged, but got %v", got)
+		}
+	})
+
+	t.Run("TestMap_BuilderUsedAfterMap", func(t *testing.T) {
+		b := Map[string, int]{}.Builder()
+		b.Map()
+		expectPanic(t, "goexpersistent: MapBuilder used after Map()", func() { b.Len() })
+		expectPanic(t, "goexpersistent: MapBuilder used after Map()", func() { b.Get("a") })
+	})
+}
+
+// expectPanic 断言 fn 以 message 为值发生 panic。
+func expectPanic(t *testing.T, message string, fn func()) {
+	t.Helper()
+	defer func() {
+		if r := recover(); r != message {
+			t.Errorf("Expected panic %q, but got %v", message, r)
+		}
+	}()
+	fn()
+}
+
+func BenchmarkMap_Set(b *testing.B) {
+	m := Map[int, int]{}
+	for i := 0; i < b.N; i++ {
+		m = m.Set(i, i)
+	}
+}
@@ -0,0 +1,497 @@
+package goexpersistent
+
+const (
+	nodeBits = 5
+	width    = 1 << nodeBits
+	mask     = width - 1
+)
+
+// owner 标记一个 Builder 独占的节点，Builder 可以原地修改带有自己 owner 的节点。
+//
+// 结构体不能为空，否则不同的 *owner 可能指向同一个地址。
+type owner struct{ _ byte }
+
+type vnode[E any] struct {
+	edit     *owner
+	children []*vnode[E]
+	values   []E
+}
+
+// Vector 是一个不可变的持久化向量，基于 32 叉位分区前缀树实现。
+//
+// 所有修改操作都返回新的 Vector，原 Vector 保持不变；新旧版本之间共享未修改的节点，
+// 因此 Append、Set、Pop 的开销为 O(log32 n)，可以放心地在并发代码中把 Vector 当作快照传递。
+// Vector 的零值是一个可以直接使用的空向量。
+//
+// 参数：
+//   - E: 元素类型。
+type Vector[E any] struct {
+	count int
+	shift uint
+	root  *vnode[E]
+	tail  []E
+}
+
+// VectorOf 创建一个包含给定元素的 Vector。
+//
+// 示例：
+//   - VectorOf(1, 2, 3).Len() 返回 3。
+func VectorOf[E any](values ...E) Vector[E] {
+	return VectorFromSlice(values)
+}
+
+// VectorFromSlice 创建一个包含 slice 中所有元素的 Vector，slice 会被复制。
+//
+// 参数：
+//   - slice: 初始元素。
+//
+// 返回值：
+//   - 新的 Vector，之后修改 slice 不会影响它。
+func VectorFromSlice[S ~[]E, E any](slice S) Vector[E] {
+	b := Vector[E]{}.Builder()
+	for _, v := range slice {
+		b.Append(v)
+	}
+	return b.Vector()
+}
+
+// MARK: - Read
+
+// Len 返回元素个数。
+func (v Vector[E]) Len() int {
+	return v.count
+}
+
+// IsEmpty 判断向量是否为空。
+func (v Vector[E]) IsEmpty() bool {
+	return v.count == 0
+}
+
+// Get 安全地获取指定索引位置的元素。
+//
+// 返回值：
+//   - 如果索引有效，返回该索引位置的元素和 true；否则返回零值和 false。
+func (v Vector[E]) Get(index int) (value E, ok bool) {
+	if index < 0 || index >= v.count {
+		return value, false
+	}
+	s := v.state(nil)
+	return s.leafFor(index)[index&mask], true
+}
+
+// First 返回第一个元素，向量为空时返回 false。
+func (v Vector[E]) First() (E, bool) {
+	return v.Get(0)
+}
+
+// Last 返回最后一个元素，向量为空时返回 false。
+func (v Vector[E]) Last() (E, bool) {
+	return v.Get(v.count - 1)
+}
+
+// Range 按顺序遍历元素，回调返回 false 时停止遍历。
+func (v Vector[E]) Range(fn func(index int, value E) bool) {
+	s := v.state(nil)
+	for base := 0; base < v.count; base += width {
+		leaf := s.leafFor(base)
+		for i, value := range leaf {
+			if !fn(base+i, value) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice 返回包含所有元素的新切片。
+func (v Vector[E]) ToSlice() []E {
+	result := make([]E, 0, v.count)
+	v.Range(func(_ int, value E) bool {
+		result = append(result, value)
+		return true
+	})
+	return result
+}
+
+// MARK: - Update
+
+// Append 返回在末尾追加元素后的新 Vector。
+//
+// 示例：
+//   - VectorOf(1, 2).Append(3) 返回包含 1, 2, 3 的新向量，原向量仍为 1, 2。
+func (v Vector[E]) Append(values ...E) Vector[E] {
+	if len(values) == 0 {
+		return v
+	}
+	if len(values) == 1 {
+		s := v.state(nil)
+		s.append(values[0])
+		return s.vector()
+	}
+	b := v.Builder()
+	for _, value := range values {
+		b.Append(value)
+	}
+	return b.Vector()
+}
+
+// Set 返回将 index 位置的元素替换为 value 后的新 Vector。
+//
+// 参数：
+//   - index: 要设置的索引。
+//   - value: 新的元素值。
+//
+// 返回值：
+//   - 新的 Vector。如果索引超出范围，将返回原 Vector。
+func (v Vector[E]) Set(index int, value E) Vector[E] {
+	if index < 0 || index >= v.count {
+		return v
+	}
+	s := v.state(nil)
+	s.set(index, value)
+	return s.vector()
+}
+
+// Pop 返回删除最后一个元素后的新 Vector，向量为空时返回原 Vector。
+func (v Vector[E]) Pop() Vector[E] {
+	if v.count == 0 {
+		return v
+	}
+	s := v.state(nil)
+	s.pop()
+	return s.vector()
+}
+
+// Delete 返回删除 index 位置元素后的新 Vector。
+//
+// 删除中间元素需要移动其后的所有元素，开销为 O(n)；删除最后一个元素请使用 Pop。
+//
+// 返回值：
+//   - 新的 Vector。如果索引超出范围，将返回原 Vector。
+func (v Vector[E]) Delete(index int) Vector[E] {
+	if index < 0 || index >= v.count {
+		return v
+	}
+	if index == v.count-1 {
+		return v.Pop()
+	}
+	b := v.Slice(0, index).Builder()
+	v.Range(func(i int, value E) bool {
+		if i > index {
+			b.Append(value)
+		}
+		return true
+	})
+	return b.Vector()
+}
+
+// Slice 返回包含 [from, to) 区间元素的新 Vector。
+//
+// 从头部截取时与原向量共享节点；其他情况需要复制区间内的元素。
+//
+// 返回值：
+//   - 新的 Vector。区间会被限制在 [0, Len()] 内。
+func (v Vector[E]) Slice(from, to int) Vector[E] {
+	from = max(from, 0)
+	to = min(to, v.count)
+	if from >= to {
+		return Vector[E]{}
+	}
+	if from == 0 {
+		b := v.Builder()
+		for b.Len() > to {
+			b.Pop()
+		}
+		return b.Vector()
+	}
+	b := Vector[E]{}.Builder()
+	v.Range(func(i int, value E) bool {
+		if i >= to {
+			return false
+		}
+		if i >= from {
+			b.Append(value)
+		}
+		return true
+	})
+	return b.Vector()
+}
+
+// Builder 返回一个以当前 Vector 为初始内容的可变构建器，用于高效地批量修改。
+//
+// 构建器只会复制它第一次修改的节点，之后在这些节点上原地修改；当前 Vector 不受影响。
+func (v Vector[E]) Builder() *VectorBuilder[E] {
+	s := v.state(&owner{})
+	tail := make([]E, len(s.tail), width)
+	copy(tail, s.tail)
+	s.tail = tail
+	return &VectorBuilder[E]{s: s}
+}
+
+func (v Vector[E]) state(edit *owner) *vecState[E] {
+	s := &vecState[E]{count: v.count, shift: v.shift, root: v.root, tail: v.tail, edit: edit}
+	if s.root == nil {
+		s.root = &vnode[E]{children: make([]*vnode[E], width)}
+		s.shift = nodeBits
+	}
+	return s
+}
+
+// MARK: - Builder
+
+// VectorBuilder 是 Vector 的可变构建器（transient），用于批量 Append/Set/Pop。
+//
+// VectorBuilder 不是并发安全的；调用 Vector 之后构建器失效，不能再使用。
+type VectorBuilder[E any] struct {
+	s *vecState[E]
+}
+
+// Len 返回构建器中的元素个数。
+func (b *VectorBuilder[E]) Len() int {
+	b.check()
+	return b.s.count
+}
+
+// Get 安全地获取指定索引位置的元素。
+func (b *VectorBuilder[E]) Get(index int) (value E, ok bool) {
+	b.check()
+	if index < 0 || index >= b.s.count {
+		return value, false
+	}
+	return b.s.leafFor(index)[index&mask], true
+}
+
+// Append 在末尾追加元素。
+func (b *VectorBuilder[E]) Append(values ...E) *VectorBuilder[E] {
+	b.check()
+	for _, value := range values {
+		b.s.append(value)
+	}
+	return b
+}
+
+// Set 将 index 位置的元素替换为 value，索引超出范围时不做任何修改。
+func (b *VectorBuilder[E]) Set(index int, value E) *VectorBuilder[E] {
+	b.check()
+	if index >= 0 && index < b.s.count {
+		b.s.set(index, value)
+	}
+	return b
+}
+
+// Pop 删除最后一个元素。
+func (b *VectorBuilder[E]) Pop() *VectorBuilder[E] {
+	b.check()
+	if b.s.count > 0 {
+		b.s.pop()
+	}
+	return b
+}
+
+// Vector 返回构建结果，之后构建器失效。
+func (b *VectorBuilder[E]) Vector() Vector[E] {
+	b.check()
+	s := b.s
+	s.edit = nil
+	b.s = nil
+	return s.vector()
+}
+
+func (b *VectorBuilder[E]) check() {
+	if b.s == nil {
+		panic("goexpersistent: VectorBuilder used after Vector()")
+	}
+}
+
+// MARK: - Trie
+
+// vecState 实现向量的前缀树操作。edit 为 nil 时所有修改都复制路径上的节点（持久化语义），
+// 否则带有相同 edit 的节点与 tail 会被原地修改（构建器语义）。
+type vecState[E any] struct {
+	count int
+	shift uint
+	root  *vnode[E]
+	tail  []E
+	edit  *owner
+}
+
+func (s *vecState[E]) vector() Vector[E] {
+	if s.count == 0 {
+		return Vector[E]{}
+	}
+	return Vector[E]{count: s.count, shift: s.shift, root: s.root, tail: s.tail}
+}
+
+func (s *vecState[E]) tailoff() int {
+	if s.count < width {
+		return 0
+	}
+	return ((s.count - 1) >> nodeBits) << nodeBits
+}
+
+func (s *vecState[E]) leafFor(index int) []E {
+	if index >= s.tailoff() {
+		return s.tail
+	}
+	n := s.root
+	for level := s.shift; level > 0; level -= nodeBits {
+		n = n.children[(index>>level)&mask]
+	}
+	return n.values
+}
+
+func (s *vecState[E]) editable(n *vnode[E]) *vnode[E] {
+	if s.edit != nil && n.edit == s.edit {
+		return n
+	}
+	c := &vnode[E]{edit: s.edit}
+	if n.children != nil {
+		c.children = make([]*vnode[E], width)
+		copy(c.children, n.children)
+	}
+	if n.values != nil {
+		c.values = make([]E, len(n.values))
+		copy(c.values, n.values)
+	}
+	return c
+}
+
+func (s *vecState[E]) append(value E) {
+	if s.count-s.tailoff() < width {
+		if s.edit != nil {
+			s.tail = append(s.tail, value)
+		} else {
+			tail := make([]E, len(s.tail)+1)
+			copy(tail, s.tail)
+			tail[len(s.tail)] = value
+			s.tail = tail
+		}
+		s.count++
+		return
+	}
+
+	tailNode := &vnode[E]{edit: s.edit, values: s.tail}
+	if (s.count >> nodeBits) > (1 << s.shift) {
+		root := &vnode[E]{edit: s.edit, children: make([]*vnode[E], width)}
+		root.children[0] = s.root
+		root.children[1] = s.newPath(s.shift, tailNode)
+		s.root = root
+		s.shift += nodeBits
+	} else {
+		s.root = s.pushTail(s.shift, s.root, tailNode)
+	}
+
+	if s.edit != nil {
+		s.tail = make([]E, 1, width)
+	} else {
+		s.tail = make([]E, 1)
+	}
+	s.tail[0] = value
+	s.count++
+}
+
+func (s *vecState[E]) pushTail(level uint, parent, tailNode *vnode[E]) *vnode[E] {
+	ret := s.editable(parent)
+	sub := ((s.count - 1) >> level) & mask
+	switch {
+	case level == nodeBits:
+		ret.children[sub] = tailNode
+	case parent.children[sub] != nil:
+		ret.children[sub] = s.pushTail(level-nodeBits, parent.children[sub], tailNode)
+	default:
+		ret.children[sub] = s.newPath(level-nodeBits, tailNode)
+	}
+	return ret
+}
+
+func (s *vecState[E]) newPath(level uint, n *vnode[E]) *vnode[E] {
+	if level == 0 {
+		return n
+	}
+	ret := &vnode[E]{edit: s.edit, children: make([]*vnode[E], width)}
+	ret.children[0] = s.newPath(level-nodeBits, n)
+	return ret
+}
+
+func (s *vecState[E]) set(index int, value E) {
+	if index >= s.tailoff() {
+		if s.edit == nil {
+			tail := make([]E, len(s.tail))
+			copy(tail, s.tail)
+			s.tail = tail
+		}
+		s.tail[index&mask] = value
+		return
+	}
+	s.root = s.doSet(s.shift, s.root, index, value)
+}
+
+func (s *vecState[E]) doSet(level uint, n *vnode[E], index int, value E) *vnode[E] {
+	ret := s.editable(n)
+	if level == 0 {
+		ret.values[index&mask] = value
+		return ret
+	}
+	sub := (index >> level) & mask
+	ret.children[sub] = s.doSet(level-nodeBits, n.children[sub], index, value)
+	return ret
+}
+
+func (s *vecState[E]) pop() {
+	if s.count == 1 {
+		*s = vecState[E]{shift: nodeBits, root: &vnode[E]{edit: s.edit, children: make([]*vnode[E], width)}, edit: s.edit}
+		if s.edit != nil {
+			s.tail = make([]E, 0, width)
+		}
+		return
+	}
+
+	if s.count-s.tailoff() > 1 {
+		if s.edit != nil {
+			var zero E
+			s.tail[len(s.tail)-1] = zero
+			s.tail = s.tail[:len(s.tail)-1]
+		} else {
+			s.tail = s.tail[: len(s.tail)-1 : len(s.tail)-1]
+		}
+		s.count--
+		return
+	}
+
+	leaf := s.leafFor(s.count - 2)
+	root := s.popTail(s.shift, s.root)
+	if root == nil {
+		root = &vnode[E]{edit: s.edit, children: make([]*vnode[E], width)}
+	}
+	if s.shift > nodeBits && root.children[1] == nil {
+		root = root.children[0]
+		s.shift -= nodeBits
+	}
+	s.root = root
+	s.count--
+
+	if s.edit != nil {
+		s.tail = make([]E, len(leaf), width)
+		copy(s.tail, leaf)
+	} else {
+		s.tail = leaf
+	}
+}
+
+func (s *vecState[E]) popTail(level uint, n *vnode[E]) *vnode[E] {
+	sub := ((s.count - 2) >> level) & mask
+	if level > nodeBits {
+		child := s.popTail(level-nodeBits, n.children[sub])
+		if child == nil && sub == 0 {
+			return nil
+		}
+		ret := s.editable(n)
+		ret.children[sub] = child
+		return ret
+	}
+	if sub == 0 {
+		return nil
+	}
+	ret := s.editable(n)
+	ret.children[sub] = nil
+	return ret
+}
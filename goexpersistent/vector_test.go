@@ -0,0 +1,131 @@
+package goexpersistent
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestVector(t *testing.T) {
+	t.Run("TestVector_AppendGet", func(t *testing.T) {
+		var v Vector[int]
+		var expected []int
+		for i := 0; i < 5000; i++ {
+			v = v.Append(i)
+			expected = append(expected, i)
+		}
+		if !reflect.DeepEqual(v.ToSlice(), expected) {
+			t.Fatalf("Expected vector to contain 0..4999")
+		}
+		if got, ok := v.Get(1234); !ok || got != 1234 {
+			t.Errorf("Expected Get(1234) = 1234, but got %v and %v", got, ok)
+		}
+		if _, ok := v.Get(5000); ok {
+			t.Errorf("Expected Get(5000) to fail")
+		}
+	})
+
+	t.Run("TestVector_Persistence", func(t *testing.T) {
+		v1 := VectorOf(1, 2, 3)
+		v2 := v1.Append(4)
+		v3 := v2.Set(0, 100)
+		v4 := v3.Pop()
+
+		testCases := []struct {
+			v    Vector[int]
+			want []int
+		}{
+			{v1, []int{1, 2, 3}},
+			{v2, []int{1, 2, 3, 4}},
+			{v3, []int{100, 2, 3, 4}},
+			{v4, []int{100, 2, 3}},
+		}
+		for _, tc := range testCases {
+			if got := tc.v.ToSlice(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Expected %v, but got %v", tc.want, got)
+			}
+		}
+	})
+
+	t.Run("TestVector_RandomOps", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		var v Vector[int]
+		var model []int
+		versions := []Vector[int]{}
+		models := [][]int{}
+		for i := 0; i < 20000; i++ {
+			switch op := r.Intn(10); {
+			case op < 6:
+				v = v.Append(i)
+				model = append(model, i)
+			case op < 8 && len(model) > 0:
+				idx := r.Intn(len(model))
+				v = v.Set(idx, -i)
+				model = append([]int(nil), model...)
+				model[idx] = -i
+			case len(model) > 0:
+				v = v.Pop()
+				model = model[: len(model)-1 : len(model)-1]
+			}
+			if i%1000 == 0 {
+				versions = append(versions, v)
+				models = append(models, append([]int(nil), model...))
+			}
+		}
+		for i := range versions {
+			if got := versions[i].ToSlice(); !reflect.DeepEqual(got, models[i]) && !(len(got) == 0 && len(models[i]) == 0) {
+				t.Fatalf("Version %d diverged from model", i)
+			}
+		}
+	})
+
+	t.Run("TestVector_DeleteSlice", func(t *testing.T) {
+		v := VectorFromSlice([]int{0, 1, 2, 3, 4, 5})
+		if got := v.Delete(2).ToSlice(); !reflect.DeepEqual(got, []int{0, 1, 3, 4, 5}) {
+			t.Errorf("Unexpected Delete result %v", got)
+		}
+		if got := v.Slice(1, 4).ToSlice(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+			t.Errorf("Unexpected Slice result %v", got)
+		}
+		if got := v.Slice(0, 2).ToSlice(); !reflect.DeepEqual(got, []int{0, 1}) {
+			t.Errorf("Unexpected Slice result %v", got)
+		}
+		if got := v.Set(10, 1); got.Len() != v.Len() {
+			t.Errorf("Expected out of range Set to return original vector")
+		}
+	})
+
+	t.Run("TestVector_Builder", func(t *testing.T) {
+		base := VectorFromSlice(make([]int, 100))
+		b := base.Builder()
+		for i := 0; i < 1000; i++ {
+			b.Append(i)
+		}
+		b.Set(0, 7).Pop()
+		v := b.Vector()
+
+		if v.Len() != 1099 || base.Len() != 100 {
+			t.Errorf("Expected lengths 1099 and 100, but got %d and %d", v.Len(), base.Len())
+		}
+		if first, _ := v.First(); first != 7 {
+			t.Errorf("Expected first element 7, but got %d", first)
+		}
+		if first, _ := base.First(); first != 0 {
+			t.Errorf("Expected base to be unchanged, but got %d", first)
+		}
+	})
+
+	t.Run("TestVector_BuilderUsedAfterVector", func(t *testing.T) {
+		b := Vector[int]{}.Builder()
+		b.Vector()
+		expectPanic(t, "goexpersistent: VectorBuilder used after Vector()", func() { b.Len() })
+		expectPanic(t, "goexpersistent: VectorBuilder used after Vector()", func() { b.Get(0) })
+	})
+}
+
+func BenchmarkVector_Append(b *testing.B) {
+	var v Vector[int]
+	for i := 0; i < b.N; i++ {
+		v = v.Append(i)
+	}
+}
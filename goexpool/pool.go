@@ -0,0 +1,587 @@
+package goexpool
+
+import (
+	"context"
+	"errors"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+	"github.com/birdmichael/GoEx/goexsync"
+)
+
+// QueuePolicy 决定任务队列已满时如何处理新提交的任务。
+type QueuePolicy int
+
+const (
+	// Block 阻塞提交方，直到队列有空位、提交的上下文被取消或池被关闭。
+	Block QueuePolicy = iota
+	// Drop 丢弃新任务，Submit 返回 nil，丢弃通过 Hooks.OnDrop 与 Stats.Dropped 反映。
+	Drop
+	// Reject 拒绝新任务，Submit 返回 ErrQueueFull。
+	Reject
+)
+
+// String 返回策略的名称。
+func (p QueuePolicy) String() string {
+	switch p {
+	case Block:
+		return "block"
+	case Drop:
+		return "drop"
+	case Reject:
+		return "reject"
+	default:
+		return "unknown"
+	}
+}
+
+var (
+	// ErrPoolClosed 表示池已经开始 Drain 或 Shutdown，不再接受新任务。
+	ErrPoolClosed = errors.New("goexpool: pool is closed")
+	// ErrQueueFull 表示队列已满，任务在 Reject 策略下被拒绝。
+	ErrQueueFull = errors.New("goexpool: queue is full")
+	// ErrDropped 表示 SubmitWait 提交的任务被丢弃，或在 Shutdown 时尚未执行就被移出队列。
+	ErrDropped = errors.New("goexpool: task dropped")
+)
+
+// DefaultIdleTimeout 是 Options.IdleTimeout 的默认值。
+const DefaultIdleTimeout = time.Minute
+
+// Task 是提交给池执行的任务，ctx 在池 Shutdown 时被取消。
+type Task func(ctx context.Context) error
+
+// Hooks 是池的指标回调，所有字段均可省略。回调在提交方或工作协程中同步调用，应当尽快返回。
+type Hooks struct {
+	// OnSubmit 在任务进入队列后被调用。
+	OnSubmit func()
+	// OnDrop 在任务被丢弃（包括 Shutdown 时被移出队列）后被调用。
+	OnDrop func()
+	// OnReject 在任务因队列已满被拒绝后被调用。
+	OnReject func()
+	// OnStart 在任务开始执行前被调用，wait 为任务在队列中等待的时间。
+	OnStart func(wait time.Duration)
+	// OnFinish 在任务执行结束后被调用，err 为任务返回的错误，panic 会被转换为 *goexsync.PanicError。
+	OnFinish func(elapsed time.Duration, err error)
+	// OnWorkers 在工作协程数量改变后被调用。
+	OnWorkers func(workers int)
+}
+
+// Options 是创建 Pool 时的配置项，所有字段均可省略。
+type Options struct {
+	// Workers 是常驻的工作协程数量，小于等于 0 时为 1。
+	Workers int
+	// MaxWorkers 是工作协程数量的上限，小于 Workers 时等于 Workers，即固定大小的池。
+	// 所有工作协程都忙碌时提交任务会按需创建新的工作协程，直到达到上限。
+	MaxWorkers int
+	// IdleTimeout 是超出 Workers 的工作协程空闲多久后退出，默认为 DefaultIdleTimeout。
+	IdleTimeout time.Duration
+	// QueueSize 是等待执行的任务队列容量，为 0 时任务只能直接交给空闲的工作协程。
+	QueueSize int
+	// Policy 是队列已满时的处理策略，默认为 Block。
+	Policy QueuePolicy
+	// Hooks 是指标回调。
+	Hooks Hooks
+	// Clock 用于计时，为 nil 时使用真实时钟。
+	Clock goexclock.Clock
+}
+
+// Stats 是池的统计数据快照。
+type Stats struct {
+	Workers   int    // 当前工作协程数量
+	Busy      int    // 正在执行任务的工作协程数量
+	Queued    int    // 队列中等待执行的任务数
+	Submitted uint64 // 进入队列的任务数
+	Completed uint64 // 执行成功的任务数
+	Failed    uint64 // 返回错误或发生 panic 的任务数
+	Dropped   uint64 // 被丢弃的任务数
+	Rejected  uint64 // 被拒绝的任务数
+}
+
+// Pool 是一个工作协程池，支持有界队列、背压策略、自动伸缩与优雅关闭。
+type Pool struct {
+	opts  Options
+	clock goexclock.Clock
+
+	queue  chan job
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// mu 保护 closed 与 queue 的关闭；提交方发送任务时持有读锁
+	mu        sync.RWMutex
+	closed    bool
+	quit      chan struct{}
+	closeOnce sync.Once
+
+	wmu     sync.Mutex
+	workers int
+	// idle 是正在等待任务的工作协程，提交方从中取出一个并把任务直接交给它
+	idle []*worker
+	wg   sync.WaitGroup
+
+	busy                                            atomic.Int64
+	submitted, completed, failed, dropped, rejected atomic.Uint64
+}
+
+type job struct {
+	task     Task
+	enqueued time.Time
+	// discard 在任务未执行就被丢弃时调用，可以为 nil
+	discard func()
+	// finish 在任务执行完毕、工作协程已经取到下一个任务或重新进入空闲列表后调用，可以为 nil
+	finish func()
+}
+
+// worker 是一个工作协程的交付通道，容量为 1；工作协程只有在 jobs 为空时才会进入空闲列表，
+// 而提交方在持有 wmu 时从空闲列表取出它并发送任务，因此发送永远不会阻塞。
+type worker struct {
+	jobs chan job
+}
+
+// New 创建一个 Pool，并立即启动 Workers 个工作协程。
+//
+// 参数：
+//   - opts: 配置项。
+//
+// 返回值：
+//   - 新的 Pool。
+//
+// 示例：
+//
+//	pool := New(Options{Workers: 4, MaxWorkers: 16, QueueSize: 100})
+//	defer pool.Drain(context.Background())
+//	pool.Submit(ctx, func(ctx context.Context) error { return process(ctx, item) })
+func New(opts Options) *Pool {
+	if opts.Workers <= 0 {
+		opts.Workers = 1
+	}
+	if opts.MaxWorkers < opts.Workers {
+		opts.MaxWorkers = opts.Workers
+	}
+	if opts.IdleTimeout <= 0 {
+		opts.IdleTimeout = DefaultIdleTimeout
+	}
+	if opts.QueueSize < 0 {
+		opts.QueueSize = 0
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &Pool{
+		opts:   opts,
+		clock:  goexclock.OrReal(opts.Clock),
+		queue:  make(chan job, opts.QueueSize),
+		ctx:    ctx,
+		cancel: cancel,
+		quit:   make(chan struct{}),
+	}
+	p.wmu.Lock()
+	for i := 0; i < opts.Workers; i++ {
+		p.spawnLocked(nil)
+	}
+	n := p.workers
+	p.wmu.Unlock()
+
+	p.notifyWorkers(n)
+	return p
+}
+
+// MARK: - Submit
+
+// Submit 提交一个任务。
+//
+// 参数：
+//   - ctx: 仅用于 Block 策略下等待队列空位，不会传给任务。
+//   - task: 要执行的任务。
+//
+// 返回值：
+//   - 池已关闭时为 ErrPoolClosed；Reject 策略下队列已满时为 ErrQueueFull；
+//     Block 策略下 ctx 被取消时为 ctx.Err()；其余情况为 nil。
+func (p *Pool) Submit(ctx context.Context, task Task) error {
+	return p.submit(ctx, job{task: task})
+}
+
+// SubmitWait 提交一个返回结果的任务，并等待其执行结束。
+//
+// 参数：
+//   - ctx: 用于等待队列空位与等待结果，取消后立即返回 ctx.Err()，已提交的任务仍会执行。
+//   - p: 要提交到的池。
+//   - fn: 要执行的任务。
+//
+// 返回值：
+//   - fn 返回的结果与错误；fn 发生 panic 时错误为 *goexsync.PanicError；
+//     任务被丢弃时为 ErrDropped；其余提交失败时为 Submit 返回的错误。
+func SubmitWait[T any](ctx context.Context, p *Pool, fn func(ctx context.Context) (T, error)) (T, error) {
+	type result struct {
+		value T
+		err   error
+	}
+	done := make(chan result, 1)
+	var (
+		zero T
+		res  result
+	)
+
+	// 结果在工作协程重新空闲后才发送，SubmitWait 返回后立即提交的任务不会因为工作协程尚未空闲而被拒绝
+	err := p.submit(ctx, job{
+		task: func(ctx context.Context) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					err = &goexsync.PanicError{Value: r, Stack: debug.Stack()}
+				}
+				res.err = err
+			}()
+			res.value, err = fn(ctx)
+			return err
+		},
+		discard: func() { done <- result{err: ErrDropped} },
+		finish:  func() { done <- res },
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+func (p *Pool) submit(ctx context.Context, j job) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.closed {
+		return ErrPoolClosed
+	}
+
+	j.enqueued = p.clock.Now()
+	if p.dispatch(j) {
+		p.onSubmit()
+		return nil
+	}
+
+	switch p.opts.Policy {
+	case Drop:
+		p.drop(j)
+		return nil
+	case Reject:
+		p.rejected.Add(1)
+		if p.opts.Hooks.OnReject != nil {
+			p.opts.Hooks.OnReject()
+		}
+		return ErrQueueFull
+	}
+
+	select {
+	case p.queue <- j:
+		p.onSubmit()
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-p.quit:
+		return ErrPoolClosed
+	}
+}
+
+func (p *Pool) onSubmit() {
+	p.submitted.Add(1)
+	if p.opts.Hooks.OnSubmit != nil {
+		p.opts.Hooks.OnSubmit()
+	}
+}
+
+func (p *Pool) drop(j job) {
+	p.dropped.Add(1)
+	if j.discard != nil {
+		j.discard()
+	}
+	if p.opts.Hooks.OnDrop != nil {
+		p.opts.Hooks.OnDrop()
+	}
+}
+
+// MARK: - Shutdown
+
+// Drain 停止接受新任务，并等待队列中的任务全部执行完毕。
+//
+// 返回值：
+//   - ctx 在等待期间被取消时为 ctx.Err()，此时任务仍在后台继续执行。
+func (p *Pool) Drain(ctx context.Context) error {
+	p.close()
+	return p.wait(ctx)
+}
+
+// Shutdown 停止接受新任务，丢弃队列中尚未开始的任务，取消正在执行的任务的上下文，并等待其返回。
+//
+// 返回值：
+//   - ctx 在等待期间被取消时为 ctx.Err()。
+func (p *Pool) Shutdown(ctx context.Context) error {
+	p.cancel()
+	p.close()
+	return p.wait(ctx)
+}
+
+// Stats 返回当前统计数据的快照。
+func (p *Pool) Stats() Stats {
+	p.wmu.Lock()
+	workers := p.workers
+	p.wmu.Unlock()
+
+	return Stats{
+		Workers:   workers,
+		Busy:      int(p.busy.Load()),
+		Queued:    len(p.queue),
+		Submitted: p.submitted.Load(),
+		Completed: p.completed.Load(),
+		Failed:    p.failed.Load(),
+		Dropped:   p.dropped.Load(),
+		Rejected:  p.rejected.Load(),
+	}
+}
+
+func (p *Pool) close() {
+	p.closeOnce.Do(func() {
+		// 先唤醒阻塞的提交方，它们释放读锁后才能关闭队列
+		close(p.quit)
+		p.mu.Lock()
+		p.closed = true
+		close(p.queue)
+		p.mu.Unlock()
+	})
+}
+
+func (p *Pool) wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		p.cancel()
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// MARK: - Worker
+
+// dispatch 在不阻塞的情况下交付任务：优先交给空闲的工作协程，其次按需创建新的工作协程，最后放入队列。
+//
+// 返回值：
+//   - 没有空闲的工作协程、已达到上限且队列已满时返回 false。
+func (p *Pool) dispatch(j job) bool {
+	p.wmu.Lock()
+	if n := len(p.idle); n > 0 {
+		w := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		w.jobs <- j
+		p.wmu.Unlock()
+		return true
+	}
+	if p.workers < p.opts.MaxWorkers {
+		p.spawnLocked(&j)
+		n := p.workers
+		p.wmu.Unlock()
+
+		p.notifyWorkers(n)
+		return true
+	}
+
+	// 与工作协程在 next 中检查队列同样持有 wmu，队列中的任务不会在有空闲工作协程时被搁置
+	ok := false
+	select {
+	case p.queue <- j:
+		ok = true
+	default:
+	}
+	p.wmu.Unlock()
+	return ok
+}
+
+// spawnLocked 启动一个工作协程，first 为 nil 时工作协程立即进入空闲列表。调用方必须持有 wmu。
+func (p *Pool) spawnLocked(first *job) {
+	w := &worker{jobs: make(chan job, 1)}
+	p.workers++
+	if first == nil {
+		p.idle = append(p.idle, w)
+	}
+	p.wg.Add(1)
+	go p.work(w, first)
+}
+
+func (p *Pool) work(w *worker, first *job) {
+	defer p.wg.Done()
+
+	var (
+		j  job
+		ok bool
+	)
+	if first != nil {
+		j, ok = *first, true
+	} else {
+		j, ok = p.waitIdle(w)
+	}
+	for ok {
+		var finish func()
+		if p.run(j) {
+			finish = j.finish
+		}
+		j, ok = p.next(w, finish)
+	}
+}
+
+// next 在执行完一个任务后取出下一个任务，没有可执行的任务时进入空闲列表等待。
+//
+// 参数：
+//   - finish: 上一个任务的 finish 回调，在取到下一个任务或进入空闲列表后调用，可以为 nil。
+//
+// 返回值：
+//   - ok 为 false 时工作协程应当退出，此时已更新计数。
+func (p *Pool) next(w *worker, finish func()) (j job, ok bool) {
+	p.wmu.Lock()
+	idle := false
+	select {
+	case j, ok = <-w.jobs:
+		// 等待期间先从队列取到了任务，提交方交付的任务留在了 w.jobs 中
+		p.wmu.Unlock()
+	default:
+		select {
+		case j, ok = <-p.queue:
+			if ok {
+				p.wmu.Unlock()
+			} else {
+				p.exitLocked()
+			}
+		default:
+			p.idle = append(p.idle, w)
+			p.wmu.Unlock()
+			idle = true
+		}
+	}
+
+	if finish != nil {
+		finish()
+	}
+	if idle {
+		return p.waitIdle(w)
+	}
+	return j, ok
+}
+
+// waitIdle 在空闲列表中等待提交方交付的任务或队列中的任务，超出 Workers 的工作协程空闲超时后退出。
+func (p *Pool) waitIdle(w *worker) (j job, ok bool) {
+	if p.opts.MaxWorkers == p.opts.Workers {
+		select {
+		case j = <-w.jobs:
+			return j, true
+		case j, ok = <-p.queue:
+			return p.leaveIdle(w, j, ok)
+		}
+	}
+
+	timer := p.clock.NewTimer(p.opts.IdleTimeout)
+	defer timer.Stop()
+	for {
+		select {
+		case j = <-w.jobs:
+			return j, true
+		case j, ok = <-p.queue:
+			return p.leaveIdle(w, j, ok)
+		case <-timer.C():
+		}
+
+		// 已被提交方取出的工作协程不在空闲列表中，不能退出，交付的任务已在 w.jobs 中
+		p.wmu.Lock()
+		if p.workers > p.opts.Workers && p.removeIdleLocked(w) {
+			return j, p.exitLocked()
+		}
+		p.wmu.Unlock()
+		timer.Reset(p.opts.IdleTimeout)
+	}
+}
+
+// leaveIdle 在空闲的工作协程从队列取到任务或发现队列已关闭后，把它移出空闲列表。
+func (p *Pool) leaveIdle(w *worker, j job, ok bool) (job, bool) {
+	p.wmu.Lock()
+	removed := p.removeIdleLocked(w)
+	if ok {
+		p.wmu.Unlock()
+		return j, true
+	}
+	if !removed {
+		// 已被提交方取出，先执行交付的任务，下一次调用 next 时再发现队列已关闭
+		p.wmu.Unlock()
+		return <-w.jobs, true
+	}
+	return j, p.exitLocked()
+}
+
+// removeIdleLocked 把 w 移出空闲列表，w 已被提交方取出时返回 false。调用方必须持有 wmu。
+func (p *Pool) removeIdleLocked(w *worker) bool {
+	for i, idle := range p.idle {
+		if idle == w {
+			p.idle = append(p.idle[:i], p.idle[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// exitLocked 减少工作协程计数并释放 wmu，总是返回 false。调用方必须持有 wmu。
+func (p *Pool) exitLocked() bool {
+	p.workers--
+	n := p.workers
+	p.wmu.Unlock()
+
+	p.notifyWorkers(n)
+	return false
+}
+
+// run 执行任务，池已经 Shutdown 时丢弃任务并返回 false。
+func (p *Pool) run(j job) bool {
+	if p.ctx.Err() != nil {
+		p.drop(j)
+		return false
+	}
+
+	start := p.clock.Now()
+	if p.opts.Hooks.OnStart != nil {
+		p.opts.Hooks.OnStart(start.Sub(j.enqueued))
+	}
+
+	p.busy.Add(1)
+	err := p.safeRun(j.task)
+	p.busy.Add(-1)
+
+	if err != nil {
+		p.failed.Add(1)
+	} else {
+		p.completed.Add(1)
+	}
+	if p.opts.Hooks.OnFinish != nil {
+		p.opts.Hooks.OnFinish(p.clock.Since(start), err)
+	}
+	return true
+}
+
+func (p *Pool) safeRun(task Task) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &goexsync.PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+	return task(p.ctx)
+}
+
+func (p *Pool) notifyWorkers(n int) {
+	if p.opts.Hooks.OnWorkers != nil {
+		p.opts.Hooks.OnWorkers(n)
+	}
+}
@@ -0,0 +1,401 @@
+package goexpool
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+	"github.com/birdmichael/GoEx/goexsync"
+)
+
+// blocker 返回一个阻塞直到 release 被关闭的任务，任务开始时向 started 发送信号。
+func blocker(started chan<- struct{}, release <-chan struct{}) Task {
+	return func(ctx context.Context) error {
+		started <- struct{}{}
+		select {
+		case <-release:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func TestPool_Submit(t *testing.T) {
+	p := New(Options{Workers: 4, QueueSize: 10})
+
+	var mu sync.Mutex
+	var got []int
+	for i := 0; i < 100; i++ {
+		i := i
+		if err := p.Submit(context.Background(), func(ctx context.Context) error {
+			mu.Lock()
+			got = append(got, i)
+			mu.Unlock()
+			return nil
+		}); err != nil {
+			t.Fatalf("Expected nil, but got %v", err)
+		}
+	}
+	if err := p.Drain(context.Background()); err != nil {
+		t.Fatalf("Expected nil, but got %v", err)
+	}
+
+	sort.Ints(got)
+	for i, v := range got {
+		if v != i {
+			t.Fatalf("Expected all tasks to run once, but got %v", got)
+		}
+	}
+	if stats := p.Stats(); stats.Submitted != 100 || stats.Completed != 100 || stats.Workers != 0 {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+	if err := p.Submit(context.Background(), func(ctx context.Context) error { return nil }); !errors.Is(err, ErrPoolClosed) {
+		t.Errorf("Expected %v, but got %v", ErrPoolClosed, err)
+	}
+}
+
+func TestPool_QueuePolicy(t *testing.T) {
+	noop := func(ctx context.Context) error { return nil }
+
+	setup := func(policy QueuePolicy, hooks Hooks) (*Pool, chan struct{}) {
+		started := make(chan struct{}, 1)
+		release := make(chan struct{})
+		p := New(Options{Workers: 1, QueueSize: 1, Policy: policy, Hooks: hooks})
+		p.Submit(context.Background(), blocker(started, release))
+		<-started
+		p.Submit(context.Background(), noop)
+		return p, release
+	}
+
+	t.Run("TestPool_QueuePolicy_Reject", func(t *testing.T) {
+		var rejected atomic.Int32
+		p, release := setup(Reject, Hooks{OnReject: func() { rejected.Add(1) }})
+		if err := p.Submit(context.Background(), noop); !errors.Is(err, ErrQueueFull) {
+			t.Errorf("Expected %v, but got %v", ErrQueueFull, err)
+		}
+		close(release)
+		p.Drain(context.Background())
+		if stats := p.Stats(); stats.Rejected != 1 || stats.Completed != 2 || rejected.Load() != 1 {
+			t.Errorf("Unexpected stats %+v", stats)
+		}
+	})
+
+	t.Run("TestPool_QueuePolicy_Drop", func(t *testing.T) {
+		var dropped atomic.Int32
+		p, release := setup(Drop, Hooks{OnDrop: func() { dropped.Add(1) }})
+		if err := p.Submit(context.Background(), noop); err != nil {
+			t.Errorf("Expected nil, but got %v", err)
+		}
+		if _, err := SubmitWait(context.Background(), p, func(ctx context.Context) (int, error) { return 1, nil }); !errors.Is(err, ErrDropped) {
+			t.Errorf("Expected %v, but got %v", ErrDropped, err)
+		}
+		close(release)
+		p.Drain(context.Background())
+		if stats := p.Stats(); stats.Dropped != 2 || stats.Completed != 2 || dropped.Load() != 2 {
+			t.Errorf("Unexpected stats %+v", stats)
+		}
+	})
+
+	t.Run("TestPool_QueuePolicy_Block", func(t *testing.T) {
+		p, release := setup(Block, Hooks{})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		if err := p.Submit(ctx, noop); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected %v, but got %v", context.DeadlineExceeded, err)
+		}
+
+		done := make(chan error, 1)
+		go func() { done <- p.Submit(context.Background(), noop) }()
+		close(release)
+		if err := <-done; err != nil {
+			t.Errorf("Expected nil, but got %v", err)
+		}
+		p.Drain(context.Background())
+		if stats := p.Stats(); stats.Completed != 3 {
+			t.Errorf("Unexpected stats %+v", stats)
+		}
+	})
+
+	t.Run("TestPool_QueuePolicy_BlockedSubmitterWokenByDrain", func(t *testing.T) {
+		p, release := setup(Block, Hooks{})
+
+		done := make(chan error, 1)
+		go func() { done <- p.Submit(context.Background(), noop) }()
+		time.Sleep(10 * time.Millisecond)
+		go p.Drain(context.Background())
+		if err := <-done; !errors.Is(err, ErrPoolClosed) {
+			t.Errorf("Expected %v, but got %v", ErrPoolClosed, err)
+		}
+		close(release)
+	})
+}
+
+// TestPool_SubmitAfterNew 验证没有队列时，刚创建的池与刚执行完任务的工作协程都能立即接收任务。
+func TestPool_SubmitAfterNew(t *testing.T) {
+	for _, policy := range []QueuePolicy{Reject, Drop} {
+		t.Run(policy.String(), func(t *testing.T) {
+			for i := 0; i < 200; i++ {
+				var ran atomic.Int32
+				p := New(Options{Workers: 4, Policy: policy})
+				for k := 0; k < 4; k++ {
+					if err := p.Submit(context.Background(), func(ctx context.Context) error {
+						ran.Add(1)
+						return nil
+					}); err != nil {
+						t.Fatalf("Expected nil, but got %v", err)
+					}
+				}
+				p.Drain(context.Background())
+				if stats := p.Stats(); ran.Load() != 4 || stats.Dropped != 0 || stats.Rejected != 0 {
+					t.Fatalf("Expected 4 tasks to run, but got %v with stats %+v", ran.Load(), stats)
+				}
+			}
+		})
+	}
+
+	t.Run("TestPool_SubmitAfterNew_Sequential", func(t *testing.T) {
+		p := New(Options{Workers: 1, Policy: Reject})
+		for i := 0; i < 1000; i++ {
+			if _, err := SubmitWait(context.Background(), p, func(ctx context.Context) (int, error) { return i, nil }); err != nil {
+				t.Fatalf("Expected nil, but got %v", err)
+			}
+		}
+		p.Drain(context.Background())
+	})
+}
+
+// TestPool_NonBlockingPoliciesNeverWait 验证 Reject 与 Drop 策略下并发提交不会等待正在执行的任务结束：
+// 任务一直阻塞到测试结束，唯一的工作协程被占用后，其余提交都必须立即返回。
+func TestPool_NonBlockingPoliciesNeverWait(t *testing.T) {
+	for _, policy := range []QueuePolicy{Reject, Drop} {
+		t.Run(policy.String(), func(t *testing.T) {
+			for i := 0; i < 300; i++ {
+				release := make(chan struct{})
+				var accepted atomic.Int32
+				p := New(Options{Workers: 1, Policy: policy})
+
+				var wg sync.WaitGroup
+				for g := 0; g < 8; g++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						err := p.Submit(context.Background(), func(ctx context.Context) error {
+							accepted.Add(1)
+							<-release
+							return nil
+						})
+						if err != nil && !errors.Is(err, ErrQueueFull) {
+							t.Errorf("Unexpected error %v", err)
+						}
+					}()
+				}
+
+				done := make(chan struct{})
+				go func() {
+					wg.Wait()
+					close(done)
+				}()
+				select {
+				case <-done:
+				case <-time.After(5 * time.Second):
+					t.Fatalf("Expected Submit not to wait for a running task")
+				}
+				close(release)
+				p.Drain(context.Background())
+
+				if got := accepted.Load(); got != 1 {
+					t.Fatalf("Expected exactly 1 task to run, but got %v", got)
+				}
+			}
+		})
+	}
+}
+
+func TestSubmitWait(t *testing.T) {
+	p := New(Options{Workers: 2})
+	defer p.Drain(context.Background())
+
+	v, err := SubmitWait(context.Background(), p, func(ctx context.Context) (string, error) { return "ok", nil })
+	if v != "ok" || err != nil {
+		t.Errorf("Expected (ok, nil), but got (%v, %v)", v, err)
+	}
+
+	errFailed := errors.New("failed")
+	if _, err := SubmitWait(context.Background(), p, func(ctx context.Context) (int, error) { return 0, errFailed }); !errors.Is(err, errFailed) {
+		t.Errorf("Expected %v, but got %v", errFailed, err)
+	}
+
+	_, err = SubmitWait(context.Background(), p, func(ctx context.Context) (int, error) { panic("boom") })
+	var panicErr *goexsync.PanicError
+	if !errors.As(err, &panicErr) || panicErr.Value != "boom" {
+		t.Errorf("Expected PanicError, but got %v", err)
+	}
+
+	// panic 之后池仍然可以正常工作
+	if v, _ := SubmitWait(context.Background(), p, func(ctx context.Context) (int, error) { return 7, nil }); v != 7 {
+		t.Errorf("Expected %v, but got %v", 7, v)
+	}
+	if stats := p.Stats(); stats.Completed != 2 || stats.Failed != 2 {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+}
+
+func TestPool_Shutdown(t *testing.T) {
+	started := make(chan struct{}, 1)
+	p := New(Options{Workers: 1, QueueSize: 5})
+
+	var cancelled atomic.Bool
+	p.Submit(context.Background(), func(ctx context.Context) error {
+		started <- struct{}{}
+		<-ctx.Done()
+		cancelled.Store(true)
+		return ctx.Err()
+	})
+	<-started
+
+	ran := atomic.Bool{}
+	for i := 0; i < 3; i++ {
+		p.Submit(context.Background(), func(ctx context.Context) error { ran.Store(true); return nil })
+	}
+	waited := make(chan error, 1)
+	go func() {
+		_, err := SubmitWait(context.Background(), p, func(ctx context.Context) (int, error) { return 1, nil })
+		waited <- err
+	}()
+	for p.Stats().Queued != 4 {
+		time.Sleep(time.Millisecond)
+	}
+
+	if err := p.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Expected nil, but got %v", err)
+	}
+	if !cancelled.Load() || ran.Load() {
+		t.Errorf("Expected running task to be cancelled and queued tasks to be discarded")
+	}
+	if err := <-waited; !errors.Is(err, ErrDropped) {
+		t.Errorf("Expected %v, but got %v", ErrDropped, err)
+	}
+	if stats := p.Stats(); stats.Dropped != 4 || stats.Failed != 1 {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+}
+
+func TestPool_DrainTimeout(t *testing.T) {
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	p := New(Options{Workers: 1})
+	p.Submit(context.Background(), blocker(started, release))
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := p.Drain(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected %v, but got %v", context.DeadlineExceeded, err)
+	}
+	close(release)
+	if err := p.Drain(context.Background()); err != nil {
+		t.Errorf("Expected nil, but got %v", err)
+	}
+}
+
+func TestPool_AutoScale(t *testing.T) {
+	clock := goexclock.NewFake(time.Time{})
+	var mu sync.Mutex
+	var sizes []int
+	p := New(Options{
+		Workers:     1,
+		MaxWorkers:  3,
+		IdleTimeout: time.Minute,
+		Clock:       clock,
+		Hooks: Hooks{OnWorkers: func(n int) {
+			mu.Lock()
+			sizes = append(sizes, n)
+			mu.Unlock()
+		}},
+	})
+
+	started := make(chan struct{}, 4)
+	release := make(chan struct{})
+	for i := 0; i < 3; i++ {
+		p.Submit(context.Background(), blocker(started, release))
+		<-started
+	}
+	if stats := p.Stats(); stats.Workers != 3 || stats.Busy != 3 {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+
+	close(release)
+	clock.BlockUntil(3)
+	clock.Advance(time.Minute)
+	for p.Stats().Workers != 1 {
+		time.Sleep(time.Millisecond)
+	}
+
+	mu.Lock()
+	expected := []int{1, 2, 3, 2, 1}
+	if !reflect.DeepEqual(sizes, expected) {
+		t.Errorf("Expected %v, but got %v", expected, sizes)
+	}
+	mu.Unlock()
+	p.Drain(context.Background())
+}
+
+func TestPool_Hooks(t *testing.T) {
+	clock := goexclock.NewFake(time.Time{})
+	var submits atomic.Int32
+	waits := make(chan time.Duration, 1)
+	finishes := make(chan error, 1)
+	p := New(Options{
+		Workers: 1,
+		Clock:   clock,
+		Hooks: Hooks{
+			OnSubmit: func() { submits.Add(1) },
+			OnStart:  func(wait time.Duration) { waits <- wait },
+			OnFinish: func(elapsed time.Duration, err error) { finishes <- err },
+		},
+	})
+
+	errFailed := errors.New("failed")
+	p.Submit(context.Background(), func(ctx context.Context) error { return errFailed })
+	p.Drain(context.Background())
+
+	if submits.Load() != 1 || <-waits != 0 || !errors.Is(<-finishes, errFailed) {
+		t.Errorf("Expected hooks to observe the task")
+	}
+}
+
+func TestQueuePolicy_String(t *testing.T) {
+	tests := []struct {
+		policy   QueuePolicy
+		expected string
+	}{
+		{Block, "block"},
+		{Drop, "drop"},
+		{Reject, "reject"},
+		{QueuePolicy(9), "unknown"},
+	}
+	for _, tt := range tests {
+		if got := tt.policy.String(); got != tt.expected {
+			t.Errorf("Expected %v, but got %v", tt.expected, got)
+		}
+	}
+}
+
+func BenchmarkPool_Submit(b *testing.B) {
+	p := New(Options{Workers: 8, QueueSize: 1024})
+	noop := func(ctx context.Context) error { return nil }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.Submit(context.Background(), noop)
+	}
+	p.Drain(context.Background())
+}
@@ -0,0 +1,115 @@
+package goexretry
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Backoff 计算两次尝试之间的等待时间。
+type Backoff interface {
+	// Delay 返回第 attempt 次失败（从 1 开始）之后的等待时间，prev 为上一次的等待时间，第一次为 0。
+	Delay(attempt int, prev time.Duration) time.Duration
+}
+
+// BackoffFunc 将普通函数适配为 Backoff。
+type BackoffFunc func(attempt int, prev time.Duration) time.Duration
+
+// Delay 实现 Backoff 接口。
+func (f BackoffFunc) Delay(attempt int, prev time.Duration) time.Duration {
+	return f(attempt, prev)
+}
+
+// Constant 每次等待固定的时长。
+type Constant struct {
+	Interval time.Duration // 等待时长
+}
+
+// Delay 实现 Backoff 接口。
+func (b Constant) Delay(int, time.Duration) time.Duration {
+	return b.Interval
+}
+
+// Linear 的等待时长按 Initial + Step*(attempt-1) 线性增长。
+type Linear struct {
+	Initial time.Duration // 第一次等待的时长
+	Step    time.Duration // 每次增加的时长
+	Max     time.Duration // 等待时长上限，小于等于 0 表示不限制
+}
+
+// Delay 实现 Backoff 接口。
+func (b Linear) Delay(attempt int, _ time.Duration) time.Duration {
+	d := b.Initial + b.Step*time.Duration(attempt-1)
+	return capDuration(d, b.Max)
+}
+
+// Exponential 的等待时长按 Initial * Multiplier^(attempt-1) 指数增长。
+//
+// Jitter 大于 0 时，等待时长会在 [d*(1-Jitter), d] 区间内随机取值，以避免大量客户端同时重试。
+type Exponential struct {
+	Initial    time.Duration  // 第一次等待的时长
+	Max        time.Duration  // 等待时长上限，小于等于 0 表示不限制
+	Multiplier float64        // 增长倍数，小于等于 1 时使用 2
+	Jitter     float64        // 随机抖动比例，取值范围 [0, 1]
+	Rand       func() float64 // 返回 [0, 1) 随机数的函数，为 nil 时使用 math/rand
+}
+
+// Delay 实现 Backoff 接口。
+func (b Exponential) Delay(attempt int, _ time.Duration) time.Duration {
+	multiplier := b.Multiplier
+	if multiplier <= 1 {
+		multiplier = 2
+	}
+	f := float64(b.Initial) * math.Pow(multiplier, float64(attempt-1))
+	if math.IsNaN(f) {
+		// Initial 为 0 而倍数溢出为 +Inf
+		f = 0
+	}
+	if b.Max > 0 && f > float64(b.Max) {
+		f = float64(b.Max)
+	}
+	// 先饱和再抖动，避免 +Inf 参与运算得到 NaN
+	if f >= math.MaxInt64 {
+		f = math.MaxInt64
+	}
+	if jitter := math.Min(math.Max(b.Jitter, 0), 1); jitter > 0 {
+		f -= f * jitter * random(b.Rand)
+	}
+	// float64(math.MaxInt64) 等于 2^63，直接转换会溢出为负数
+	if f >= math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(f)
+}
+
+// DecorrelatedJitter 实现 AWS 架构博客中的 “Decorrelated Jitter” 算法：
+// 等待时长在 [Base, prev*3] 区间内随机取值，并且不超过 Cap。
+type DecorrelatedJitter struct {
+	Base time.Duration  // 最小等待时长
+	Cap  time.Duration  // 等待时长上限，小于等于 0 表示不限制
+	Rand func() float64 // 返回 [0, 1) 随机数的函数，为 nil 时使用 math/rand
+}
+
+// Delay 实现 Backoff 接口。
+func (b DecorrelatedJitter) Delay(_ int, prev time.Duration) time.Duration {
+	upper := time.Duration(math.MaxInt64)
+	if prev <= math.MaxInt64/3 {
+		upper = max(prev*3, b.Base)
+	}
+	d := b.Base + time.Duration(float64(upper-b.Base)*random(b.Rand))
+	return capDuration(d, b.Cap)
+}
+
+func capDuration(d, limit time.Duration) time.Duration {
+	if limit > 0 && d > limit {
+		return limit
+	}
+	return max(d, 0)
+}
+
+func random(fn func() float64) float64 {
+	if fn == nil {
+		return rand.Float64()
+	}
+	return fn()
+}
@@ -0,0 +1,73 @@
+package goexretry
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func delays(b Backoff, n int) []time.Duration {
+	var result []time.Duration
+	var prev time.Duration
+	for attempt := 1; attempt <= n; attempt++ {
+		prev = b.Delay(attempt, prev)
+		result = append(result, prev)
+	}
+	return result
+}
+
+func TestBackoff(t *testing.T) {
+	half := func() float64 { return 0.5 }
+	testCases := []struct {
+		name    string
+		backoff Backoff
+		want    []time.Duration
+	}{
+		{"Constant", Constant{Interval: time.Second}, []time.Duration{time.Second, time.Second, time.Second}},
+		{"Linear", Linear{Initial: time.Second, Step: time.Second, Max: 2500 * time.Millisecond},
+			[]time.Duration{time.Second, 2 * time.Second, 2500 * time.Millisecond}},
+		{"Exponential", Exponential{Initial: time.Second, Max: 5 * time.Second},
+			[]time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}},
+		{"ExponentialMultiplier", Exponential{Initial: time.Second, Multiplier: 3},
+			[]time.Duration{time.Second, 3 * time.Second, 9 * time.Second}},
+		{"ExponentialJitter", Exponential{Initial: time.Second, Jitter: 0.5, Rand: half},
+			[]time.Duration{750 * time.Millisecond, 1500 * time.Millisecond}},
+		{"DecorrelatedJitter", DecorrelatedJitter{Base: time.Second, Cap: 4 * time.Second, Rand: half},
+			[]time.Duration{time.Second, 2 * time.Second, 3500 * time.Millisecond, 4 * time.Second}},
+	}
+
+	for _, tc := range testCases {
+		if got := delays(tc.backoff, len(tc.want)); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: expected %v, but got %v", tc.name, tc.want, got)
+		}
+	}
+}
+
+// TestBackoffSaturates 验证没有上限时等待时长在多次重试后饱和，而不是溢出为负数或回绕。
+func TestBackoffSaturates(t *testing.T) {
+	testCases := []struct {
+		name    string
+		backoff Backoff
+	}{
+		{"Exponential", Exponential{Initial: 100 * time.Millisecond}},
+		{"ExponentialMultiplier", Exponential{Initial: time.Second, Multiplier: 10}},
+		{"ExponentialJitter", Exponential{Initial: 100 * time.Millisecond, Jitter: 0.2, Rand: func() float64 { return 0.5 }}},
+		{"DecorrelatedJitter", DecorrelatedJitter{Base: time.Second, Rand: func() float64 { return 0.999 }}},
+	}
+
+	for _, tc := range testCases {
+		got := delays(tc.backoff, 1200)
+		for i := 1; i < len(got); i++ {
+			if got[i] < got[i-1] {
+				t.Fatalf("%s: expected non-decreasing delays, but attempt %d got %v after %v", tc.name, i+1, got[i], got[i-1])
+			}
+		}
+		if last := got[len(got)-1]; last < time.Duration(1<<62) {
+			t.Errorf("%s: expected delay to saturate, but got %v", tc.name, last)
+		}
+	}
+
+	if got := (Exponential{Jitter: 0.2}).Delay(1100, 0); got != 0 {
+		t.Errorf("Expected zero initial delay to stay 0, but got %v", got)
+	}
+}
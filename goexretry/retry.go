@@ -0,0 +1,229 @@
+package goexretry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+	"github.com/birdmichael/GoEx/goexslice"
+)
+
+// DefaultMaxAttempts 是 Policy.MaxAttempts 为 0 时使用的最大尝试次数。
+const DefaultMaxAttempts = 3
+
+// Attempt 描述一次失败的尝试，传递给 Policy.OnRetry。
+type Attempt struct {
+	Number  int           // 尝试序号，从 1 开始
+	Err     error         // 本次尝试返回的错误
+	Delay   time.Duration // 下一次尝试前的等待时间
+	Elapsed time.Duration // 从第一次尝试开始经过的时间
+}
+
+// Policy 描述重试的方式，所有字段均可省略。
+type Policy struct {
+	// Backoff 计算两次尝试之间的等待时间，为 nil 时使用初始 100 毫秒、上限 10 秒的 Exponential。
+	Backoff Backoff
+	// MaxAttempts 是最大尝试次数（包括第一次），为 0 时使用 DefaultMaxAttempts，小于 0 表示不限制。
+	MaxAttempts int
+	// MaxElapsed 是从第一次尝试开始允许经过的最长时间，小于等于 0 表示不限制。
+	// 如果下一次等待会超过该时间，则不再重试。
+	MaxElapsed time.Duration
+	// Retryable 判断错误是否可以重试，为 nil 时除 Permanent 包装的错误外都会重试。
+	Retryable goexslice.Predicate[error]
+	// OnRetry 在每次失败且即将重试时、等待之前被调用。
+	OnRetry func(attempt Attempt)
+	// Clock 用于计时与等待，为 nil 时使用真实时钟。
+	Clock goexclock.Clock
+}
+
+// ExhaustedError 表示达到最大尝试次数或最长时间后仍然失败。
+type ExhaustedError struct {
+	Attempts int           // 实际尝试的次数
+	Elapsed  time.Duration // 从第一次尝试开始经过的时间
+	Err      error         // 最后一次尝试返回的错误
+}
+
+// Error 实现 error 接口。
+func (e *ExhaustedError) Error() string {
+	return fmt.Sprintf("retry: giving up after %d attempts: %v", e.Attempts, e.Err)
+}
+
+// Unwrap 返回最后一次尝试的错误。
+func (e *ExhaustedError) Unwrap() error {
+	return e.Err
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent 包装一个不应重试的错误，Do 遇到它会立即返回被包装的 err。
+//
+// 示例：
+//   - return goexretry.Permanent(ErrInvalidArgument)
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent 判断 err 是否由 Permanent 包装。
+func IsPermanent(err error) bool {
+	var p *permanentError
+	return errors.As(err, &p)
+}
+
+// MARK: - Do
+
+// Do 按 policy 执行 fn，直到成功、遇到不可重试的错误、尝试次数或时间用尽，或 ctx 被取消。
+//
+// 参数：
+//   - ctx: 上下文，取消后立即停止等待并返回。
+//   - policy: 重试策略。
+//   - fn: 要执行的函数。
+//
+// 返回值：
+//   - 成功时为 nil。
+//   - 遇到不可重试的错误时为该错误本身（Permanent 包装会被去掉）。
+//   - 尝试次数或时间用尽时为 *ExhaustedError。
+//   - ctx 被取消时为同时包装 ctx.Err() 与最后一次错误的错误。
+func Do(ctx context.Context, policy Policy, fn func(ctx context.Context) error) error {
+	_, err := DoValue(ctx, policy, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, fn(ctx)
+	})
+	return err
+}
+
+// DoValue 与 Do 相同，但 fn 会返回一个值，成功时返回该值。
+//
+// 示例：
+//
+//	body, err := goexretry.DoValue(ctx, goexretry.Policy{MaxAttempts: 5}, func(ctx context.Context) ([]byte, error) {
+//		return fetch(ctx, url)
+//	})
+func DoValue[T any](ctx context.Context, policy Policy, fn func(ctx context.Context) (T, error)) (T, error) {
+	clock := goexclock.OrReal(policy.Clock)
+	backoff := policy.Backoff
+	if backoff == nil {
+		backoff = Exponential{Initial: 100 * time.Millisecond, Max: 10 * time.Second}
+	}
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+
+	var zero T
+	start := clock.Now()
+	var delay time.Duration
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+
+		value, err := fn(ctx)
+		if err == nil {
+			return value, nil
+		}
+		if IsPermanent(err) {
+			var p *permanentError
+			errors.As(err, &p)
+			return zero, p.err
+		}
+		if policy.Retryable != nil && !policy.Retryable(err) {
+			return zero, err
+		}
+
+		elapsed := clock.Since(start)
+		if maxAttempts > 0 && attempt >= maxAttempts {
+			return zero, &ExhaustedError{Attempts: attempt, Elapsed: elapsed, Err: err}
+		}
+		delay = backoff.Delay(attempt, delay)
+		if policy.MaxElapsed > 0 && elapsed+delay > policy.MaxElapsed {
+			return zero, &ExhaustedError{Attempts: attempt, Elapsed: elapsed, Err: err}
+		}
+
+		if policy.OnRetry != nil {
+			policy.OnRetry(Attempt{Number: attempt, Err: err, Delay: delay, Elapsed: elapsed})
+		}
+		if ctxErr := sleep(ctx, clock, delay); ctxErr != nil {
+			return zero, fmt.Errorf("%w: last error: %w", ctxErr, err)
+		}
+	}
+}
+
+func sleep(ctx context.Context, clock goexclock.Clock, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := clock.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// MARK: - Predicate
+
+// RetryIfErrorIs 返回一个判断函数：当错误链中包含 targets 之一时可以重试。
+//
+// 示例：
+//   - Policy{Retryable: RetryIfErrorIs(io.ErrUnexpectedEOF, syscall.ECONNRESET)}
+func RetryIfErrorIs(targets ...error) goexslice.Predicate[error] {
+	return func(err error) bool {
+		for _, target := range targets {
+			if errors.Is(err, target) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// RetryIfErrorAs 返回一个判断函数：当错误链中包含类型为 E 的错误时可以重试。
+func RetryIfErrorAs[E error]() goexslice.Predicate[error] {
+	return func(err error) bool {
+		var target E
+		return errors.As(err, &target)
+	}
+}
+
+// Not 返回 predicate 取反后的判断函数。
+func Not(predicate goexslice.Predicate[error]) goexslice.Predicate[error] {
+	return func(err error) bool {
+		return !predicate(err)
+	}
+}
+
+// AnyOf 返回一个判断函数：任意一个 predicate 返回 true 时返回 true。
+func AnyOf(predicates ...goexslice.Predicate[error]) goexslice.Predicate[error] {
+	return func(err error) bool {
+		for _, p := range predicates {
+			if p(err) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// AllOf 返回一个判断函数：所有 predicate 都返回 true 时返回 true。
+func AllOf(predicates ...goexslice.Predicate[error]) goexslice.Predicate[error] {
+	return func(err error) bool {
+		for _, p := range predicates {
+			if !p(err) {
+				return false
+			}
+		}
+		return true
+	}
+}
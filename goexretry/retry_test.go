@@ -0,0 +1,161 @@
+package goexretry
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+)
+
+var errTemporary = errors.New("temporary")
+
+// advanceOnRetry 返回一个 OnRetry 钩子，在每次重试前记录等待时间，并在后台推进 Fake 时钟。
+func advanceOnRetry(clock *goexclock.Fake, delays *[]time.Duration) func(Attempt) {
+	return func(a Attempt) {
+		*delays = append(*delays, a.Delay)
+		go func() {
+			clock.BlockUntil(1)
+			clock.Advance(a.Delay)
+		}()
+	}
+}
+
+func TestDo(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("TestDo_SucceedAfterRetries", func(t *testing.T) {
+		clock := goexclock.NewFake(time.Time{})
+		var delays []time.Duration
+		calls := 0
+		err := Do(ctx, Policy{
+			Backoff:     Exponential{Initial: time.Second},
+			MaxAttempts: 5,
+			Clock:       clock,
+			OnRetry:     advanceOnRetry(clock, &delays),
+		}, func(ctx context.Context) error {
+			calls++
+			if calls < 3 {
+				return errTemporary
+			}
+			return nil
+		})
+
+		if err != nil || calls != 3 {
+			t.Errorf("Expected success on third call, but got %v after %d calls", err, calls)
+		}
+		if !reflect.DeepEqual(delays, []time.Duration{time.Second, 2 * time.Second}) {
+			t.Errorf("Unexpected delays %v", delays)
+		}
+	})
+
+	t.Run("TestDo_Exhausted", func(t *testing.T) {
+		clock := goexclock.NewFake(time.Time{})
+		var delays []time.Duration
+		err := Do(ctx, Policy{
+			Backoff: Constant{Interval: time.Second},
+			Clock:   clock,
+			OnRetry: advanceOnRetry(clock, &delays),
+		}, func(ctx context.Context) error { return errTemporary })
+
+		var exhausted *ExhaustedError
+		if !errors.As(err, &exhausted) || exhausted.Attempts != DefaultMaxAttempts || !errors.Is(err, errTemporary) {
+			t.Errorf("Expected ExhaustedError after %d attempts, but got %v", DefaultMaxAttempts, err)
+		}
+		if exhausted.Elapsed != 2*time.Second {
+			t.Errorf("Expected elapsed 2s, but got %v", exhausted.Elapsed)
+		}
+	})
+
+	t.Run("TestDo_MaxElapsed", func(t *testing.T) {
+		clock := goexclock.NewFake(time.Time{})
+		var delays []time.Duration
+		err := Do(ctx, Policy{
+			Backoff:     Constant{Interval: time.Second},
+			MaxAttempts: -1,
+			MaxElapsed:  3 * time.Second,
+			Clock:       clock,
+			OnRetry:     advanceOnRetry(clock, &delays),
+		}, func(ctx context.Context) error { return errTemporary })
+
+		var exhausted *ExhaustedError
+		if !errors.As(err, &exhausted) || exhausted.Attempts != 4 {
+			t.Errorf("Expected to give up after 4 attempts, but got %v", err)
+		}
+	})
+
+	t.Run("TestDo_Permanent", func(t *testing.T) {
+		errFatal := errors.New("fatal")
+		calls := 0
+		err := Do(ctx, Policy{}, func(ctx context.Context) error {
+			calls++
+			return Permanent(errFatal)
+		})
+		if err != errFatal || calls != 1 {
+			t.Errorf("Expected unwrapped fatal error after one call, but got %v after %d calls", err, calls)
+		}
+	})
+
+	t.Run("TestDo_Retryable", func(t *testing.T) {
+		errOther := errors.New("other")
+		calls := 0
+		err := Do(ctx, Policy{Retryable: RetryIfErrorIs(errTemporary)}, func(ctx context.Context) error {
+			calls++
+			return errOther
+		})
+		if err != errOther || calls != 1 {
+			t.Errorf("Expected non-retryable error after one call, but got %v after %d calls", err, calls)
+		}
+	})
+
+	t.Run("TestDo_ContextCanceled", func(t *testing.T) {
+		clock := goexclock.NewFake(time.Time{})
+		ctx, cancel := context.WithCancel(ctx)
+		err := Do(ctx, Policy{
+			Backoff: Constant{Interval: time.Hour},
+			Clock:   clock,
+			OnRetry: func(Attempt) { cancel() },
+		}, func(ctx context.Context) error { return errTemporary })
+
+		if !errors.Is(err, context.Canceled) || !errors.Is(err, errTemporary) {
+			t.Errorf("Expected canceled error wrapping last error, but got %v", err)
+		}
+	})
+}
+
+func TestDoValue(t *testing.T) {
+	calls := 0
+	v, err := DoValue(context.Background(), Policy{Backoff: Constant{}}, func(ctx context.Context) (string, error) {
+		calls++
+		if calls == 1 {
+			return "", errTemporary
+		}
+		return "ok", nil
+	})
+	if v != "ok" || err != nil {
+		t.Errorf("Expected ok and nil, but got %v and %v", v, err)
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	wrapped := errors.Join(errors.New("context"), errTemporary)
+	testCases := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"RetryIfErrorIs", RetryIfErrorIs(errTemporary)(wrapped), true},
+		{"RetryIfErrorAs", RetryIfErrorAs[*ExhaustedError]()(&ExhaustedError{Err: errTemporary}), true},
+		{"RetryIfErrorAsMiss", RetryIfErrorAs[*ExhaustedError]()(errTemporary), false},
+		{"Not", Not(RetryIfErrorIs(errTemporary))(errTemporary), false},
+		{"AnyOf", AnyOf(RetryIfErrorIs(context.Canceled), RetryIfErrorIs(errTemporary))(errTemporary), true},
+		{"AllOf", AllOf(RetryIfErrorIs(context.Canceled), RetryIfErrorIs(errTemporary))(errTemporary), false},
+	}
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("%s: expected %v, but got %v", tc.name, tc.want, tc.got)
+		}
+	}
+}
@@ -0,0 +1,355 @@
+package goexslice
+
+import (
+	"cmp"
+
+	"github.com/birdmichael/GoEx/tupleext"
+)
+
+// Generator 惰性地逐个生成组合结果，不会一次性把所有结果放入内存。
+//
+// 参数：
+//   - T: 生成结果的类型。
+type Generator[T any] struct {
+	next func() (T, bool)
+}
+
+// NewGenerator 使用 next 创建一个生成器，next 在没有更多结果时返回 false，之后不会再被调用。
+//
+// 示例：
+//
+//	n := 0
+//	countdown := NewGenerator(func() (int, bool) {
+//		n++
+//		return 4 - n, n <= 3
+//	})
+//	countdown.Collect() // [3 2 1]
+func NewGenerator[T any](next func() (T, bool)) *Generator[T] {
+	done := false
+	return &Generator[T]{next: func() (v T, ok bool) {
+		if done {
+			return v, false
+		}
+		if v, ok = next(); !ok {
+			done = true
+		}
+		return v, ok
+	}}
+}
+
+// Next 返回下一个结果，生成结束时 ok 为 false。
+//
+// 本文件中的排列组合函数每次返回的切片都是新分配的，可以安全地保存或修改。
+func (g *Generator[T]) Next() (v T, ok bool) {
+	return g.next()
+}
+
+// Collect 返回剩余的所有结果。
+func (g *Generator[T]) Collect() []T {
+	var result []T
+	for v, ok := g.Next(); ok; v, ok = g.Next() {
+		result = append(result, v)
+	}
+	return result
+}
+
+func emptyGenerator[T any]() *Generator[T] {
+	return &Generator[T]{next: func() (v T, ok bool) { return v, false }}
+}
+
+func pick[S ~[]E, E any](slice S, indices []int) S {
+	result := make(S, len(indices))
+	for i, index := range indices {
+		result[i] = slice[index]
+	}
+	return result
+}
+
+// MARK: - Permutations
+
+// Permutations 返回一个按位置字典序生成 slice 中 k 个元素的所有排列的生成器，共 n!/(n-k)! 个。
+//
+// 元素按位置区分，即使值相同也视为不同元素；k 为 0 时只生成一个空切片，k 小于 0 或大于 len(slice) 时不生成任何结果。
+//
+// 示例：
+//   - Permutations([]int{1, 2, 3}, 2).Collect() 返回 [[1 2] [1 3] [2 1] [2 3] [3 1] [3 2]]。
+func Permutations[S ~[]E, E any](slice S, k int) *Generator[S] {
+	n := len(slice)
+	if k < 0 || k > n {
+		return emptyGenerator[S]()
+	}
+
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+	cycles := make([]int, k)
+	for i := range cycles {
+		cycles[i] = n - i
+	}
+
+	started, done := false, false
+	return &Generator[S]{next: func() (S, bool) {
+		if done {
+			return nil, false
+		}
+		if !started {
+			started = true
+			return pick(slice, indices[:k]), true
+		}
+		for i := k - 1; i >= 0; i-- {
+			cycles[i]--
+			if cycles[i] == 0 {
+				// 把 indices[i] 移到末尾，恢复这一位的初始顺序
+				moved := indices[i]
+				copy(indices[i:], indices[i+1:])
+				indices[n-1] = moved
+				cycles[i] = n - i
+				continue
+			}
+			j := n - cycles[i]
+			indices[i], indices[j] = indices[j], indices[i]
+			return pick(slice, indices[:k]), true
+		}
+		done = true
+		return nil, false
+	}}
+}
+
+// NextPermutation 将 slice 原地重排为字典序中的下一个排列，相当于 C++ 的 std::next_permutation。
+//
+// 从升序排列的切片开始反复调用即可遍历所有不重复的排列，重复元素不会产生重复的排列。
+//
+// 返回值：
+//   - bool: 如果存在下一个排列返回 true；如果 slice 已经是最后一个（降序）排列，将其重排为第一个（升序）排列并返回 false。
+//
+// 示例：
+//
+//	s := []int{1, 2, 3}
+//	for ok := true; ok; ok = NextPermutation(s) {
+//		fmt.Println(s)
+//	}
+func NextPermutation[S ~[]E, E cmp.Ordered](slice S) bool {
+	return NextPermutationFunc(slice, cmp.Less[E])
+}
+
+// NextPermutationFunc 与 NextPermutation 相同，但使用 less 比较元素。
+func NextPermutationFunc[S ~[]E, E any](slice S, less Comparator[E]) bool {
+	if len(slice) < 2 {
+		return false
+	}
+	// 找到最长的非递增后缀，其前一个位置就是需要增大的位置
+	i := len(slice) - 2
+	for i >= 0 && !less(slice[i], slice[i+1]) {
+		i--
+	}
+	if i >= 0 {
+		j := len(slice) - 1
+		for !less(slice[i], slice[j]) {
+			j--
+		}
+		slice[i], slice[j] = slice[j], slice[i]
+	}
+	Reverse(slice[i+1:])
+	return i >= 0
+}
+
+// MARK: - Combinations
+
+// Combinations 返回一个按位置字典序生成从 slice 中选取 k 个元素的所有组合的生成器，共 C(n, k) 个。
+//
+// k 为 0 时只生成一个空切片，k 小于 0 或大于 len(slice) 时不生成任何结果。
+//
+// 示例：
+//   - Combinations([]string{"a", "b", "c"}, 2).Collect() 返回 [[a b] [a c] [b c]]。
+func Combinations[S ~[]E, E any](slice S, k int) *Generator[S] {
+	n := len(slice)
+	if k < 0 || k > n {
+		return emptyGenerator[S]()
+	}
+
+	indices := make([]int, k)
+	for i := range indices {
+		indices[i] = i
+	}
+
+	started, done := false, false
+	return &Generator[S]{next: func() (S, bool) {
+		if done {
+			return nil, false
+		}
+		if !started {
+			started = true
+			return pick(slice, indices), true
+		}
+		// 找到最右边还能增大的位置
+		i := k - 1
+		for i >= 0 && indices[i] == i+n-k {
+			i--
+		}
+		if i < 0 {
+			done = true
+			return nil, false
+		}
+		indices[i]++
+		for j := i + 1; j < k; j++ {
+			indices[j] = indices[j-1] + 1
+		}
+		return pick(slice, indices), true
+	}}
+}
+
+// CombinationsWithReplacement 返回一个生成从 slice 中可重复地选取 k 个元素的所有组合的生成器，共 C(n+k-1, k) 个。
+//
+// k 为 0 时只生成一个空切片；k 小于 0，或 slice 为空而 k 大于 0 时不生成任何结果。
+//
+// 示例：
+//   - CombinationsWithReplacement([]int{1, 2}, 2).Collect() 返回 [[1 1] [1 2] [2 2]]。
+func CombinationsWithReplacement[S ~[]E, E any](slice S, k int) *Generator[S] {
+	n := len(slice)
+	if k < 0 || (n == 0 && k > 0) {
+		return emptyGenerator[S]()
+	}
+
+	indices := make([]int, k)
+	started, done := false, false
+	return &Generator[S]{next: func() (S, bool) {
+		if done {
+			return nil, false
+		}
+		if !started {
+			started = true
+			return pick(slice, indices), true
+		}
+		i := k - 1
+		for i >= 0 && indices[i] == n-1 {
+			i--
+		}
+		if i < 0 {
+			done = true
+			return nil, false
+		}
+		value := indices[i] + 1
+		for j := i; j < k; j++ {
+			indices[j] = value
+		}
+		return pick(slice, indices), true
+	}}
+}
+
+// PowerSet 返回一个生成 slice 所有子集的生成器，共 2^n 个。
+//
+// 子集按元素个数从少到多生成，个数相同的子集按 Combinations 的顺序生成，第一个结果是空切片。
+//
+// 示例：
+//   - PowerSet([]int{1, 2, 3}).Collect() 返回 [[] [1] [2] [3] [1 2] [1 3] [2 3] [1 2 3]]。
+func PowerSet[S ~[]E, E any](slice S) *Generator[S] {
+	size := 0
+	current := Combinations(slice, size)
+	return &Generator[S]{next: func() (S, bool) {
+		for size <= len(slice) {
+			if v, ok := current.Next(); ok {
+				return v, true
+			}
+			size++
+			current = Combinations(slice, size)
+		}
+		return nil, false
+	}}
+}
+
+// MARK: - Cartesian Product
+
+// odometer 返回一个依次生成所有下标组合的函数，最后一位变化最快。
+func odometer(lengths ...int) func() ([]int, bool) {
+	for _, length := range lengths {
+		if length == 0 {
+			return func() ([]int, bool) { return nil, false }
+		}
+	}
+	indices := make([]int, len(lengths))
+	started, done := false, false
+	return func() ([]int, bool) {
+		if done {
+			return nil, false
+		}
+		if !started {
+			started = true
+			return indices, true
+		}
+		for i := len(indices) - 1; i >= 0; i-- {
+			indices[i]++
+			if indices[i] < lengths[i] {
+				return indices, true
+			}
+			indices[i] = 0
+		}
+		done = true
+		return nil, false
+	}
+}
+
+// CartesianProduct 返回一个生成多个同类型切片的笛卡尔积的生成器，最后一个切片变化最快。
+//
+// 任意一个切片为空时不生成任何结果；没有传入切片时只生成一个空切片。
+//
+// 示例：
+//   - CartesianProduct([]int{1, 2}, []int{3, 4}).Collect() 返回 [[1 3] [1 4] [2 3] [2 4]]。
+func CartesianProduct[S ~[]E, E any](slices ...S) *Generator[S] {
+	lengths := make([]int, len(slices))
+	for i, s := range slices {
+		lengths[i] = len(s)
+	}
+	next := odometer(lengths...)
+	return &Generator[S]{next: func() (S, bool) {
+		indices, ok := next()
+		if !ok {
+			return nil, false
+		}
+		result := make(S, len(indices))
+		for i, index := range indices {
+			result[i] = slices[i][index]
+		}
+		return result, true
+	}}
+}
+
+// CartesianProduct2 返回一个生成两个切片的笛卡尔积的生成器，结果为 tupleext.Tuple。
+//
+// 示例：
+//   - CartesianProduct2([]string{"linux", "darwin"}, []int{32, 64}).Collect() 返回
+//     [{linux 32} {linux 64} {darwin 32} {darwin 64}]。
+func CartesianProduct2[T1, T2 any](s1 []T1, s2 []T2) *Generator[tupleext.Tuple[T1, T2]] {
+	next := odometer(len(s1), len(s2))
+	return &Generator[tupleext.Tuple[T1, T2]]{next: func() (t tupleext.Tuple[T1, T2], ok bool) {
+		i, ok := next()
+		if !ok {
+			return t, false
+		}
+		return tupleext.Tuple[T1, T2]{S1: s1[i[0]], S2: s2[i[1]]}, true
+	}}
+}
+
+// CartesianProduct3 返回一个生成三个切片的笛卡尔积的生成器，结果为 tupleext.Tuple3。
+func CartesianProduct3[T1, T2, T3 any](s1 []T1, s2 []T2, s3 []T3) *Generator[tupleext.Tuple3[T1, T2, T3]] {
+	next := odometer(len(s1), len(s2), len(s3))
+	return &Generator[tupleext.Tuple3[T1, T2, T3]]{next: func() (t tupleext.Tuple3[T1, T2, T3], ok bool) {
+		i, ok := next()
+		if !ok {
+			return t, false
+		}
+		return tupleext.Tuple3[T1, T2, T3]{S1: s1[i[0]], S2: s2[i[1]], S3: s3[i[2]]}, true
+	}}
+}
+
+// CartesianProduct4 返回一个生成四个切片的笛卡尔积的生成器，结果为 tupleext.Tuple4。
+func CartesianProduct4[T1, T2, T3, T4 any](s1 []T1, s2 []T2, s3 []T3, s4 []T4) *Generator[tupleext.Tuple4[T1, T2, T3, T4]] {
+	next := odometer(len(s1), len(s2), len(s3), len(s4))
+	return &Generator[tupleext.Tuple4[T1, T2, T3, T4]]{next: func() (t tupleext.Tuple4[T1, T2, T3, T4], ok bool) {
+		i, ok := next()
+		if !ok {
+			return t, false
+		}
+		return tupleext.Tuple4[T1, T2, T3, T4]{S1: s1[i[0]], S2: s2[i[1]], S3: s3[i[2]], S4: s4[i[3]]}, true
+	}}
+}
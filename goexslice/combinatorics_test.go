@@ -0,0 +1,232 @@
+package goexslice
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/birdmichael/GoEx/tupleext"
+)
+
+func TestPermutations(t *testing.T) {
+	testCases := []struct {
+		name  string
+		slice []int
+		k     int
+		want  [][]int
+	}{
+		{"Full", []int{1, 2, 3}, 3, [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}}},
+		{"Partial", []int{1, 2, 3}, 2, [][]int{{1, 2}, {1, 3}, {2, 1}, {2, 3}, {3, 1}, {3, 2}}},
+		{"Zero", []int{1, 2}, 0, [][]int{{}}},
+		{"TooLarge", []int{1, 2}, 3, nil},
+		{"Negative", []int{1, 2}, -1, nil},
+		{"Empty", nil, 0, [][]int{{}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestPermutations_"+tc.name, func(t *testing.T) {
+			if got := Permutations(tc.slice, tc.k).Collect(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Expected %v, but got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestPermutations_Count(t *testing.T) {
+	gen := Permutations([]int{0, 1, 2, 3, 4, 5}, 4)
+	seen := map[[4]int]bool{}
+	for p, ok := gen.Next(); ok; p, ok = gen.Next() {
+		seen[[4]int(p)] = true
+	}
+	if len(seen) != 360 {
+		t.Errorf("Expected %v, but got %v", 360, len(seen))
+	}
+	if _, ok := gen.Next(); ok {
+		t.Errorf("Expected exhausted generator")
+	}
+}
+
+func TestPermutations_ResultsAreIndependent(t *testing.T) {
+	gen := Permutations([]int{1, 2}, 2)
+	first, _ := gen.Next()
+	first[0] = 99
+	second, _ := gen.Next()
+	if want := []int{2, 1}; !reflect.DeepEqual(second, want) {
+		t.Errorf("Expected %v, but got %v", want, second)
+	}
+}
+
+func TestNextPermutation(t *testing.T) {
+	t.Run("TestNextPermutation_All", func(t *testing.T) {
+		s := []int{1, 2, 3}
+		var got [][]int
+		for ok := true; ok; ok = NextPermutation(s) {
+			got = append(got, append([]int(nil), s...))
+		}
+		want := [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, but got %v", want, got)
+		}
+		if want := []int{1, 2, 3}; !reflect.DeepEqual(s, want) {
+			t.Errorf("Expected %v, but got %v", want, s)
+		}
+	})
+
+	t.Run("TestNextPermutation_Duplicates", func(t *testing.T) {
+		s := []string{"a", "a", "b"}
+		count := 1
+		for NextPermutation(s) {
+			count++
+		}
+		if count != 3 {
+			t.Errorf("Expected %v, but got %v", 3, count)
+		}
+	})
+
+	t.Run("TestNextPermutation_Trivial", func(t *testing.T) {
+		if NextPermutation([]int{}) || NextPermutation([]int{1}) {
+			t.Errorf("Expected no next permutation")
+		}
+	})
+
+	t.Run("TestNextPermutationFunc_Descending", func(t *testing.T) {
+		s := []int{3, 2, 1}
+		greater := func(a, b int) bool { return a > b }
+		if !NextPermutationFunc(s, greater) {
+			t.Fatalf("Expected next permutation")
+		}
+		if want := []int{3, 1, 2}; !reflect.DeepEqual(s, want) {
+			t.Errorf("Expected %v, but got %v", want, s)
+		}
+	})
+}
+
+func TestCombinations(t *testing.T) {
+	testCases := []struct {
+		name  string
+		slice []string
+		k     int
+		want  [][]string
+	}{
+		{"Pairs", []string{"a", "b", "c", "d"}, 2, [][]string{{"a", "b"}, {"a", "c"}, {"a", "d"}, {"b", "c"}, {"b", "d"}, {"c", "d"}}},
+		{"All", []string{"a", "b"}, 2, [][]string{{"a", "b"}}},
+		{"Zero", []string{"a", "b"}, 0, [][]string{{}}},
+		{"TooLarge", []string{"a"}, 2, nil},
+		{"Negative", []string{"a"}, -1, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestCombinations_"+tc.name, func(t *testing.T) {
+			if got := Combinations(tc.slice, tc.k).Collect(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Expected %v, but got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	testCases := []struct {
+		name  string
+		slice []int
+		k     int
+		want  [][]int
+	}{
+		{"Pairs", []int{1, 2, 3}, 2, [][]int{{1, 1}, {1, 2}, {1, 3}, {2, 2}, {2, 3}, {3, 3}}},
+		{"LargerThanSlice", []int{1, 2}, 3, [][]int{{1, 1, 1}, {1, 1, 2}, {1, 2, 2}, {2, 2, 2}}},
+		{"Zero", []int{1}, 0, [][]int{{}}},
+		{"EmptySlice", nil, 2, nil},
+		{"Negative", []int{1}, -1, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestCombinationsWithReplacement_"+tc.name, func(t *testing.T) {
+			if got := CombinationsWithReplacement(tc.slice, tc.k).Collect(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Expected %v, but got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	got := PowerSet([]int{1, 2, 3}).Collect()
+	want := [][]int{{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, but got %v", want, got)
+	}
+
+	if got := PowerSet([]int(nil)).Collect(); !reflect.DeepEqual(got, [][]int{{}}) {
+		t.Errorf("Expected %v, but got %v", [][]int{{}}, got)
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	testCases := []struct {
+		name   string
+		slices [][]int
+		want   [][]int
+	}{
+		{"Two", [][]int{{1, 2}, {3, 4}}, [][]int{{1, 3}, {1, 4}, {2, 3}, {2, 4}}},
+		{"Three", [][]int{{1}, {2, 3}, {4}}, [][]int{{1, 2, 4}, {1, 3, 4}}},
+		{"WithEmpty", [][]int{{1, 2}, {}}, nil},
+		{"None", nil, [][]int{{}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestCartesianProduct_"+tc.name, func(t *testing.T) {
+			if got := CartesianProduct(tc.slices...).Collect(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Expected %v, but got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCartesianProductN(t *testing.T) {
+	t.Run("TestCartesianProduct2", func(t *testing.T) {
+		got := CartesianProduct2([]string{"linux", "darwin"}, []int{32, 64}).Collect()
+		want := []tupleext.Tuple[string, int]{{S1: "linux", S2: 32}, {S1: "linux", S2: 64}, {S1: "darwin", S2: 32}, {S1: "darwin", S2: 64}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, but got %v", want, got)
+		}
+	})
+
+	t.Run("TestCartesianProduct3", func(t *testing.T) {
+		got := CartesianProduct3([]string{"go"}, []bool{true, false}, []int{1}).Collect()
+		want := []tupleext.Tuple3[string, bool, int]{{S1: "go", S2: true, S3: 1}, {S1: "go", S2: false, S3: 1}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, but got %v", want, got)
+		}
+	})
+
+	t.Run("TestCartesianProduct4", func(t *testing.T) {
+		gen := CartesianProduct4([]int{1, 2}, []int{3}, []string{"a", "b"}, []float64{0.5})
+		count := 0
+		last := tupleext.Tuple4[int, int, string, float64]{}
+		for v, ok := gen.Next(); ok; v, ok = gen.Next() {
+			count++
+			last = v
+		}
+		want := tupleext.Tuple4[int, int, string, float64]{S1: 2, S2: 3, S3: "b", S4: 0.5}
+		if count != 4 || last != want {
+			t.Errorf("Expected 4 results ending with %v, but got %d ending with %v", want, count, last)
+		}
+	})
+
+	t.Run("TestCartesianProduct2_Empty", func(t *testing.T) {
+		if got := CartesianProduct2([]int{}, []int{1}).Collect(); got != nil {
+			t.Errorf("Expected nil, but got %v", got)
+		}
+	})
+}
+
+func TestNewGenerator(t *testing.T) {
+	calls := 0
+	gen := NewGenerator(func() (int, bool) {
+		calls++
+		return 4 - calls, calls <= 3
+	})
+	if got, want := gen.Collect(), []int{3, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, but got %v", want, got)
+	}
+	if _, ok := gen.Next(); ok || calls != 4 {
+		t.Errorf("Expected next not to be called after exhaustion, but got %d calls", calls)
+	}
+}
@@ -0,0 +1,141 @@
+package goexstat
+
+import (
+	"math"
+
+	"github.com/birdmichael/GoEx/constraintsext"
+)
+
+// Accumulator 使用 Welford 算法以流式方式统计数据，只占用常数内存，适合统计无法全部保存的数据流。
+//
+// 零值即可使用。Accumulator 不是并发安全的，多个 goroutine 可以各自统计后通过 Merge 合并。
+//
+// 示例：
+//
+//	var acc goexstat.Accumulator[float64]
+//	for _, latency := range latencies {
+//		acc.Add(latency)
+//	}
+//	fmt.Println(acc.Mean(), acc.StdDev())
+type Accumulator[T constraintsext.Number] struct {
+	count int
+	mean  float64
+	// m2 是与均值之差的平方和
+	m2 float64
+	// sum 与 compensation 用于 Neumaier 补偿求和
+	sum          float64
+	compensation float64
+	min          T
+	max          T
+}
+
+// Add 加入一个数据。
+func (a *Accumulator[T]) Add(value T) {
+	x := float64(value)
+	if a.count == 0 {
+		a.min, a.max = value, value
+	} else {
+		a.min = min(a.min, value)
+		a.max = max(a.max, value)
+	}
+
+	a.count++
+	delta := x - a.mean
+	a.mean += delta / float64(a.count)
+	a.m2 += delta * (x - a.mean)
+
+	t := a.sum + x
+	if math.Abs(a.sum) >= math.Abs(x) {
+		a.compensation += (a.sum - t) + x
+	} else {
+		a.compensation += (x - t) + a.sum
+	}
+	a.sum = t
+}
+
+// AddAll 依次加入多个数据。
+func (a *Accumulator[T]) AddAll(values ...T) {
+	for _, v := range values {
+		a.Add(v)
+	}
+}
+
+// Merge 将 other 统计的数据合并进来，结果与把两组数据加入同一个 Accumulator 相同（在浮点误差范围内）。
+func (a *Accumulator[T]) Merge(other *Accumulator[T]) {
+	if other.count == 0 {
+		return
+	}
+	if a.count == 0 {
+		*a = *other
+		return
+	}
+
+	n := float64(a.count + other.count)
+	delta := other.mean - a.mean
+	a.m2 += other.m2 + delta*delta*float64(a.count)*float64(other.count)/n
+	a.mean += delta * float64(other.count) / n
+	a.count += other.count
+	a.sum += other.sum
+	a.compensation += other.compensation
+	a.min = min(a.min, other.min)
+	a.max = max(a.max, other.max)
+}
+
+// Reset 清空已统计的数据。
+func (a *Accumulator[T]) Reset() {
+	*a = Accumulator[T]{}
+}
+
+// Count 返回已加入的数据个数。
+func (a *Accumulator[T]) Count() int {
+	return a.count
+}
+
+// Sum 返回所有数据的和。
+func (a *Accumulator[T]) Sum() float64 {
+	return a.sum + a.compensation
+}
+
+// Mean 返回平均值，没有数据时返回 NaN。
+func (a *Accumulator[T]) Mean() float64 {
+	if a.count == 0 {
+		return math.NaN()
+	}
+	return a.mean
+}
+
+// Variance 返回总体方差，没有数据时返回 NaN。
+func (a *Accumulator[T]) Variance() float64 {
+	if a.count == 0 {
+		return math.NaN()
+	}
+	return a.m2 / float64(a.count)
+}
+
+// SampleVariance 返回样本方差，数据少于 2 个时返回 NaN。
+func (a *Accumulator[T]) SampleVariance() float64 {
+	if a.count < 2 {
+		return math.NaN()
+	}
+	return a.m2 / float64(a.count-1)
+}
+
+// StdDev 返回总体标准差，没有数据时返回 NaN。
+func (a *Accumulator[T]) StdDev() float64 {
+	return math.Sqrt(a.Variance())
+}
+
+// SampleStdDev 返回样本标准差，数据少于 2 个时返回 NaN。
+func (a *Accumulator[T]) SampleStdDev() float64 {
+	return math.Sqrt(a.SampleVariance())
+}
+
+// Min 返回最小值，没有数据时 ok 为 false。
+func (a *Accumulator[T]) Min() (v T, ok bool) {
+	return a.min, a.count > 0
+}
+
+// Max 返回最大值，没有数据时 ok 为 false。
+func (a *Accumulator[T]) Max() (v T, ok bool) {
+	return a.max, a.count > 0
+}
@@ -0,0 +1,84 @@
+package goexstat
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAccumulator(t *testing.T) {
+	var acc Accumulator[int]
+
+	t.Run("TestAccumulator_Empty", func(t *testing.T) {
+		if acc.Count() != 0 || !math.IsNaN(acc.Mean()) || !math.IsNaN(acc.Variance()) {
+			t.Errorf("Expected empty accumulator")
+		}
+		if _, ok := acc.Min(); ok {
+			t.Errorf("Expected no minimum")
+		}
+	})
+
+	acc.AddAll(2, 4, 4, 4, 5, 5, 7, 9)
+
+	t.Run("TestAccumulator_Values", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			got  float64
+			want float64
+		}{
+			{"Count", float64(acc.Count()), 8},
+			{"Sum", acc.Sum(), 40},
+			{"Mean", acc.Mean(), 5},
+			{"Variance", acc.Variance(), 4},
+			{"StdDev", acc.StdDev(), 2},
+			{"SampleVariance", acc.SampleVariance(), 32.0 / 7},
+			{"SampleStdDev", acc.SampleStdDev(), math.Sqrt(32.0 / 7)},
+		}
+		for _, tc := range testCases {
+			if !almostEqual(tc.got, tc.want) {
+				t.Errorf("%s: Expected %v, but got %v", tc.name, tc.want, tc.got)
+			}
+		}
+		if v, ok := acc.Min(); !ok || v != 2 {
+			t.Errorf("Expected min %v, but got %v", 2, v)
+		}
+		if v, ok := acc.Max(); !ok || v != 9 {
+			t.Errorf("Expected max %v, but got %v", 9, v)
+		}
+	})
+
+	t.Run("TestAccumulator_Reset", func(t *testing.T) {
+		acc.Reset()
+		if acc.Count() != 0 {
+			t.Errorf("Expected %v, but got %v", 0, acc.Count())
+		}
+	})
+}
+
+func TestAccumulator_Merge(t *testing.T) {
+	values := []float64{1.5, -2, 3.25, 8, 0, 13, -7.5, 4}
+
+	var whole Accumulator[float64]
+	whole.AddAll(values...)
+
+	var left, right, empty Accumulator[float64]
+	left.AddAll(values[:3]...)
+	right.AddAll(values[3:]...)
+	left.Merge(&right)
+	left.Merge(&empty)
+
+	if left.Count() != whole.Count() || !almostEqual(left.Mean(), whole.Mean()) ||
+		!almostEqual(left.Variance(), whole.Variance()) || !almostEqual(left.Sum(), whole.Sum()) {
+		t.Errorf("Expected %+v, but got %+v", whole, left)
+	}
+	if v, _ := left.Min(); v != -7.5 {
+		t.Errorf("Expected %v, but got %v", -7.5, v)
+	}
+	if v, _ := left.Max(); v != 13 {
+		t.Errorf("Expected %v, but got %v", 13, v)
+	}
+
+	empty.Merge(&whole)
+	if empty.Count() != whole.Count() || !almostEqual(empty.Mean(), whole.Mean()) {
+		t.Errorf("Expected %+v, but got %+v", whole, empty)
+	}
+}
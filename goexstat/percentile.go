@@ -0,0 +1,197 @@
+package goexstat
+
+import (
+	"math"
+	"slices"
+
+	"github.com/birdmichael/GoEx/constraintsext"
+)
+
+// Interpolation 是百分位数落在两个数据点之间时的取值方法，与 NumPy 的 percentile 一致。
+type Interpolation int
+
+const (
+	// Linear 在相邻两个数据点之间线性插值，是最常用的方法，也是 Excel PERCENTILE.INC 的算法。
+	Linear Interpolation = iota
+	// Lower 取较小的数据点。
+	Lower
+	// Higher 取较大的数据点。
+	Higher
+	// Nearest 取最近的数据点，距离相同时取下标为偶数的数据点。
+	Nearest
+	// Midpoint 取相邻两个数据点的平均值。
+	Midpoint
+)
+
+// String 返回插值方法的名称。
+func (i Interpolation) String() string {
+	switch i {
+	case Linear:
+		return "linear"
+	case Lower:
+		return "lower"
+	case Higher:
+		return "higher"
+	case Nearest:
+		return "nearest"
+	case Midpoint:
+		return "midpoint"
+	default:
+		return "unknown"
+	}
+}
+
+func sorted[T constraintsext.Number](values []T) []float64 {
+	result := make([]float64, len(values))
+	for i, v := range values {
+		result[i] = float64(v)
+	}
+	slices.Sort(result)
+	return result
+}
+
+// percentileOf 计算已排序数据的第 p 百分位数。
+func percentileOf(sorted []float64, p float64, method Interpolation) float64 {
+	if len(sorted) == 0 || math.IsNaN(p) || p < 0 || p > 100 {
+		return math.NaN()
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	lo, hi := int(math.Floor(rank)), int(math.Ceil(rank))
+	switch method {
+	case Lower:
+		return sorted[lo]
+	case Higher:
+		return sorted[hi]
+	case Nearest:
+		return sorted[int(math.RoundToEven(rank))]
+	case Midpoint:
+		return (sorted[lo] + sorted[hi]) / 2
+	case Linear:
+		return sorted[lo] + (rank-float64(lo))*(sorted[hi]-sorted[lo])
+	default:
+		return math.NaN()
+	}
+}
+
+// Percentile 返回 values 的第 p 百分位数，values 不会被修改。
+//
+// 参数：
+//   - values: 数据，无需排序。
+//   - p: 百分位，取值范围 [0, 100]，例如 50 表示中位数、99 表示 P99。
+//   - method: 百分位落在两个数据点之间时的取值方法。
+//
+// 返回值：
+//   - float64: 百分位数；values 为空、p 超出范围或 method 无效时返回 NaN。
+//
+// 示例：
+//   - Percentile([]int{1, 2, 3, 4}, 50, Linear) 返回 2.5。
+//   - Percentile([]int{1, 2, 3, 4}, 50, Lower) 返回 2。
+func Percentile[T constraintsext.Number](values []T, p float64, method Interpolation) float64 {
+	return percentileOf(sorted(values), p, method)
+}
+
+// Percentiles 一次计算多个百分位数，只排序一次，适合同时计算 P50、P90、P99。
+//
+// 示例：
+//   - Percentiles(latencies, []float64{50, 90, 99}, Linear)
+func Percentiles[T constraintsext.Number](values []T, ps []float64, method Interpolation) []float64 {
+	data := sorted(values)
+	result := make([]float64, len(ps))
+	for i, p := range ps {
+		result[i] = percentileOf(data, p, method)
+	}
+	return result
+}
+
+// MARK: - Histogram
+
+// Bin 是直方图中的一个区间 [Lo, Hi)，最后一个区间包含 Hi。
+type Bin struct {
+	Lo    float64
+	Hi    float64
+	Count int
+}
+
+// Histogram 将 values 按最小值到最大值等宽地划分为 bins 个区间并统计每个区间的元素个数。
+//
+// NaN 与正负无穷无法归入等宽区间，会被忽略。所有元素都相等时只有一个宽度为 0 的区间包含它们；
+// 没有可统计的元素或 bins 小于 1 时返回 nil。
+//
+// 示例：
+//   - Histogram([]int{1, 2, 2, 3, 9}, 4) 返回 [{1 3 3} {3 5 1} {5 7 0} {7 9 1}]。
+func Histogram[T constraintsext.Number](values []T, bins int) []Bin {
+	if bins < 1 {
+		return nil
+	}
+	lo, hi, count := math.Inf(1), math.Inf(-1), 0
+	for _, v := range values {
+		if x := float64(v); isFinite(x) {
+			lo, hi = math.Min(lo, x), math.Max(hi, x)
+			count++
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	if lo == hi {
+		return []Bin{{Lo: lo, Hi: hi, Count: count}}
+	}
+
+	// 分别缩放两端再相减，避免 hi-lo 在 ±MaxFloat64 时溢出为无穷
+	width := hi/float64(bins) - lo/float64(bins)
+	result := make([]Bin, bins)
+	for i := range result {
+		result[i].Lo = lo + float64(i)*width
+		result[i].Hi = lo + float64(i+1)*width
+	}
+	result[bins-1].Hi = hi
+	span := hi/2 - lo/2
+	for _, v := range values {
+		x := float64(v)
+		if !isFinite(x) {
+			continue
+		}
+		index := int((x/2 - lo/2) / span * float64(bins))
+		result[max(min(index, bins-1), 0)].Count++
+	}
+	return result
+}
+
+func isFinite(x float64) bool {
+	return !math.IsNaN(x) && !math.IsInf(x, 0)
+}
+
+// HistogramEdges 按给定的区间边界统计 values，edges 必须升序排列，n 个边界构成 n-1 个区间。
+//
+// 区间为 [edges[i], edges[i+1])，最后一个区间包含右边界；落在所有区间之外的元素不被统计。
+// edges 少于 2 个时返回 nil。
+//
+// 示例：
+//   - HistogramEdges([]int{5, 15, 25, 100}, []float64{0, 10, 20, 30}) 返回 [{0 10 1} {10 20 1} {20 30 1}]。
+func HistogramEdges[T constraintsext.Number](values []T, edges []float64) []Bin {
+	if len(edges) < 2 {
+		return nil
+	}
+	result := make([]Bin, len(edges)-1)
+	for i := range result {
+		result[i].Lo, result[i].Hi = edges[i], edges[i+1]
+	}
+	last := edges[len(edges)-1]
+	for _, v := range values {
+		x := float64(v)
+		if x == last {
+			result[len(result)-1].Count++
+			continue
+		}
+		// 第一个大于 x 的边界的前一个区间
+		index, _ := slices.BinarySearch(edges, x)
+		if index < len(edges) && edges[index] == x {
+			index++
+		}
+		if index == 0 || index == len(edges) {
+			continue
+		}
+		result[index-1].Count++
+	}
+	return result
+}
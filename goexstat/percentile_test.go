@@ -0,0 +1,130 @@
+package goexstat
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestInterpolation_String(t *testing.T) {
+	testCases := []struct {
+		method Interpolation
+		want   string
+	}{
+		{Linear, "linear"},
+		{Lower, "lower"},
+		{Higher, "higher"},
+		{Nearest, "nearest"},
+		{Midpoint, "midpoint"},
+		{Interpolation(42), "unknown"},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.method.String(); got != tc.want {
+			t.Errorf("Expected %v, but got %v", tc.want, got)
+		}
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	values := []int{1, 2, 3, 4}
+	testCases := []struct {
+		name   string
+		p      float64
+		method Interpolation
+		want   float64
+	}{
+		{"LinearMedian", 50, Linear, 2.5},
+		{"Linear40", 40, Linear, 2.2},
+		{"Lower", 40, Lower, 2},
+		{"Higher", 40, Higher, 3},
+		{"Nearest", 40, Nearest, 2},
+		{"NearestTie", 50, Nearest, 3},
+		{"Midpoint", 40, Midpoint, 2.5},
+		{"Min", 0, Linear, 1},
+		{"Max", 100, Linear, 4},
+		{"Negative", -1, Linear, math.NaN()},
+		{"TooLarge", 101, Linear, math.NaN()},
+		{"InvalidMethod", 50, Interpolation(9), math.NaN()},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestPercentile_"+tc.name, func(t *testing.T) {
+			if got := Percentile(values, tc.p, tc.method); !almostEqual(got, tc.want) {
+				t.Errorf("Expected %v, but got %v", tc.want, got)
+			}
+		})
+	}
+
+	if got := Percentile([]float64{}, 50, Linear); !math.IsNaN(got) {
+		t.Errorf("Expected NaN, but got %v", got)
+	}
+}
+
+func TestPercentiles(t *testing.T) {
+	values := make([]int, 100)
+	for i := range values {
+		values[i] = 100 - i
+	}
+	got := Percentiles(values, []float64{50, 90, 99}, Lower)
+	if want := []float64{50, 90, 99}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, but got %v", want, got)
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	testCases := []struct {
+		name   string
+		values []int
+		bins   int
+		want   []Bin
+	}{
+		{"Basic", []int{1, 2, 2, 3, 9}, 4, []Bin{{1, 3, 3}, {3, 5, 1}, {5, 7, 0}, {7, 9, 1}}},
+		{"Single", []int{5, 5}, 3, []Bin{{5, 5, 2}}},
+		{"Empty", nil, 3, nil},
+		{"NoBins", []int{1}, 0, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestHistogram_"+tc.name, func(t *testing.T) {
+			if got := Histogram(tc.values, tc.bins); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Expected %v, but got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestHistogramNonFinite(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+	testCases := []struct {
+		name   string
+		values []float64
+		bins   int
+		want   []Bin
+	}{
+		{"NaN", []float64{1, nan, 3}, 2, []Bin{{1, 2, 1}, {2, 3, 1}}},
+		{"Inf", []float64{1, inf, -inf, 3}, 2, []Bin{{1, 2, 1}, {2, 3, 1}}},
+		{"OnlyNaN", []float64{nan, nan}, 2, nil},
+		{"SingleFinite", []float64{1, nan}, 2, []Bin{{1, 1, 1}}},
+		{"MaxFloat", []float64{-math.MaxFloat64, 0, math.MaxFloat64}, 2, []Bin{{-math.MaxFloat64, 0, 1}, {0, math.MaxFloat64, 2}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestHistogramNonFinite_"+tc.name, func(t *testing.T) {
+			if got := Histogram(tc.values, tc.bins); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Expected %v, but got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestHistogramEdges(t *testing.T) {
+	got := HistogramEdges([]float64{-1, 0, 5, 10, 15, 20, 25, 30, 100}, []float64{0, 10, 20, 30})
+	want := []Bin{{0, 10, 2}, {10, 20, 2}, {20, 30, 3}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, but got %v", want, got)
+	}
+	if got := HistogramEdges([]int{1}, []float64{0}); got != nil {
+		t.Errorf("Expected nil, but got %v", got)
+	}
+}
@@ -0,0 +1,163 @@
+package goexstat
+
+import (
+	"errors"
+	"math"
+	"slices"
+
+	"github.com/birdmichael/GoEx/constraintsext"
+	"github.com/birdmichael/GoEx/tupleext"
+)
+
+// ErrOverflow 表示整数求和的结果超出了类型的取值范围。
+var ErrOverflow = errors.New("goexstat: integer overflow")
+
+// MARK: - Sum
+
+// Sum 返回 values 中所有元素的和，values 为空时返回 0。
+//
+// 计算在类型 T 上进行：整数溢出时会回绕，需要检测溢出时请使用 SumChecked；
+// 浮点数大量累加时如果需要更高精度，请使用 Mean 或 Accumulator，它们使用补偿求和。
+//
+// 示例：
+//   - Sum([]int{1, 2, 3}) 返回 6。
+func Sum[T constraintsext.Number](values []T) T {
+	var sum T
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
+// SumChecked 返回 values 中所有整数的和，结果超出类型 T 的取值范围时返回 ErrOverflow。
+//
+// 示例：
+//   - SumChecked([]int8{100, 27}) 返回 127, nil。
+//   - SumChecked([]int8{100, 28}) 返回 0, ErrOverflow。
+func SumChecked[T constraintsext.Integer](values []T) (T, error) {
+	var sum T
+	for _, v := range values {
+		next := sum + v
+		if (v > 0 && next < sum) || (v < 0 && next > sum) {
+			return 0, ErrOverflow
+		}
+		sum = next
+	}
+	return sum, nil
+}
+
+// fsum 使用 Neumaier 补偿求和计算浮点数的和，减少大量累加时的舍入误差。
+func fsum[T constraintsext.Number](values []T) float64 {
+	var sum, compensation float64
+	for _, v := range values {
+		x := float64(v)
+		t := sum + x
+		if math.Abs(sum) >= math.Abs(x) {
+			compensation += (sum - t) + x
+		} else {
+			compensation += (x - t) + sum
+		}
+		sum = t
+	}
+	return sum + compensation
+}
+
+// MARK: - Central Tendency
+
+// Mean 返回 values 的算术平均值，values 为空时返回 NaN。
+//
+// 元素先转换为 float64 再使用补偿求和累加，因此整数求和不会溢出。
+//
+// 示例：
+//   - Mean([]int{1, 2, 3, 4}) 返回 2.5。
+func Mean[T constraintsext.Number](values []T) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	return fsum(values) / float64(len(values))
+}
+
+// Median 返回 values 的中位数，元素个数为偶数时返回中间两个数的平均值，values 为空时返回 NaN。
+//
+// values 不会被修改。
+//
+// 示例：
+//   - Median([]int{3, 1, 2}) 返回 2。
+//   - Median([]int{4, 1, 3, 2}) 返回 2.5。
+func Median[T constraintsext.Number](values []T) float64 {
+	return Percentile(values, 50, Linear)
+}
+
+// Mode 返回 values 中出现次数最多的元素，有多个时按升序全部返回，values 为空时返回 nil。
+//
+// 示例：
+//   - Mode([]int{1, 2, 2, 3}) 返回 [2]。
+//   - Mode([]int{3, 1, 3, 1, 2}) 返回 [1 3]。
+func Mode[T constraintsext.Number](values []T) []T {
+	counts := make(map[T]int, len(values))
+	best := 0
+	for _, v := range values {
+		counts[v]++
+		best = max(best, counts[v])
+	}
+	var modes []T
+	for v, count := range counts {
+		if count == best {
+			modes = append(modes, v)
+		}
+	}
+	slices.Sort(modes)
+	return modes
+}
+
+// MARK: - Dispersion
+
+// Variance 返回 values 的总体方差（除以 n），values 为空时返回 NaN。
+//
+// 示例：
+//   - Variance([]float64{2, 4, 4, 4, 5, 5, 7, 9}) 返回 4。
+func Variance[T constraintsext.Number](values []T) float64 {
+	var acc Accumulator[T]
+	acc.AddAll(values...)
+	return acc.Variance()
+}
+
+// SampleVariance 返回 values 的样本方差（除以 n-1），元素少于 2 个时返回 NaN。
+func SampleVariance[T constraintsext.Number](values []T) float64 {
+	var acc Accumulator[T]
+	acc.AddAll(values...)
+	return acc.SampleVariance()
+}
+
+// StdDev 返回 values 的总体标准差，values 为空时返回 NaN。
+//
+// 示例：
+//   - StdDev([]float64{2, 4, 4, 4, 5, 5, 7, 9}) 返回 2。
+func StdDev[T constraintsext.Number](values []T) float64 {
+	return math.Sqrt(Variance(values))
+}
+
+// SampleStdDev 返回 values 的样本标准差，元素少于 2 个时返回 NaN。
+func SampleStdDev[T constraintsext.Number](values []T) float64 {
+	return math.Sqrt(SampleVariance(values))
+}
+
+// MinMax 同时返回 values 中的最小值与最大值。
+//
+// 返回值：
+//   - tupleext.Tuple[T, T]: S1 为最小值，S2 为最大值。
+//   - bool: values 为空时返回 false。
+//
+// 示例：
+//   - MinMax([]int{3, 1, 2}) 返回 {1 3}, true。
+func MinMax[T constraintsext.Number](values []T) (tupleext.Tuple[T, T], bool) {
+	if len(values) == 0 {
+		return tupleext.Tuple[T, T]{}, false
+	}
+	result := tupleext.Tuple[T, T]{S1: values[0], S2: values[0]}
+	for _, v := range values[1:] {
+		result.S1 = min(result.S1, v)
+		result.S2 = max(result.S2, v)
+	}
+	return result, true
+}
@@ -0,0 +1,153 @@
+package goexstat
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/birdmichael/GoEx/tupleext"
+)
+
+func almostEqual(a, b float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.IsNaN(a) && math.IsNaN(b)
+	}
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestSum(t *testing.T) {
+	if got := Sum([]int{1, 2, 3}); got != 6 {
+		t.Errorf("Expected %v, but got %v", 6, got)
+	}
+	if got := Sum([]float64{0.5, 0.25}); got != 0.75 {
+		t.Errorf("Expected %v, but got %v", 0.75, got)
+	}
+	if got := Sum([]int(nil)); got != 0 {
+		t.Errorf("Expected %v, but got %v", 0, got)
+	}
+}
+
+func TestSumChecked(t *testing.T) {
+	testCases := []struct {
+		name   string
+		values []int8
+		want   int8
+		err    error
+	}{
+		{"Empty", nil, 0, nil},
+		{"Max", []int8{100, 27}, 127, nil},
+		{"Overflow", []int8{100, 28}, 0, ErrOverflow},
+		{"Min", []int8{-100, -28}, -128, nil},
+		{"Underflow", []int8{-100, -29}, 0, ErrOverflow},
+		{"RecoverAfterNegative", []int8{100, -50, 70}, 120, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestSumChecked_"+tc.name, func(t *testing.T) {
+			got, err := SumChecked(tc.values)
+			if got != tc.want || !errors.Is(err, tc.err) {
+				t.Errorf("Expected %v, %v, but got %v, %v", tc.want, tc.err, got, err)
+			}
+		})
+	}
+
+	t.Run("TestSumChecked_Unsigned", func(t *testing.T) {
+		if _, err := SumChecked([]uint8{200, 56}); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Expected %v, but got %v", ErrOverflow, err)
+		}
+		if got, err := SumChecked([]uint64{math.MaxUint64 - 1, 1}); err != nil || got != math.MaxUint64 {
+			t.Errorf("Expected %v, but got %v, %v", uint64(math.MaxUint64), got, err)
+		}
+	})
+}
+
+func TestMean(t *testing.T) {
+	testCases := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"Int", Mean([]int{1, 2, 3, 4}), 2.5},
+		{"Empty", Mean([]float64{}), math.NaN()},
+		{"NoIntegerOverflow", Mean([]int64{math.MaxInt64, math.MaxInt64}), math.MaxInt64},
+		{"Compensated", Mean([]float64{1e100, 1, -1e100, 1}), 0.5},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestMean_"+tc.name, func(t *testing.T) {
+			if !almostEqual(tc.got, tc.want) {
+				t.Errorf("Expected %v, but got %v", tc.want, tc.got)
+			}
+		})
+	}
+}
+
+func TestMedian(t *testing.T) {
+	values := []int{4, 1, 3, 2}
+	if got := Median(values); got != 2.5 {
+		t.Errorf("Expected %v, but got %v", 2.5, got)
+	}
+	if want := []int{4, 1, 3, 2}; !reflect.DeepEqual(values, want) {
+		t.Errorf("Expected input to be unchanged, but got %v", values)
+	}
+	if got := Median([]int{3, 1, 2}); got != 2 {
+		t.Errorf("Expected %v, but got %v", 2, got)
+	}
+	if got := Median([]int{}); !math.IsNaN(got) {
+		t.Errorf("Expected NaN, but got %v", got)
+	}
+}
+
+func TestMode(t *testing.T) {
+	testCases := []struct {
+		values []int
+		want   []int
+	}{
+		{nil, nil},
+		{[]int{1, 2, 2, 3}, []int{2}},
+		{[]int{3, 1, 3, 1, 2}, []int{1, 3}},
+		{[]int{5}, []int{5}},
+	}
+
+	for _, tc := range testCases {
+		if got := Mode(tc.values); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("Mode(%v): Expected %v, but got %v", tc.values, tc.want, got)
+		}
+	}
+}
+
+func TestVariance(t *testing.T) {
+	values := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+	testCases := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"Variance", Variance(values), 4},
+		{"StdDev", StdDev(values), 2},
+		{"SampleVariance", SampleVariance(values), 32.0 / 7},
+		{"SampleStdDev", SampleStdDev(values), math.Sqrt(32.0 / 7)},
+		{"VarianceEmpty", Variance([]int{}), math.NaN()},
+		{"SampleVarianceSingle", SampleVariance([]int{1}), math.NaN()},
+		{"LargeOffset", Variance([]float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}), 22.5},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestVariance_"+tc.name, func(t *testing.T) {
+			if !almostEqual(tc.got, tc.want) {
+				t.Errorf("Expected %v, but got %v", tc.want, tc.got)
+			}
+		})
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	got, ok := MinMax([]int{3, 1, 2})
+	if want := (tupleext.Tuple[int, int]{S1: 1, S2: 3}); !ok || got != want {
+		t.Errorf("Expected %v, but got %v (%t)", want, got, ok)
+	}
+	if _, ok := MinMax([]float64{}); ok {
+		t.Errorf("Expected no result for empty input")
+	}
+}
@@ -0,0 +1,174 @@
+package goexstring
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MARK: - Words
+
+type runeKind int
+
+const (
+	kindSeparator runeKind = iota
+	kindUpper
+	kindLower
+	kindDigit
+	kindMark
+)
+
+func kindOf(r rune) runeKind {
+	switch {
+	case unicode.IsUpper(r), unicode.IsTitle(r):
+		return kindUpper
+	case unicode.IsLetter(r):
+		// 小写字母以及中日韩文字等没有大小写之分的字母
+		return kindLower
+	case unicode.IsDigit(r):
+		return kindDigit
+	case unicode.In(r, unicode.Mn, unicode.Mc):
+		return kindMark
+	default:
+		return kindSeparator
+	}
+}
+
+// isApostrophe 判断 r 是否为撇号；撇号会被直接忽略，因此 "don't" 被视为一个单词。
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '’'
+}
+
+// Words 将标识符或自然语言文本拆分为单词，是各种大小写转换的基础。
+//
+// 拆分规则：
+//   - 非字母、非数字的字符（空格、下划线、连字符、标点等）作为分隔符，撇号被忽略。
+//   - 小写字母后跟大写字母时拆分，例如 "fooBar" -> ["foo", "Bar"]。
+//   - 连续大写字母视为缩写，在最后一个大写字母后跟小写字母时拆分，例如 "HTTPServer" -> ["HTTP", "Server"]。
+//   - 数字附着在前一个单词上，数字后跟大写字母时拆分，例如 "Int64Value" -> ["Int64", "Value"]。
+//   - 组合音标附着在前一个字符上，没有大小写之分的文字（如中文）按小写字母处理。
+//
+// 示例：
+//   - Words("XMLHttpRequest") 返回 ["XML", "Http", "Request"]。
+//   - Words("user_id, don't-panic") 返回 ["user", "id", "dont", "panic"]。
+func Words(s string) []string {
+	var (
+		words   []string
+		current strings.Builder
+		prev    = kindSeparator
+	)
+	flush := func() {
+		if current.Len() > 0 {
+			words = append(words, current.String())
+			current.Reset()
+		}
+	}
+
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		if isApostrophe(r) {
+			continue
+		}
+
+		kind := kindOf(r)
+		switch kind {
+		case kindSeparator:
+			flush()
+			prev = kindSeparator
+			continue
+		case kindMark:
+			if prev == kindSeparator {
+				continue
+			}
+			current.WriteRune(r)
+			continue
+		case kindUpper:
+			switch prev {
+			case kindLower, kindDigit:
+				flush()
+			case kindUpper:
+				if next, _ := utf8.DecodeRuneInString(s[i:]); kindOf(next) == kindLower {
+					flush()
+				}
+			}
+		}
+		current.WriteRune(r)
+		prev = kind
+	}
+	flush()
+	return words
+}
+
+// MARK: - Case Conversion
+
+// capitalize 将单词的首字母转为标题大小写，其余字母转为小写。
+func capitalize(word string) string {
+	r, size := utf8.DecodeRuneInString(word)
+	return string(unicode.ToTitle(r)) + strings.ToLower(word[size:])
+}
+
+// CamelCase 将 s 转换为小驼峰形式。
+//
+// 缩写会按普通单词处理，以保证结果可以再次被 Words 正确拆分。
+//
+// 示例：
+//   - CamelCase("HTTPServer") 返回 "httpServer"。
+//   - CamelCase("user_id") 返回 "userId"。
+func CamelCase(s string) string {
+	words := Words(s)
+	for i, word := range words {
+		if i == 0 {
+			words[i] = strings.ToLower(word)
+		} else {
+			words[i] = capitalize(word)
+		}
+	}
+	return strings.Join(words, "")
+}
+
+// PascalCase 将 s 转换为大驼峰形式。
+//
+// 示例：
+//   - PascalCase("http_server") 返回 "HttpServer"。
+//   - PascalCase("école normale") 返回 "ÉcoleNormale"。
+func PascalCase(s string) string {
+	words := Words(s)
+	for i, word := range words {
+		words[i] = capitalize(word)
+	}
+	return strings.Join(words, "")
+}
+
+// SnakeCase 将 s 转换为小写下划线形式。
+//
+// 示例：
+//   - SnakeCase("HTTPServer") 返回 "http_server"。
+//   - SnakeCase("Int64Value") 返回 "int64_value"。
+func SnakeCase(s string) string {
+	return joinWords(s, "_", strings.ToLower)
+}
+
+// KebabCase 将 s 转换为小写连字符形式。
+//
+// 示例：
+//   - KebabCase("backgroundColor") 返回 "background-color"。
+func KebabCase(s string) string {
+	return joinWords(s, "-", strings.ToLower)
+}
+
+// ScreamingCase 将 s 转换为大写下划线形式，常用于常量与环境变量名。
+//
+// 示例：
+//   - ScreamingCase("maxRetryCount") 返回 "MAX_RETRY_COUNT"。
+func ScreamingCase(s string) string {
+	return joinWords(s, "_", strings.ToUpper)
+}
+
+func joinWords(s, separator string, transform func(string) string) string {
+	words := Words(s)
+	for i, word := range words {
+		words[i] = transform(word)
+	}
+	return strings.Join(words, separator)
+}
@@ -0,0 +1,81 @@
+package goexstring
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWords(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want []string
+	}{
+		{"", nil},
+		{"  _-  ", nil},
+		{"fooBar", []string{"foo", "Bar"}},
+		{"HTTPServer", []string{"HTTP", "Server"}},
+		{"XMLHttpRequest", []string{"XML", "Http", "Request"}},
+		{"userID", []string{"user", "ID"}},
+		{"Int64Value", []string{"Int64", "Value"}},
+		{"HTTP2Server", []string{"HTTP2", "Server"}},
+		{"utf8decode", []string{"utf8decode"}},
+		{"user_id, don't-panic", []string{"user", "id", "dont", "panic"}},
+		{"SCREAMING_CASE", []string{"SCREAMING", "CASE"}},
+		{"ÉcoleNormale", []string{"École", "Normale"}},
+		{"用户Name", []string{"用户", "Name"}},
+		{"école", []string{"école"}},
+	}
+
+	for _, tc := range testCases {
+		if got := Words(tc.s); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("Words(%q): Expected %q, but got %q", tc.s, tc.want, got)
+		}
+	}
+}
+
+func TestCaseConversion(t *testing.T) {
+	testCases := []struct {
+		s         string
+		camel     string
+		pascal    string
+		snake     string
+		kebab     string
+		screaming string
+	}{
+		{"HTTPServer", "httpServer", "HttpServer", "http_server", "http-server", "HTTP_SERVER"},
+		{"user_id", "userId", "UserId", "user_id", "user-id", "USER_ID"},
+		{"background-color", "backgroundColor", "BackgroundColor", "background_color", "background-color", "BACKGROUND_COLOR"},
+		{"MAX_RETRY_COUNT", "maxRetryCount", "MaxRetryCount", "max_retry_count", "max-retry-count", "MAX_RETRY_COUNT"},
+		{"Int64Value", "int64Value", "Int64Value", "int64_value", "int64-value", "INT64_VALUE"},
+		{"école normale", "écoleNormale", "ÉcoleNormale", "école_normale", "école-normale", "ÉCOLE_NORMALE"},
+		{"", "", "", "", "", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestCaseConversion_"+tc.s, func(t *testing.T) {
+			if got := CamelCase(tc.s); got != tc.camel {
+				t.Errorf("CamelCase: Expected %q, but got %q", tc.camel, got)
+			}
+			if got := PascalCase(tc.s); got != tc.pascal {
+				t.Errorf("PascalCase: Expected %q, but got %q", tc.pascal, got)
+			}
+			if got := SnakeCase(tc.s); got != tc.snake {
+				t.Errorf("SnakeCase: Expected %q, but got %q", tc.snake, got)
+			}
+			if got := KebabCase(tc.s); got != tc.kebab {
+				t.Errorf("KebabCase: Expected %q, but got %q", tc.kebab, got)
+			}
+			if got := ScreamingCase(tc.s); got != tc.screaming {
+				t.Errorf("ScreamingCase: Expected %q, but got %q", tc.screaming, got)
+			}
+		})
+	}
+}
+
+func TestCaseConversion_RoundTrip(t *testing.T) {
+	for _, s := range []string{"httpServerURL", "parse_json_v2", "XMLHttpRequest"} {
+		if got := SnakeCase(CamelCase(s)); got != SnakeCase(s) {
+			t.Errorf("Expected %q, but got %q", SnakeCase(s), got)
+		}
+	}
+}
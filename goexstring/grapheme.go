@@ -0,0 +1,218 @@
+package goexstring
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// 本文件按 Unicode 标准附件 #29（UAX #29）的扩展字素簇规则划分字符，
+// 只依赖标准库的 unicode 表，Extended_Pictographic 等标准库没有提供的属性使用近似的码点区间。
+
+type graphemeClass int
+
+const (
+	gcOther graphemeClass = iota
+	gcCR
+	gcLF
+	gcControl
+	gcExtend
+	gcZWJ
+	gcRegionalIndicator
+	gcSpacingMark
+	gcL
+	gcV
+	gcT
+	gcLV
+	gcLVT
+)
+
+var (
+	// extendExtra 是标准库的 Mn、Me 之外同样属于 Grapheme_Extend 的字符。
+	extendExtra = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x200c, Hi: 0x200c, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x1f3fb, Hi: 0x1f3ff, Stride: 1}, // 肤色修饰符
+			{Lo: 0xe0020, Hi: 0xe007f, Stride: 1}, // 标签字符，用于子区域旗帜
+		},
+	}
+
+	// pictographic 近似 Extended_Pictographic 属性，覆盖常见的 emoji 与符号区间。
+	pictographic = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x00a9, Hi: 0x00a9, Stride: 1},
+			{Lo: 0x00ae, Hi: 0x00ae, Stride: 1},
+			{Lo: 0x203c, Hi: 0x203c, Stride: 1},
+			{Lo: 0x2049, Hi: 0x2049, Stride: 1},
+			{Lo: 0x2122, Hi: 0x2122, Stride: 1},
+			{Lo: 0x2139, Hi: 0x2139, Stride: 1},
+			{Lo: 0x2194, Hi: 0x21aa, Stride: 1},
+			{Lo: 0x231a, Hi: 0x23ff, Stride: 1},
+			{Lo: 0x24c2, Hi: 0x24c2, Stride: 1},
+			{Lo: 0x25aa, Hi: 0x25fe, Stride: 1},
+			{Lo: 0x2600, Hi: 0x27bf, Stride: 1},
+			{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+			{Lo: 0x2b05, Hi: 0x2b55, Stride: 1},
+			{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+			{Lo: 0x303d, Hi: 0x303d, Stride: 1},
+			{Lo: 0x3297, Hi: 0x3297, Stride: 1},
+			{Lo: 0x3299, Hi: 0x3299, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x1f000, Hi: 0x1f0ff, Stride: 1},
+			{Lo: 0x1f10d, Hi: 0x1f1e5, Stride: 1},
+			{Lo: 0x1f200, Hi: 0x1f3fa, Stride: 1},
+			{Lo: 0x1f400, Hi: 0x1faff, Stride: 1},
+			{Lo: 0x1fc00, Hi: 0x1fffd, Stride: 1},
+		},
+	}
+)
+
+func classify(r rune) graphemeClass {
+	switch {
+	case r == '\r':
+		return gcCR
+	case r == '\n':
+		return gcLF
+	case r == 0x200d:
+		return gcZWJ
+	case r >= 0x1f1e6 && r <= 0x1f1ff:
+		return gcRegionalIndicator
+	case unicode.In(r, unicode.Mn, unicode.Me, extendExtra):
+		return gcExtend
+	case unicode.Is(unicode.Mc, r):
+		return gcSpacingMark
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Zl, unicode.Zp):
+		return gcControl
+	case r >= 0x1100 && r <= 0x115f, r >= 0xa960 && r <= 0xa97c:
+		return gcL
+	case r >= 0x1160 && r <= 0x11a7, r >= 0xd7b0 && r <= 0xd7c6:
+		return gcV
+	case r >= 0x11a8 && r <= 0x11ff, r >= 0xd7cb && r <= 0xd7fb:
+		return gcT
+	case r >= 0xac00 && r <= 0xd7a3:
+		if (r-0xac00)%28 == 0 {
+			return gcLV
+		}
+		return gcLVT
+	default:
+		return gcOther
+	}
+}
+
+// segmenter 记录判断字素簇边界所需的状态。
+type segmenter struct {
+	prev graphemeClass
+	// regionalIndicators 是当前字素簇末尾连续的区域指示符个数
+	regionalIndicators int
+	// pictSequence 表示当前字素簇以 Extended_Pictographic Extend* 结尾
+	pictSequence bool
+	// pictZWJ 表示当前字素簇以 Extended_Pictographic Extend* ZWJ 结尾
+	pictZWJ bool
+}
+
+// isBoundary 判断在已读入的字符与 r 之间是否存在字素簇边界，并更新状态。
+func (s *segmenter) isBoundary(r rune, class graphemeClass) bool {
+	boundary := s.boundary(r, class)
+
+	pict := unicode.Is(pictographic, r)
+	switch {
+	case boundary:
+		s.regionalIndicators = 0
+		s.pictSequence = pict
+		s.pictZWJ = false
+	case class == gcExtend:
+		s.pictZWJ = false
+	case class == gcZWJ:
+		s.pictZWJ = s.pictSequence
+		s.pictSequence = false
+	default:
+		s.pictSequence = pict
+		s.pictZWJ = false
+	}
+	if class == gcRegionalIndicator {
+		s.regionalIndicators++
+	} else {
+		s.regionalIndicators = 0
+	}
+	s.prev = class
+	return boundary
+}
+
+func (s *segmenter) boundary(r rune, class graphemeClass) bool {
+	prev := s.prev
+	switch {
+	case prev == gcCR && class == gcLF: // GB3
+		return false
+	case prev == gcCR || prev == gcLF || prev == gcControl: // GB4
+		return true
+	case class == gcCR || class == gcLF || class == gcControl: // GB5
+		return true
+	case prev == gcL && (class == gcL || class == gcV || class == gcLV || class == gcLVT): // GB6
+		return false
+	case (prev == gcLV || prev == gcV) && (class == gcV || class == gcT): // GB7
+		return false
+	case (prev == gcLVT || prev == gcT) && class == gcT: // GB8
+		return false
+	case class == gcExtend || class == gcZWJ || class == gcSpacingMark: // GB9, GB9a
+		return false
+	case s.pictZWJ && unicode.Is(pictographic, r): // GB11
+		return false
+	case class == gcRegionalIndicator && s.regionalIndicators%2 == 1: // GB12, GB13
+		return false
+	default: // GB999
+		return true
+	}
+}
+
+// nextGrapheme 返回 s 中的第一个字素簇及其字节长度。
+func nextGrapheme(s string) (cluster string, size int) {
+	if s == "" {
+		return "", 0
+	}
+	r, n := utf8.DecodeRuneInString(s)
+	seg := &segmenter{}
+	seg.isBoundary(r, classify(r))
+	size = n
+	for size < len(s) {
+		r, n = utf8.DecodeRuneInString(s[size:])
+		if seg.isBoundary(r, classify(r)) {
+			break
+		}
+		size += n
+	}
+	return s[:size], size
+}
+
+// Graphemes 将 s 划分为用户感知的字符（扩展字素簇），相当于 Swift 中 String 的 Character 序列。
+//
+// 一个字素簇可能由多个码点组成，例如带有组合音标的字母、旗帜、带肤色或由 ZWJ 连接的 emoji。
+//
+// 示例：
+//   - Graphemes("é👍🏽🇨🇳") 返回 ["é", "👍🏽", "🇨🇳"]。
+func Graphemes(s string) []string {
+	var result []string
+	for len(s) > 0 {
+		cluster, size := nextGrapheme(s)
+		result = append(result, cluster)
+		s = s[size:]
+	}
+	return result
+}
+
+// RangeGraphemes 依次遍历 s 中的字素簇，回调返回 false 时停止遍历。
+//
+// 参数：
+//   - s: 要遍历的字符串。
+//   - fn: 接受字素簇的序号、字素簇及其在 s 中的起始字节位置。
+func RangeGraphemes(s string, fn func(index int, cluster string, offset int) bool) {
+	offset := 0
+	for i := 0; offset < len(s); i++ {
+		cluster, size := nextGrapheme(s[offset:])
+		if !fn(i, cluster, offset) {
+			return
+		}
+		offset += size
+	}
+}
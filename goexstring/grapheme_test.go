@@ -0,0 +1,53 @@
+package goexstring
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGraphemes(t *testing.T) {
+	testCases := []struct {
+		name string
+		s    string
+		want []string
+	}{
+		{"Empty", "", nil},
+		{"ASCII", "abc", []string{"a", "b", "c"}},
+		{"CRLF", "a\r\nb", []string{"a", "\r\n", "b"}},
+		{"Control", "\t\u0301", []string{"\t", "\u0301"}},
+		{"CombiningMark", "e\u0301x", []string{"e\u0301", "x"}},
+		{"SpacingMark", "\u0915\u093f", []string{"\u0915\u093f"}},
+		{"Hangul", "\u1100\u1161\u11a8한", []string{"\u1100\u1161\u11a8", "한"}},
+		{"Flags", "🇨🇳🇯🇵🇺", []string{"🇨🇳", "🇯🇵", "🇺"}},
+		{"SkinTone", "👍🏽👍", []string{"👍🏽", "👍"}},
+		{"ZWJSequence", "👨\u200d👩\u200d👧!", []string{"👨\u200d👩\u200d👧", "!"}},
+		{"ZWJWithoutPictographic", "a\u200d😀", []string{"a\u200d", "😀"}},
+		{"VariationSelector", "❤\ufe0fx", []string{"❤\ufe0f", "x"}},
+		{"TagSequence", "🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f中", []string{"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", "中"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestGraphemes_"+tc.name, func(t *testing.T) {
+			if got := Graphemes(tc.s); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Expected %q, but got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRangeGraphemes(t *testing.T) {
+	var offsets []int
+	var clusters []string
+	RangeGraphemes("a😀e\u0301", func(index int, cluster string, offset int) bool {
+		offsets = append(offsets, offset)
+		clusters = append(clusters, cluster)
+		return index < 1
+	})
+
+	if want := []int{0, 1}; !reflect.DeepEqual(offsets, want) {
+		t.Errorf("Expected %v, but got %v", want, offsets)
+	}
+	if want := []string{"a", "😀"}; !reflect.DeepEqual(clusters, want) {
+		t.Errorf("Expected %q, but got %q", want, clusters)
+	}
+}
@@ -0,0 +1,294 @@
+package goexstring
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/birdmichael/GoEx/tupleext"
+)
+
+// 本文件中的算法都按 Unicode 码点比较字符串，而不是按字节。
+
+// Similarity 计算两个字符串的相似度，返回值在 [0, 1] 之间，1 表示完全相同。
+type Similarity func(a, b string) float64
+
+// MARK: - Edit Distance
+
+// Levenshtein 返回 a 与 b 之间的编辑距离，即把 a 变为 b 所需的最少插入、删除、替换次数。
+//
+// 示例：
+//   - Levenshtein("kitten", "sitting") 返回 3。
+//   - Levenshtein("你好", "您好") 返回 1。
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) < len(rb) {
+		ra, rb = rb, ra
+	}
+	row := make([]int, len(rb)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		diagonal := row[0]
+		row[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			diagonal, row[j] = row[j], min(row[j]+1, row[j-1]+1, diagonal+cost)
+		}
+	}
+	return row[len(rb)]
+}
+
+// LevenshteinSimilarity 将编辑距离归一化为相似度：1 - 距离 / 较长字符串的长度。
+func LevenshteinSimilarity(a, b string) float64 {
+	longest := max(utf8.RuneCountInString(a), utf8.RuneCountInString(b))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(Levenshtein(a, b))/float64(longest)
+}
+
+// DamerauLevenshtein 返回 a 与 b 之间的 Damerau-Levenshtein 距离。
+//
+// 与 Levenshtein 相比，相邻两个字符的交换只算一次编辑，适合纠正键盘输入错误。
+// 这里实现的是不受限的版本，交换后的字符之间仍然允许继续编辑。
+//
+// 示例：
+//   - DamerauLevenshtein("ca", "ac") 返回 1，而 Levenshtein 返回 2。
+//   - DamerauLevenshtein("ca", "abc") 返回 2。
+func DamerauLevenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	infinity := len(ra) + len(rb)
+	// lastRow 记录每个字符在 a 中最后出现的行号
+	lastRow := make(map[rune]int)
+
+	d := make([][]int, len(ra)+2)
+	for i := range d {
+		d[i] = make([]int, len(rb)+2)
+	}
+	d[0][0] = infinity
+	for i := 0; i <= len(ra); i++ {
+		d[i+1][0] = infinity
+		d[i+1][1] = i
+	}
+	for j := 0; j <= len(rb); j++ {
+		d[0][j+1] = infinity
+		d[1][j+1] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		// lastCol 记录本行中最后一次匹配的列号
+		lastCol := 0
+		for j := 1; j <= len(rb); j++ {
+			k := lastRow[rb[j-1]]
+			l := lastCol
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+				lastCol = j
+			}
+			d[i+1][j+1] = min(
+				d[i][j]+cost,
+				d[i+1][j]+1,
+				d[i][j+1]+1,
+				d[k][l]+(i-k-1)+1+(j-l-1),
+			)
+		}
+		lastRow[ra[i-1]] = i
+	}
+	return d[len(ra)+1][len(rb)+1]
+}
+
+// MARK: - Jaro-Winkler
+
+// Jaro 返回 a 与 b 的 Jaro 相似度。
+func Jaro(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 && len(rb) == 0 {
+		return 1
+	}
+	if len(ra) == 0 || len(rb) == 0 {
+		return 0
+	}
+
+	window := max(max(len(ra), len(rb))/2-1, 0)
+	matchedA := make([]bool, len(ra))
+	matchedB := make([]bool, len(rb))
+	matches := 0
+	for i, r := range ra {
+		for j := max(0, i-window); j < min(len(rb), i+window+1); j++ {
+			if !matchedB[j] && rb[j] == r {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions, j := 0, 0
+	for i, r := range ra {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if r != rb[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	return (m/float64(len(ra)) + m/float64(len(rb)) + (m-float64(transpositions)/2)/m) / 3
+}
+
+// JaroWinkler 返回 a 与 b 的 Jaro-Winkler 相似度。
+//
+// 在 Jaro 相似度的基础上，对最多 4 个字符的公共前缀给予额外加分（缩放因子 0.1），
+// 适合比较人名、短词等开头更重要的字符串。
+//
+// 示例：
+//   - JaroWinkler("MARTHA", "MARHTA") 约为 0.961。
+func JaroWinkler(a, b string) float64 {
+	jaro := Jaro(a, b)
+	ra, rb := []rune(a), []rune(b)
+	prefix := 0
+	for prefix < min(len(ra), len(rb), 4) && ra[prefix] == rb[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
+
+// MARK: - Subsequence & N-gram
+
+// LongestCommonSubsequence 返回 a 与 b 的最长公共子序列（字符不必连续），存在多个时返回其中之一。
+//
+// 示例：
+//   - LongestCommonSubsequence("ABCBDAB", "BDCABA") 返回 "BDAB"。
+func LongestCommonSubsequence(a, b string) string {
+	ra, rb := []rune(a), []rune(b)
+	// lengths[i][j] 是 ra[i:] 与 rb[j:] 的最长公共子序列长度
+	lengths := make([][]int, len(ra)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(rb)+1)
+	}
+	for i := len(ra) - 1; i >= 0; i-- {
+		for j := len(rb) - 1; j >= 0; j-- {
+			if ra[i] == rb[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	result := make([]rune, 0, lengths[0][0])
+	for i, j := 0, 0; i < len(ra) && j < len(rb); {
+		switch {
+		case ra[i] == rb[j]:
+			result = append(result, ra[i])
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return string(result)
+}
+
+// ngrams 返回 s 中长度为 n 的码点片段集合；s 短于 n 时整个字符串作为唯一的片段。
+func ngrams(s string, n int) map[string]struct{} {
+	runes := []rune(s)
+	set := make(map[string]struct{})
+	if len(runes) == 0 {
+		return set
+	}
+	if len(runes) < n {
+		set[s] = struct{}{}
+		return set
+	}
+	for i := 0; i+n <= len(runes); i++ {
+		set[string(runes[i:i+n])] = struct{}{}
+	}
+	return set
+}
+
+// NGramJaccard 返回 a 与 b 的 n-gram 集合的 Jaccard 相似度：交集大小 / 并集大小。
+//
+// 参数：
+//   - a, b: 要比较的字符串。
+//   - n: 片段长度，常用 2（bigram）或 3（trigram），小于 1 时按 1 处理。
+//
+// 示例：
+//   - NGramJaccard("night", "nacht", 2) 返回 1/7：两者只共享 "ht"。
+func NGramJaccard(a, b string, n int) float64 {
+	n = max(n, 1)
+	setA, setB := ngrams(a, n), ngrams(b, n)
+	if len(setA) == 0 && len(setB) == 0 {
+		return 1
+	}
+	intersection := 0
+	for gram := range setA {
+		if _, ok := setB[gram]; ok {
+			intersection++
+		}
+	}
+	return float64(intersection) / float64(len(setA)+len(setB)-intersection)
+}
+
+// MARK: - FuzzyFind
+
+// FuzzyFind 在 candidates 中查找与 query 相似的字符串，按相似度从高到低返回。
+//
+// 比较时忽略大小写，使用 JaroWinkler 计算相似度；完全包含 query 的候选项至少得到 0.9 分，
+// 以便 "gopher" 这类前缀、子串输入也能找到较长的候选项。
+//
+// 参数：
+//   - query: 查询字符串。
+//   - candidates: 候选字符串。
+//   - threshold: 最低相似度，低于该值的候选项被过滤掉。
+//
+// 返回值：
+//   - []tupleext.Tuple[string, float64]: S1 为候选字符串，S2 为相似度；相似度相同时保持 candidates 中的顺序。
+//
+// 示例：
+//   - FuzzyFind("aple", []string{"apple", "maple", "banana"}, 0.8) 返回 [{apple 0.946…} {maple 0.933…}]。
+func FuzzyFind(query string, candidates []string, threshold float64) []tupleext.Tuple[string, float64] {
+	lowerQuery := strings.ToLower(query)
+	return FuzzyFindFunc(query, candidates, threshold, func(query, candidate string) float64 {
+		candidate = strings.ToLower(candidate)
+		score := JaroWinkler(lowerQuery, candidate)
+		if lowerQuery != "" && strings.Contains(candidate, lowerQuery) {
+			score = max(score, 0.9)
+		}
+		return score
+	})
+}
+
+// FuzzyFindFunc 与 FuzzyFind 相同，但使用 similarity 计算 query 与每个候选项的相似度。
+//
+// 示例：
+//   - FuzzyFindFunc("kitten", names, 0.5, goexstring.LevenshteinSimilarity)。
+func FuzzyFindFunc(query string, candidates []string, threshold float64, similarity Similarity) []tupleext.Tuple[string, float64] {
+	var result []tupleext.Tuple[string, float64]
+	for _, candidate := range candidates {
+		if score := similarity(query, candidate); score >= threshold {
+			result = append(result, tupleext.Tuple[string, float64]{S1: candidate, S2: score})
+		}
+	}
+	slices.SortStableFunc(result, func(a, b tupleext.Tuple[string, float64]) int {
+		return cmp.Compare(b.S2, a.S2)
+	})
+	return result
+}
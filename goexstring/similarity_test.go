@@ -0,0 +1,173 @@
+package goexstring
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/birdmichael/GoEx/tupleext"
+)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-3
+}
+
+func TestLevenshtein(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"你好", "您好", 1},
+		{"ca", "ac", 2},
+	}
+
+	for _, tc := range testCases {
+		if got := Levenshtein(tc.a, tc.b); got != tc.want {
+			t.Errorf("Levenshtein(%q, %q): Expected %d, but got %d", tc.a, tc.b, tc.want, got)
+		}
+	}
+}
+
+func TestLevenshteinSimilarity(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want float64
+	}{
+		{"", "", 1},
+		{"abc", "abc", 1},
+		{"abc", "xyz", 0},
+		{"kitten", "sitting", 1 - 3.0/7},
+	}
+
+	for _, tc := range testCases {
+		if got := LevenshteinSimilarity(tc.a, tc.b); !almostEqual(got, tc.want) {
+			t.Errorf("LevenshteinSimilarity(%q, %q): Expected %v, but got %v", tc.a, tc.b, tc.want, got)
+		}
+	}
+}
+
+func TestDamerauLevenshtein(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"ca", "ac", 1},
+		{"ca", "abc", 2},
+		{"kitten", "sitting", 3},
+		{"teh", "the", 1},
+		{"abcdef", "badcfe", 3},
+	}
+
+	for _, tc := range testCases {
+		if got := DamerauLevenshtein(tc.a, tc.b); got != tc.want {
+			t.Errorf("DamerauLevenshtein(%q, %q): Expected %d, but got %d", tc.a, tc.b, tc.want, got)
+		}
+	}
+}
+
+func TestJaroWinkler(t *testing.T) {
+	testCases := []struct {
+		a, b        string
+		jaro, jaroW float64
+	}{
+		{"", "", 1, 1},
+		{"abc", "", 0, 0},
+		{"abc", "xyz", 0, 0},
+		{"MARTHA", "MARHTA", 0.944, 0.961},
+		{"DWAYNE", "DUANE", 0.822, 0.840},
+		{"DIXON", "DICKSONX", 0.767, 0.813},
+	}
+
+	for _, tc := range testCases {
+		if got := Jaro(tc.a, tc.b); !almostEqual(got, tc.jaro) {
+			t.Errorf("Jaro(%q, %q): Expected %v, but got %v", tc.a, tc.b, tc.jaro, got)
+		}
+		if got := JaroWinkler(tc.a, tc.b); !almostEqual(got, tc.jaroW) {
+			t.Errorf("JaroWinkler(%q, %q): Expected %v, but got %v", tc.a, tc.b, tc.jaroW, got)
+		}
+	}
+}
+
+func TestLongestCommonSubsequence(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want string
+	}{
+		{"", "abc", ""},
+		{"abc", "def", ""},
+		{"ABCBDAB", "BDCABA", "BDAB"},
+		{"AGGTAB", "GXTXAYB", "GTAB"},
+		{"我爱北京天安门", "我在北京", "我北京"},
+	}
+
+	for _, tc := range testCases {
+		if got := LongestCommonSubsequence(tc.a, tc.b); got != tc.want {
+			t.Errorf("LongestCommonSubsequence(%q, %q): Expected %q, but got %q", tc.a, tc.b, tc.want, got)
+		}
+	}
+}
+
+func TestNGramJaccard(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		n    int
+		want float64
+	}{
+		{"", "", 2, 1},
+		{"abc", "", 2, 0},
+		{"night", "nacht", 2, 1.0 / 7},
+		{"abc", "abc", 3, 1},
+		{"a", "a", 2, 1},
+		{"abc", "bcd", 0, 0.5},
+	}
+
+	for _, tc := range testCases {
+		if got := NGramJaccard(tc.a, tc.b, tc.n); !almostEqual(got, tc.want) {
+			t.Errorf("NGramJaccard(%q, %q, %d): Expected %v, but got %v", tc.a, tc.b, tc.n, tc.want, got)
+		}
+	}
+}
+
+func TestFuzzyFind(t *testing.T) {
+	candidates := []string{"banana", "Maple", "apple", "pineapple", "grape"}
+
+	t.Run("TestFuzzyFind_Ranked", func(t *testing.T) {
+		got := FuzzyFind("aple", candidates, 0.8)
+		var names []string
+		for _, item := range got {
+			names = append(names, item.S1)
+		}
+		if want := []string{"apple", "Maple"}; !reflect.DeepEqual(names, want) {
+			t.Errorf("Expected %v, but got %v", want, names)
+		}
+	})
+
+	t.Run("TestFuzzyFind_Substring", func(t *testing.T) {
+		got := FuzzyFind("APPLE", candidates, 0.9)
+		want := []tupleext.Tuple[string, float64]{{S1: "apple", S2: 1}, {S1: "pineapple", S2: 0.9}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, but got %v", want, got)
+		}
+	})
+
+	t.Run("TestFuzzyFind_NoMatch", func(t *testing.T) {
+		if got := FuzzyFind("zzz", candidates, 0.5); len(got) != 0 {
+			t.Errorf("Expected no result, but got %v", got)
+		}
+	})
+
+	t.Run("TestFuzzyFindFunc_StableTies", func(t *testing.T) {
+		got := FuzzyFindFunc("x", []string{"b", "a", "c"}, 0, func(string, string) float64 { return 0.5 })
+		want := []tupleext.Tuple[string, float64]{{S1: "b", S2: 0.5}, {S1: "a", S2: 0.5}, {S1: "c", S2: 0.5}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, but got %v", want, got)
+		}
+	})
+}
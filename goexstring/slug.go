@@ -0,0 +1,143 @@
+package goexstring
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// transliterationGroups 列出小写字母到 ASCII 的近似转写，大写形式在初始化时自动生成。
+var transliterationGroups = []struct {
+	letters string
+	ascii   string
+}{
+	// 拉丁字母（含汉语拼音声调）
+	{"àáâãäåāăąǎ", "a"}, {"æǽ", "ae"}, {"çćĉċč", "c"}, {"ďđð", "d"},
+	{"èéêëēĕėęě", "e"}, {"ĝğġģ", "g"}, {"ĥħ", "h"}, {"ìíîïĩīĭįıǐ", "i"},
+	{"ĳ", "ij"}, {"ĵ", "j"}, {"ķĸ", "k"}, {"ĺļľŀł", "l"}, {"ñńņňŉŋ", "n"},
+	{"òóôõöøōŏőǒ", "o"}, {"œ", "oe"}, {"ŕŗř", "r"}, {"śŝşšș", "s"}, {"ß", "ss"},
+	{"ţťŧț", "t"}, {"þ", "th"}, {"ùúûüũūŭůűųǔǖǘǚǜ", "u"}, {"ŵ", "w"},
+	{"ýÿŷ", "y"}, {"źżž", "z"},
+	// 希腊字母
+	{"αά", "a"}, {"β", "v"}, {"γ", "g"}, {"δ", "d"}, {"εέ", "e"}, {"ζ", "z"},
+	{"ηή", "i"}, {"θ", "th"}, {"ιίϊΐ", "i"}, {"κ", "k"}, {"λ", "l"}, {"μ", "m"},
+	{"ν", "n"}, {"ξ", "x"}, {"οό", "o"}, {"π", "p"}, {"ρ", "r"}, {"σς", "s"},
+	{"τ", "t"}, {"υύϋΰ", "y"}, {"φ", "f"}, {"χ", "ch"}, {"ψ", "ps"}, {"ωώ", "o"},
+	// 西里尔字母
+	{"а", "a"}, {"б", "b"}, {"в", "v"}, {"гґ", "g"}, {"д", "d"}, {"еэє", "e"},
+	{"ё", "yo"}, {"ж", "zh"}, {"з", "z"}, {"иіы", "i"}, {"ї", "yi"}, {"й", "y"},
+	{"к", "k"}, {"л", "l"}, {"м", "m"}, {"н", "n"}, {"о", "o"}, {"п", "p"},
+	{"р", "r"}, {"с", "s"}, {"т", "t"}, {"у", "u"}, {"ф", "f"}, {"х", "kh"},
+	{"ц", "ts"}, {"ч", "ch"}, {"ш", "sh"}, {"щ", "shch"}, {"ъь", ""},
+	{"ю", "yu"}, {"я", "ya"},
+}
+
+var transliterations = buildTransliterations()
+
+func buildTransliterations() map[rune]string {
+	table := make(map[rune]string)
+	for _, group := range transliterationGroups {
+		for _, r := range group.letters {
+			table[r] = group.ascii
+		}
+	}
+	for _, group := range transliterationGroups {
+		for _, r := range group.letters {
+			upper := unicode.ToUpper(r)
+			if _, exists := table[upper]; exists || upper == r || upper < utf8.RuneSelf {
+				continue
+			}
+			if group.ascii == "" {
+				table[upper] = ""
+			} else {
+				table[upper] = strings.ToUpper(group.ascii[:1]) + group.ascii[1:]
+			}
+		}
+	}
+	return table
+}
+
+// transliterateRune 返回 r 的 ASCII 近似形式，ok 为 false 表示 r 没有已知的转写。
+func transliterateRune(r rune) (ascii string, ok bool) {
+	switch {
+	case r < utf8.RuneSelf:
+		return string(r), true
+	case unicode.In(r, unicode.Mn, unicode.Me):
+		// 组合音标直接去掉，例如 "é" -> "e"
+		return "", true
+	case unicode.IsSpace(r):
+		return " ", true
+	}
+	ascii, ok = transliterations[r]
+	return ascii, ok
+}
+
+// Transliterate 将 s 转写为 ASCII 近似形式。
+//
+// 支持带变音符号的拉丁字母（包括汉语拼音声调）、希腊字母与西里尔字母，组合音标会被去掉，
+// 没有已知转写的字符（例如汉字）会被删除。
+//
+// 示例：
+//   - Transliterate("Crème Brûlée") 返回 "Creme Brulee"。
+//   - Transliterate("Москва") 返回 "Moskva"。
+func Transliterate(s string) string {
+	var builder strings.Builder
+	builder.Grow(len(s))
+	for _, r := range s {
+		if ascii, ok := transliterateRune(r); ok {
+			builder.WriteString(ascii)
+		}
+	}
+	return builder.String()
+}
+
+// Slugify 将 s 转换为适合用于 URL 的小写 ASCII 短名，单词之间以连字符连接。
+//
+// 字符会先按 Transliterate 的规则转写；没有已知转写的字母和数字（例如汉字）不会被丢弃，
+// 而是以小写十六进制码点作为独立的单词输出，从而保证不同的中文标题得到不同的短名。
+//
+// 示例：
+//   - Slugify("Hello, World!") 返回 "hello-world"。
+//   - Slugify("Ça va très bien") 返回 "ca-va-tres-bien"。
+//   - Slugify("Go 语言") 返回 "go-8bed-8a00"。
+func Slugify(s string) string {
+	var builder strings.Builder
+	pendingSeparator := false
+	write := func(part string) {
+		for i := 0; i < len(part); i++ {
+			c := part[i]
+			switch {
+			case 'a' <= c && c <= 'z', '0' <= c && c <= '9':
+			case 'A' <= c && c <= 'Z':
+				c += 'a' - 'A'
+			default:
+				pendingSeparator = true
+				continue
+			}
+			if pendingSeparator && builder.Len() > 0 {
+				builder.WriteByte('-')
+			}
+			pendingSeparator = false
+			builder.WriteByte(c)
+		}
+	}
+
+	for _, r := range s {
+		if isApostrophe(r) {
+			continue
+		}
+		if ascii, ok := transliterateRune(r); ok {
+			write(ascii)
+			continue
+		}
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			pendingSeparator = true
+			write(strconv.FormatInt(int64(r), 16))
+			pendingSeparator = true
+			continue
+		}
+		pendingSeparator = true
+	}
+	return builder.String()
+}
@@ -0,0 +1,48 @@
+package goexstring
+
+import "testing"
+
+func TestTransliterate(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want string
+	}{
+		{"Crème Brûlée", "Creme Brulee"},
+		{"Straße", "Strasse"},
+		{"Ægir Œuvre", "Aegir Oeuvre"},
+		{"Москва", "Moskva"},
+		{"Αθήνα", "Athina"},
+		{"Nǚ hái", "Nu hai"},
+		{"é", "e"},
+		{"Go 语言", "Go "},
+	}
+
+	for _, tc := range testCases {
+		if got := Transliterate(tc.s); got != tc.want {
+			t.Errorf("Transliterate(%q): Expected %q, but got %q", tc.s, tc.want, got)
+		}
+	}
+}
+
+func TestSlugify(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want string
+	}{
+		{"", ""},
+		{"Hello, World!", "hello-world"},
+		{"  --Hello,   World!--  ", "hello-world"},
+		{"Ça va très bien", "ca-va-tres-bien"},
+		{"Don't Panic", "dont-panic"},
+		{"Привет мир", "privet-mir"},
+		{"Go 语言", "go-8bed-8a00"},
+		{"语言2024", "8bed-8a00-2024"},
+		{"👍 Like", "like"},
+	}
+
+	for _, tc := range testCases {
+		if got := Slugify(tc.s); got != tc.want {
+			t.Errorf("Slugify(%q): Expected %q, but got %q", tc.s, tc.want, got)
+		}
+	}
+}
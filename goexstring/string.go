@@ -0,0 +1,312 @@
+package goexstring
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// MARK: - Character
+
+// Length 返回 s 中用户感知的字符（扩展字素簇）个数，相当于 Swift 中的 String.count。
+//
+// 与 utf8.RuneCountInString 不同，带组合音标的字母、旗帜与 emoji 序列都只算作一个字符。
+//
+// 示例：
+//   - Length("he\u0301llo") 返回 5，即使 é 由 e 和 U+0301 两个码点组成。
+//   - Length("👨‍👩‍👧🇨🇳") 返回 2。
+func Length(s string) int {
+	count := 0
+	for len(s) > 0 {
+		_, size := nextGrapheme(s)
+		s = s[size:]
+		count++
+	}
+	return count
+}
+
+// CharacterAt 返回 s 中第 index 个字符。
+//
+// 参数：
+//   - s: 原字符串。
+//   - index: 字符序号，从 0 开始。
+//
+// 返回值：
+//   - string: 对应的字素簇。
+//   - bool: index 越界时返回 false。
+func CharacterAt(s string, index int) (string, bool) {
+	if index < 0 {
+		return "", false
+	}
+	for i := 0; len(s) > 0; i++ {
+		cluster, size := nextGrapheme(s)
+		if i == index {
+			return cluster, true
+		}
+		s = s[size:]
+	}
+	return "", false
+}
+
+// byteOffset 返回第 index 个字符在 s 中的起始字节位置，index 超出字符个数时返回 len(s)。
+func byteOffset(s string, index int) int {
+	offset := 0
+	for i := 0; i < index && offset < len(s); i++ {
+		_, size := nextGrapheme(s[offset:])
+		offset += size
+	}
+	return offset
+}
+
+// Substring 按字符位置截取 [start, end) 区间的子串。
+//
+// 越界的位置会被限制在 [0, Length(s)] 内，start 不小于 end 时返回空字符串，因此不会像按字节切片那样切断多字节字符。
+//
+// 示例：
+//   - Substring("你好，世界", 3, 5) 返回 "世界"。
+//   - Substring("🇨🇳🇯🇵🇺🇸", 1, 10) 返回 "🇯🇵🇺🇸"。
+func Substring(s string, start, end int) string {
+	start = max(start, 0)
+	if start >= end {
+		return ""
+	}
+	from := byteOffset(s, start)
+	to := from + byteOffset(s[from:], end-start)
+	return s[from:to]
+}
+
+// Prefix 返回 s 的前 n 个字符，n 超过字符个数时返回 s 本身。
+func Prefix(s string, n int) string {
+	return s[:byteOffset(s, max(n, 0))]
+}
+
+// Suffix 返回 s 的最后 n 个字符，n 超过字符个数时返回 s 本身。
+func Suffix(s string, n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return s[byteOffset(s, Length(s)-n):]
+}
+
+// Reverse 按字符反转 s，组合音标与 emoji 序列保持完整。
+//
+// 示例：
+//   - Reverse("noe\u0308l") 返回 "le\u0308on"，而不是把组合音标移到 l 上。
+func Reverse(s string) string {
+	graphemes := Graphemes(s)
+	var builder strings.Builder
+	builder.Grow(len(s))
+	for i := len(graphemes) - 1; i >= 0; i-- {
+		builder.WriteString(graphemes[i])
+	}
+	return builder.String()
+}
+
+// MARK: - Truncate
+
+// Truncate 将 s 截断为最多 n 个字符，发生截断时以 ellipsis 结尾，结果的字符数（含 ellipsis）不超过 n。
+//
+// 参数：
+//   - s: 原字符串。
+//   - n: 允许的最大字符数。
+//   - ellipsis: 截断时追加的省略符，例如 "…" 或 "..."；n 不足以容纳 ellipsis 时直接截断。
+//
+// 示例：
+//   - Truncate("Hello, 世界", 8, "…") 返回 "Hello, 世界"。
+//   - Truncate("Hello, 世界", 6, "…") 返回 "Hello…"。
+func Truncate(s string, n int, ellipsis string) string {
+	if n <= 0 {
+		return ""
+	}
+	if Length(s) <= n {
+		return s
+	}
+	keep := n - Length(ellipsis)
+	if keep < 0 {
+		return Prefix(s, n)
+	}
+	return Prefix(s, keep) + ellipsis
+}
+
+// TruncateWidth 将 s 截断为最多占 width 列显示宽度，发生截断时以 ellipsis 结尾，结果的宽度（含 ellipsis）不超过 width。
+//
+// 宽字符不会被拆开，因此结果可能比 width 少一列。
+//
+// 示例：
+//   - TruncateWidth("你好世界", 7, "…") 返回 "你好世…"。
+func TruncateWidth(s string, width int, ellipsis string) string {
+	if width <= 0 {
+		return ""
+	}
+	if Width(s) <= width {
+		return s
+	}
+	limit := width - Width(ellipsis)
+	if limit < 0 {
+		limit, ellipsis = width, ""
+	}
+	return s[:widthOffset(s, limit)] + ellipsis
+}
+
+// widthOffset 返回 s 中显示宽度不超过 width 的最长前缀的字节长度。
+func widthOffset(s string, width int) int {
+	offset, used := 0, 0
+	for offset < len(s) {
+		cluster, size := nextGrapheme(s[offset:])
+		w := graphemeWidth(cluster)
+		if used+w > width {
+			break
+		}
+		used += w
+		offset += size
+	}
+	return offset
+}
+
+// MARK: - Pad
+
+// PadLeft 在 s 左侧填充 pad，使其显示宽度达到 width。
+//
+// 宽度按终端显示列数计算，中文与 emoji 占两列。pad 为空时使用空格；pad 中的字符循环使用，
+// 剩余不足一个 pad 字符宽度的列用空格补齐。s 的宽度已达到 width 时原样返回。
+//
+// 示例：
+//   - PadLeft("42", 5, "0") 返回 "00042"。
+//   - PadLeft("中文", 6, "") 返回 "  中文"。
+func PadLeft(s string, width int, pad string) string {
+	return padding(width-Width(s), pad) + s
+}
+
+// PadRight 在 s 右侧填充 pad，使其显示宽度达到 width，规则与 PadLeft 相同。
+//
+// 示例：
+//   - PadRight("名称", 8, ".") 返回 "名称...."。
+func PadRight(s string, width int, pad string) string {
+	return s + padding(width-Width(s), pad)
+}
+
+// PadCenter 在 s 两侧填充 pad，使其显示宽度达到 width；无法平分时右侧多填充一列。
+func PadCenter(s string, width int, pad string) string {
+	total := width - Width(s)
+	if total <= 0 {
+		return s
+	}
+	return padding(total/2, pad) + s + padding(total-total/2, pad)
+}
+
+// padding 生成显示宽度恰好为 width 的填充字符串。
+func padding(width int, pad string) string {
+	if width <= 0 {
+		return ""
+	}
+	units := Graphemes(pad)
+	var builder strings.Builder
+	used := 0
+	for i := 0; len(units) > 0; i++ {
+		unit := units[i%len(units)]
+		w := graphemeWidth(unit)
+		if w == 0 || used+w > width {
+			break
+		}
+		builder.WriteString(unit)
+		used += w
+	}
+	builder.WriteString(strings.Repeat(" ", width-used))
+	return builder.String()
+}
+
+// MARK: - Prefix & Suffix
+
+// HasPrefixFold 判断 s 是否以 prefix 开头，比较时忽略大小写（Unicode 简单大小写折叠）。
+//
+// 示例：
+//   - HasPrefixFold("GoEx", "goe") 返回 true。
+func HasPrefixFold(s, prefix string) bool {
+	for prefix != "" {
+		if s == "" {
+			return false
+		}
+		r1, n1 := utf8.DecodeRuneInString(s)
+		r2, n2 := utf8.DecodeRuneInString(prefix)
+		if !strings.EqualFold(string(r1), string(r2)) {
+			return false
+		}
+		s, prefix = s[n1:], prefix[n2:]
+	}
+	return true
+}
+
+// HasSuffixFold 判断 s 是否以 suffix 结尾，比较时忽略大小写（Unicode 简单大小写折叠）。
+//
+// 示例：
+//   - HasSuffixFold("photo.JPG", ".jpg") 返回 true。
+func HasSuffixFold(s, suffix string) bool {
+	for suffix != "" {
+		if s == "" {
+			return false
+		}
+		r1, n1 := utf8.DecodeLastRuneInString(s)
+		r2, n2 := utf8.DecodeLastRuneInString(suffix)
+		if !strings.EqualFold(string(r1), string(r2)) {
+			return false
+		}
+		s, suffix = s[:len(s)-n1], suffix[:len(suffix)-n2]
+	}
+	return true
+}
+
+// HasAnyPrefix 判断 s 是否以 prefixes 中的任意一个开头。
+func HasAnyPrefix(s string, prefixes ...string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAnySuffix 判断 s 是否以 suffixes 中的任意一个结尾。
+func HasAnySuffix(s string, suffixes ...string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(s, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasCharacterPrefix 按字符判断 s 是否以 prefix 开头，相当于 Swift 中的 String.hasPrefix。
+//
+// 与 strings.HasPrefix 不同，prefix 必须在字符边界处结束，因此不会匹配字符的一部分。
+//
+// 示例：
+//   - strings.HasPrefix("é", "e") 为 true（é 由 e 和 U+0301 组成），而 HasCharacterPrefix("é", "e") 为 false。
+//   - HasCharacterPrefix("👍🏽好", "👍") 返回 false。
+func HasCharacterPrefix(s, prefix string) bool {
+	if !strings.HasPrefix(s, prefix) {
+		return false
+	}
+	offset := 0
+	for offset < len(prefix) {
+		_, size := nextGrapheme(s[offset:])
+		offset += size
+	}
+	return offset == len(prefix)
+}
+
+// HasCharacterSuffix 按字符判断 s 是否以 suffix 结尾，suffix 必须在字符边界处开始。
+//
+// 示例：
+//   - HasCharacterSuffix("🇨🇳🇯🇵", "🇯🇵") 返回 true。
+//   - HasCharacterSuffix("👨‍👩‍👧", "👧") 返回 false。
+func HasCharacterSuffix(s, suffix string) bool {
+	if !strings.HasSuffix(s, suffix) {
+		return false
+	}
+	start := len(s) - len(suffix)
+	offset := 0
+	for offset < start {
+		_, size := nextGrapheme(s[offset:])
+		offset += size
+	}
+	return offset == start
+}
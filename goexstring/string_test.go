@@ -0,0 +1,207 @@
+package goexstring
+
+import "testing"
+
+func TestLength(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want int
+	}{
+		{"", 0},
+		{"hello", 5},
+		{"he\u0301llo", 5},
+		{"你好，世界", 5},
+		{"👨\u200d👩\u200d👧🇨🇳", 2},
+	}
+
+	for _, tc := range testCases {
+		if got := Length(tc.s); got != tc.want {
+			t.Errorf("Length(%q): Expected %d, but got %d", tc.s, tc.want, got)
+		}
+	}
+}
+
+func TestCharacterAt(t *testing.T) {
+	t.Run("TestCharacterAt_InRange", func(t *testing.T) {
+		got, ok := CharacterAt("a👍🏽b", 1)
+		if !ok || got != "👍🏽" {
+			t.Errorf("Expected %q, but got %q (%t)", "👍🏽", got, ok)
+		}
+	})
+
+	t.Run("TestCharacterAt_OutOfRange", func(t *testing.T) {
+		for _, index := range []int{-1, 3} {
+			if got, ok := CharacterAt("a👍🏽b", index); ok {
+				t.Errorf("Expected no character at %d, but got %q", index, got)
+			}
+		}
+	})
+}
+
+func TestSubstring(t *testing.T) {
+	testCases := []struct {
+		s          string
+		start, end int
+		want       string
+	}{
+		{"你好，世界", 3, 5, "世界"},
+		{"你好，世界", 0, 2, "你好"},
+		{"🇨🇳🇯🇵🇺🇸", 1, 10, "🇯🇵🇺🇸"},
+		{"🇨🇳🇯🇵🇺🇸", -3, 1, "🇨🇳"},
+		{"cafe\u0301!", 3, 4, "e\u0301"},
+		{"abc", 2, 1, ""},
+		{"abc", 5, 8, ""},
+	}
+
+	for _, tc := range testCases {
+		if got := Substring(tc.s, tc.start, tc.end); got != tc.want {
+			t.Errorf("Substring(%q, %d, %d): Expected %q, but got %q", tc.s, tc.start, tc.end, tc.want, got)
+		}
+	}
+}
+
+func TestPrefixSuffix(t *testing.T) {
+	s := "a👍🏽中"
+	testCases := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"Prefix", Prefix(s, 2), "a👍🏽"},
+		{"PrefixOverflow", Prefix(s, 10), s},
+		{"PrefixNegative", Prefix(s, -1), ""},
+		{"Suffix", Suffix(s, 2), "👍🏽中"},
+		{"SuffixOverflow", Suffix(s, 10), s},
+		{"SuffixZero", Suffix(s, 0), ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestPrefixSuffix_"+tc.name, func(t *testing.T) {
+			if tc.got != tc.want {
+				t.Errorf("Expected %q, but got %q", tc.want, tc.got)
+			}
+		})
+	}
+}
+
+func TestReverse(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want string
+	}{
+		{"", ""},
+		{"abc", "cba"},
+		{"noe\u0308l", "le\u0308on"},
+		{"👍🏽好🇨🇳", "🇨🇳好👍🏽"},
+	}
+
+	for _, tc := range testCases {
+		if got := Reverse(tc.s); got != tc.want {
+			t.Errorf("Reverse(%q): Expected %q, but got %q", tc.s, tc.want, got)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	testCases := []struct {
+		s        string
+		n        int
+		ellipsis string
+		want     string
+	}{
+		{"Hello, 世界", 9, "…", "Hello, 世界"},
+		{"Hello, 世界", 6, "…", "Hello…"},
+		{"Hello, 世界", 6, "...", "Hel..."},
+		{"Hello", 2, "...", "He"},
+		{"Hello", 0, "…", ""},
+		{"👨\u200d👩\u200d👧👍🏽🇨🇳", 2, "…", "👨\u200d👩\u200d👧…"},
+	}
+
+	for _, tc := range testCases {
+		if got := Truncate(tc.s, tc.n, tc.ellipsis); got != tc.want {
+			t.Errorf("Truncate(%q, %d, %q): Expected %q, but got %q", tc.s, tc.n, tc.ellipsis, tc.want, got)
+		}
+	}
+}
+
+func TestTruncateWidth(t *testing.T) {
+	testCases := []struct {
+		s        string
+		width    int
+		ellipsis string
+		want     string
+	}{
+		{"你好世界", 8, "…", "你好世界"},
+		{"你好世界", 7, "…", "你好世…"},
+		{"你好世界", 6, "…", "你好…"},
+		{"你好世界", 6, "", "你好世"},
+		{"ab你好", 3, "...", "..."},
+		{"ab你好", 1, "...", "a"},
+		{"ab你好", 0, "…", ""},
+	}
+
+	for _, tc := range testCases {
+		if got := TruncateWidth(tc.s, tc.width, tc.ellipsis); got != tc.want {
+			t.Errorf("TruncateWidth(%q, %d, %q): Expected %q, but got %q", tc.s, tc.width, tc.ellipsis, tc.want, got)
+		}
+	}
+}
+
+func TestPad(t *testing.T) {
+	testCases := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"LeftZero", PadLeft("42", 5, "0"), "00042"},
+		{"LeftWide", PadLeft("中文", 6, ""), "  中文"},
+		{"LeftNoop", PadLeft("中文", 3, "*"), "中文"},
+		{"RightDots", PadRight("名称", 8, "."), "名称...."},
+		{"RightCycle", PadRight("a", 6, "-="), "a-=-=-"},
+		{"RightWidePad", PadRight("a", 4, "中"), "a中 "},
+		{"Center", PadCenter("中", 7, "*"), "**中***"},
+		{"CenterNoop", PadCenter("abc", 2, "*"), "abc"},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestPad_"+tc.name, func(t *testing.T) {
+			if tc.got != tc.want {
+				t.Errorf("Expected %q, but got %q", tc.want, tc.got)
+			}
+		})
+	}
+}
+
+func TestHasPrefixSuffix(t *testing.T) {
+	testCases := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"PrefixFold", HasPrefixFold("GoEx", "goe"), true},
+		{"PrefixFoldUnicode", HasPrefixFold("ΣΊΣΥΦΟΣ", "σίσ"), true},
+		{"PrefixFoldLonger", HasPrefixFold("Go", "gopher"), false},
+		{"PrefixFoldMismatch", HasPrefixFold("GoEx", "gx"), false},
+		{"SuffixFold", HasSuffixFold("photo.JPG", ".jpg"), true},
+		{"SuffixFoldMismatch", HasSuffixFold("photo.png", ".jpg"), false},
+		{"AnyPrefix", HasAnyPrefix("https://example.com", "http://", "https://"), true},
+		{"AnyPrefixNone", HasAnyPrefix("ftp://example.com", "http://", "https://"), false},
+		{"AnySuffix", HasAnySuffix("main.go", ".md", ".go"), true},
+		{"AnySuffixEmpty", HasAnySuffix("main.go"), false},
+		{"CharacterPrefix", HasCharacterPrefix("e\u0301te\u0301", "e\u0301"), true},
+		{"CharacterPrefixPartial", HasCharacterPrefix("e\u0301", "e"), false},
+		{"CharacterPrefixEmoji", HasCharacterPrefix("👍🏽好", "👍"), false},
+		{"CharacterPrefixEmpty", HasCharacterPrefix("abc", ""), true},
+		{"CharacterSuffix", HasCharacterSuffix("🇨🇳🇯🇵", "🇯🇵"), true},
+		{"CharacterSuffixPartial", HasCharacterSuffix("👨\u200d👩\u200d👧", "👧"), false},
+		{"CharacterSuffixMismatch", HasCharacterSuffix("abc", "b"), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run("TestHasPrefixSuffix_"+tc.name, func(t *testing.T) {
+			if tc.got != tc.want {
+				t.Errorf("Expected %t, but got %t", tc.want, tc.got)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package goexstring
+
+import "testing"
+
+func TestRuneWidth(t *testing.T) {
+	testCases := []struct {
+		r    rune
+		want int
+	}{
+		{'a', 1},
+		{'\n', 0},
+		{'\u0301', 0},
+		{'\u200d', 0},
+		{'中', 2},
+		{'あ', 2},
+		{'한', 2},
+		{'Ａ', 2},
+		{'ｱ', 1},
+		{'😀', 2},
+		{'é', 1},
+		{'Ω', 1},
+	}
+
+	for _, tc := range testCases {
+		if got := RuneWidth(tc.r); got != tc.want {
+			t.Errorf("RuneWidth(%q): Expected %d, but got %d", tc.r, tc.want, got)
+		}
+	}
+}
+
+func TestWidth(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want int
+	}{
+		{"", 0},
+		{"hello", 5},
+		{"Go语言", 6},
+		{"é", 1},
+		{"👨\u200d👩\u200d👧", 2},
+		{"👍🏽", 2},
+		{"🇨🇳", 2},
+		{"❤", 1},
+		{"❤\ufe0f", 2},
+		{"\u1100\u1161\u11a8", 2},
+	}
+
+	for _, tc := range testCases {
+		if got := Width(tc.s); got != tc.want {
+			t.Errorf("Width(%q): Expected %d, but got %d", tc.s, tc.want, got)
+		}
+	}
+}
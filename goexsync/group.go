@@ -0,0 +1,205 @@
+package goexsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+// ErrorMode 决定 Group 中的任务出错后如何处理其余任务。
+type ErrorMode int
+
+const (
+	// FailFast 在第一个任务出错后取消 Group 的上下文，尚未开始的任务不再执行。
+	FailFast ErrorMode = iota
+	// CollectAll 让所有任务执行完毕，并收集全部错误。
+	CollectAll
+)
+
+// GroupOptions 是创建 Group 时的配置项，所有字段均可省略。
+type GroupOptions struct {
+	// Limit 是同时运行的最大任务数，小于等于 0 表示不限制。
+	Limit int
+	// Mode 是错误处理模式，默认为 FailFast。
+	Mode ErrorMode
+	// TaskTimeout 是单个任务的超时时间，小于等于 0 表示不限制。
+	TaskTimeout time.Duration
+}
+
+// TaskError 记录出错任务的序号与原始错误。
+type TaskError struct {
+	Index int   // 任务按调用 Go 的顺序从 0 开始的序号
+	Err   error // 任务返回的错误
+}
+
+// Error 实现 error 接口。
+func (e *TaskError) Error() string {
+	return fmt.Sprintf("task %d: %v", e.Index, e.Err)
+}
+
+// Unwrap 返回任务的原始错误，便于使用 errors.Is 与 errors.As。
+func (e *TaskError) Unwrap() error {
+	return e.Err
+}
+
+// PanicError 表示任务发生了 panic。
+type PanicError struct {
+	Value any    // recover 得到的值
+	Stack []byte // 发生 panic 时的调用栈
+}
+
+// Error 实现 error 接口，包含 panic 的值与调用栈。
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v\n\n%s", e.Value, e.Stack)
+}
+
+// Group 并发运行一组返回结果的任务，并按提交顺序收集结果。
+//
+// 与 errgroup.Group 相比，Group 会收集每个任务的结果，支持 FailFast 与 CollectAll 两种错误模式，
+// 将任务中的 panic 转换为 *PanicError，并支持单个任务的超时。
+//
+// 参数：
+//   - T: 任务结果的类型。
+type Group[T any] struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	opts   GroupOptions
+	sem    chan struct{}
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	results []T
+	errs    []error
+}
+
+// NewGroup 创建一个 Group。
+//
+// 参数：
+//   - ctx: 父上下文，取消后尚未开始的任务不再执行。
+//   - opts: 配置项。
+//
+// 返回值：
+//   - 新的 Group。
+//   - 派生出的上下文，在 FailFast 模式下第一个任务出错时或 Wait 返回后被取消。
+//
+// 示例：
+//
+//	g, ctx := NewGroup[string](ctx, GroupOptions{Limit: 4})
+//	for _, url := range urls {
+//		url := url
+//		g.Go(func(ctx context.Context) (string, error) { return fetch(ctx, url) })
+//	}
+//	pages, err := g.Wait()
+func NewGroup[T any](ctx context.Context, opts GroupOptions) (*Group[T], context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	g := &Group[T]{ctx: ctx, cancel: cancel, opts: opts}
+	if opts.Limit > 0 {
+		g.sem = make(chan struct{}, opts.Limit)
+	}
+	return g, ctx
+}
+
+// Go 提交一个任务。
+//
+// 达到并发上限时 Go 会阻塞，直到有任务结束或 Group 的上下文被取消。
+// 上下文已被取消时任务不会执行，其错误为上下文被取消的原因。
+//
+// 参数：
+//   - task: 要运行的任务，接收的上下文在 Group 取消或任务超时时被取消。
+func (g *Group[T]) Go(task func(ctx context.Context) (T, error)) {
+	g.mu.Lock()
+	index := len(g.results)
+	var zero T
+	g.results = append(g.results, zero)
+	g.errs = append(g.errs, nil)
+	g.mu.Unlock()
+
+	if g.sem != nil {
+		select {
+		case g.sem <- struct{}{}:
+		case <-g.ctx.Done():
+			g.finish(index, zero, context.Cause(g.ctx))
+			return
+		}
+	}
+
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if g.sem != nil {
+			defer func() { <-g.sem }()
+		}
+
+		if err := context.Cause(g.ctx); err != nil {
+			g.finish(index, zero, err)
+			return
+		}
+		result, err := g.run(task)
+		g.finish(index, result, err)
+	}()
+}
+
+// Wait 等待所有已提交的任务结束。
+//
+// 返回值：
+//   - 按提交顺序排列的结果，出错或未执行的任务对应零值。
+//   - FailFast 模式下为第一个出错任务的 *TaskError；CollectAll 模式下为 errors.Join 合并的所有 *TaskError。
+//     没有任务出错时为 nil。
+func (g *Group[T]) Wait() ([]T, error) {
+	g.wg.Wait()
+	g.cancel(nil)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var errs []error
+	for i, err := range g.errs {
+		if err != nil {
+			errs = append(errs, &TaskError{Index: i, Err: err})
+		}
+	}
+	results := make([]T, len(g.results))
+	copy(results, g.results)
+
+	if len(errs) == 0 {
+		return results, nil
+	}
+	if g.opts.Mode == FailFast {
+		var first *TaskError
+		if errors.As(context.Cause(g.ctx), &first) {
+			return results, first
+		}
+		return results, errs[0]
+	}
+	return results, errors.Join(errs...)
+}
+
+func (g *Group[T]) run(task func(ctx context.Context) (T, error)) (result T, err error) {
+	ctx := g.ctx
+	if g.opts.TaskTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, g.opts.TaskTimeout)
+		defer cancel()
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+	return task(ctx)
+}
+
+func (g *Group[T]) finish(index int, result T, err error) {
+	g.mu.Lock()
+	g.results[index] = result
+	g.errs[index] = err
+	g.mu.Unlock()
+
+	if err != nil && g.opts.Mode == FailFast {
+		g.cancel(&TaskError{Index: index, Err: err})
+	}
+}
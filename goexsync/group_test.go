@@ -0,0 +1,113 @@
+package goexsync
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGroup(t *testing.T) {
+	t.Run("TestGroup_OrderedResults", func(t *testing.T) {
+		g, _ := NewGroup[int](context.Background(), GroupOptions{Limit: 2})
+		for i := 0; i < 5; i++ {
+			i := i
+			g.Go(func(ctx context.Context) (int, error) {
+				time.Sleep(time.Duration(5-i) * time.Millisecond)
+				return i * i, nil
+			})
+		}
+		results, err := g.Wait()
+		expected := []int{0, 1, 4, 9, 16}
+		if err != nil || !reflect.DeepEqual(results, expected) {
+			t.Errorf("Expected %v and nil, but got %v and %v", expected, results, err)
+		}
+	})
+
+	t.Run("TestGroup_Limit", func(t *testing.T) {
+		g, _ := NewGroup[int](context.Background(), GroupOptions{Limit: 3})
+		var running, peak atomic.Int32
+		for i := 0; i < 20; i++ {
+			g.Go(func(ctx context.Context) (int, error) {
+				n := running.Add(1)
+				for {
+					p := peak.Load()
+					if n <= p || peak.CompareAndSwap(p, n) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+				running.Add(-1)
+				return 0, nil
+			})
+		}
+		g.Wait()
+		if peak.Load() > 3 {
+			t.Errorf("Expected at most 3 concurrent tasks, but got %d", peak.Load())
+		}
+	})
+
+	t.Run("TestGroup_FailFast", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		g, ctx := NewGroup[int](context.Background(), GroupOptions{Limit: 1})
+		g.Go(func(ctx context.Context) (int, error) { return 0, errBoom })
+		var ran atomic.Bool
+		g.Go(func(ctx context.Context) (int, error) {
+			ran.Store(true)
+			return 1, nil
+		})
+		_, err := g.Wait()
+
+		var taskErr *TaskError
+		if !errors.As(err, &taskErr) || taskErr.Index != 0 || !errors.Is(err, errBoom) {
+			t.Errorf("Expected task 0 error, but got %v", err)
+		}
+		if ran.Load() {
+			t.Errorf("Expected second task to be skipped")
+		}
+		if ctx.Err() == nil {
+			t.Errorf("Expected group context to be canceled")
+		}
+	})
+
+	t.Run("TestGroup_CollectAll", func(t *testing.T) {
+		g, _ := NewGroup[string](context.Background(), GroupOptions{Mode: CollectAll})
+		g.Go(func(ctx context.Context) (string, error) { return "", errors.New("first") })
+		g.Go(func(ctx context.Context) (string, error) { return "ok", nil })
+		g.Go(func(ctx context.Context) (string, error) { return "", errors.New("third") })
+		results, err := g.Wait()
+
+		if !reflect.DeepEqual(results, []string{"", "ok", ""}) {
+			t.Errorf("Unexpected results %v", results)
+		}
+		if err == nil || !strings.Contains(err.Error(), "task 0: first") || !strings.Contains(err.Error(), "task 2: third") {
+			t.Errorf("Expected both errors to be collected, but got %v", err)
+		}
+	})
+
+	t.Run("TestGroup_Panic", func(t *testing.T) {
+		g, _ := NewGroup[int](context.Background(), GroupOptions{})
+		g.Go(func(ctx context.Context) (int, error) { panic("boom") })
+		_, err := g.Wait()
+
+		var panicErr *PanicError
+		if !errors.As(err, &panicErr) || panicErr.Value != "boom" || len(panicErr.Stack) == 0 {
+			t.Errorf("Expected PanicError with stack, but got %v", err)
+		}
+	})
+
+	t.Run("TestGroup_TaskTimeout", func(t *testing.T) {
+		g, _ := NewGroup[int](context.Background(), GroupOptions{TaskTimeout: 10 * time.Millisecond, Mode: CollectAll})
+		g.Go(func(ctx context.Context) (int, error) {
+			<-ctx.Done()
+			return 0, ctx.Err()
+		})
+		_, err := g.Wait()
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected DeadlineExceeded, but got %v", err)
+		}
+	})
+}
@@ -0,0 +1,226 @@
+package goexsync
+
+import (
+	"sync"
+)
+
+// SyncMap 是一个并发安全的泛型 map，基于读写锁实现。
+//
+// 与 sync.Map 相比，SyncMap 提供类型安全的接口、Len 以及原子的 Compute 操作，
+// 适合读多写少且 key 集合频繁变化的场景。高并发写入时可以改用 ShardedMap。
+//
+// 参数：
+//   - K: key 的类型。
+//   - V: value 的类型。
+type SyncMap[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  map[K]V
+}
+
+// NewSyncMap 创建一个空的 SyncMap。
+func NewSyncMap[K comparable, V any]() *SyncMap[K, V] {
+	return &SyncMap[K, V]{m: make(map[K]V)}
+}
+
+// NewSyncMapFrom 创建一个包含 m 中所有键值对副本的 SyncMap。
+//
+// 参数：
+//   - m: 初始数据。
+//
+// 返回值：
+//   - 新的 SyncMap，修改它不会影响 m。
+func NewSyncMapFrom[K comparable, V any](m map[K]V) *SyncMap[K, V] {
+	s := &SyncMap[K, V]{m: make(map[K]V, len(m))}
+	for k, v := range m {
+		s.m[k] = v
+	}
+	return s
+}
+
+// MARK: - Read
+
+// Load 返回 key 对应的值。
+//
+// 参数：
+//   - key: 要查找的 key。
+//
+// 返回值：
+//   - value: key 对应的值，不存在时为零值。
+//   - ok: key 是否存在。
+func (s *SyncMap[K, V]) Load(key K) (value V, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	value, ok = s.m[key]
+	return value, ok
+}
+
+// Has 判断 key 是否存在。
+func (s *SyncMap[K, V]) Has(key K) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.m[key]
+	return ok
+}
+
+// Len 返回键值对的个数。
+func (s *SyncMap[K, V]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.m)
+}
+
+// Keys 返回所有 key 组成的切片，顺序不确定。
+func (s *SyncMap[K, V]) Keys() []K {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := make([]K, 0, len(s.m))
+	for k := range s.m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values 返回所有 value 组成的切片，顺序不确定。
+func (s *SyncMap[K, V]) Values() []V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	values := make([]V, 0, len(s.m))
+	for _, v := range s.m {
+		values = append(values, v)
+	}
+	return values
+}
+
+// Range 遍历所有键值对，回调返回 false 时停止遍历。
+//
+// 遍历期间持有读锁，回调中不能调用会写入同一个 SyncMap 的方法，否则会死锁。
+//
+// 参数：
+//   - fn: 接受 key 和 value 的回调函数。
+func (s *SyncMap[K, V]) Range(fn func(key K, value V) bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for k, v := range s.m {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Snapshot 返回当前所有键值对的副本。
+func (s *SyncMap[K, V]) Snapshot() map[K]V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make(map[K]V, len(s.m))
+	for k, v := range s.m {
+		result[k] = v
+	}
+	return result
+}
+
+// MARK: - Write
+
+// Store 设置 key 对应的值。
+//
+// 参数：
+//   - key: 要设置的 key。
+//   - value: 新的值。
+func (s *SyncMap[K, V]) Store(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lazyInit()
+	s.m[key] = value
+}
+
+// LoadOrStore 如果 key 已存在则返回已有的值，否则存入 value。
+//
+// 参数：
+//   - key: 要查找或设置的 key。
+//   - value: key 不存在时存入的值。
+//
+// 返回值：
+//   - actual: key 最终对应的值。
+//   - loaded: 如果值是已存在的，返回 true；如果是新存入的，返回 false。
+func (s *SyncMap[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if v, ok := s.m[key]; ok {
+		return v, true
+	}
+	s.lazyInit()
+	s.m[key] = value
+	return value, false
+}
+
+// LoadAndDelete 删除 key 并返回它原来的值。
+//
+// 返回值：
+//   - value: 被删除的值。
+//   - loaded: key 原本是否存在。
+func (s *SyncMap[K, V]) LoadAndDelete(key K) (value V, loaded bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, loaded = s.m[key]
+	delete(s.m, key)
+	return value, loaded
+}
+
+// Delete 删除 key。
+func (s *SyncMap[K, V]) Delete(key K) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.m, key)
+}
+
+// Compute 在写锁保护下原子地更新 key 对应的值。
+//
+// 参数：
+//   - key: 要更新的 key。
+//   - fn: 接受旧值及其是否存在，返回新值以及是否保留该 key；keep 为 false 时删除 key。
+//
+// 返回值：
+//   - value: 更新后的值。
+//   - ok: key 在更新后是否存在。
+//
+// 示例：
+//   - m.Compute("hits", func(old int, _ bool) (int, bool) { return old + 1, true }) 原子地将计数加一。
+func (s *SyncMap[K, V]) Compute(key K, fn func(old V, loaded bool) (value V, keep bool)) (value V, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old, loaded := s.m[key]
+	value, keep := fn(old, loaded)
+	if !keep {
+		delete(s.m, key)
+		var zero V
+		return zero, false
+	}
+	s.lazyInit()
+	s.m[key] = value
+	return value, true
+}
+
+// Clear 删除所有键值对。
+func (s *SyncMap[K, V]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.m = make(map[K]V)
+}
+
+func (s *SyncMap[K, V]) lazyInit() {
+	if s.m == nil {
+		s.m = make(map[K]V)
+	}
+}
@@ -0,0 +1,93 @@
+package goexsync
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestSyncMap(t *testing.T) {
+	t.Run("TestSyncMap_ReadWrite", func(t *testing.T) {
+		m := NewSyncMap[string, int]()
+		m.Store("a", 1)
+		if v, loaded := m.LoadOrStore("a", 2); v != 1 || !loaded {
+			t.Errorf("Expected LoadOrStore to return 1 and true, but got %v and %v", v, loaded)
+		}
+		if v, loaded := m.LoadOrStore("b", 2); v != 2 || loaded {
+			t.Errorf("Expected LoadOrStore to return 2 and false, but got %v and %v", v, loaded)
+		}
+		keys := m.Keys()
+		sort.Strings(keys)
+		if !reflect.DeepEqual(keys, []string{"a", "b"}) {
+			t.Errorf("Expected keys [a b], but got %v", keys)
+		}
+		if v, loaded := m.LoadAndDelete("a"); v != 1 || !loaded || m.Has("a") {
+			t.Errorf("Expected LoadAndDelete to remove a")
+		}
+	})
+
+	t.Run("TestSyncMap_ZeroValue", func(t *testing.T) {
+		var m SyncMap[string, int]
+		m.Store("a", 1)
+		if v, ok := m.Load("a"); v != 1 || !ok {
+			t.Errorf("Expected zero value SyncMap to be usable")
+		}
+	})
+
+	t.Run("TestSyncMap_Compute", func(t *testing.T) {
+		m := NewSyncMapFrom(map[string]int{"a": 1})
+		m.Compute("a", func(old int, loaded bool) (int, bool) { return old + 1, true })
+		if v, _ := m.Load("a"); v != 2 {
+			t.Errorf("Expected 2, but got %v", v)
+		}
+		if _, ok := m.Compute("a", func(int, bool) (int, bool) { return 0, false }); ok || m.Has("a") {
+			t.Errorf("Expected Compute to delete a")
+		}
+	})
+
+	t.Run("TestSyncMap_ConcurrentCompute", func(t *testing.T) {
+		m := NewSyncMap[string, int]()
+		var wg sync.WaitGroup
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 1000; i++ {
+					m.Compute("hits", func(old int, _ bool) (int, bool) { return old + 1, true })
+					m.Load("hits")
+				}
+			}()
+		}
+		wg.Wait()
+		if v, _ := m.Load("hits"); v != 8000 {
+			t.Errorf("Expected 8000, but got %v", v)
+		}
+	})
+}
+
+func BenchmarkSyncMap_StoreLoad(b *testing.B) {
+	m := NewSyncMap[string, int]()
+	keys := benchmarkKeys()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			key := keys[i%len(keys)]
+			if i%4 == 0 {
+				m.Store(key, i)
+			} else {
+				m.Load(key)
+			}
+			i++
+		}
+	})
+}
+
+func benchmarkKeys() []string {
+	keys := make([]string, 1024)
+	for i := range keys {
+		keys[i] = "key-" + strconv.Itoa(i)
+	}
+	return keys
+}
@@ -0,0 +1,182 @@
+package goexsync
+
+import (
+	"sync"
+)
+
+// SyncSet 是一个并发安全的集合，基于读写锁实现。
+//
+// 参数：
+//   - E: 元素类型，必须可比较。
+type SyncSet[E comparable] struct {
+	mu sync.RWMutex
+	m  map[E]struct{}
+}
+
+// NewSyncSet 创建一个包含给定元素的 SyncSet。
+//
+// 示例：
+//   - NewSyncSet(1, 2, 2, 3).Len() 返回 3。
+func NewSyncSet[E comparable](items ...E) *SyncSet[E] {
+	s := &SyncSet[E]{m: make(map[E]struct{}, len(items))}
+	for _, item := range items {
+		s.m[item] = struct{}{}
+	}
+	return s
+}
+
+// MARK: - Read
+
+// Contain 检查集合中是否包含元素 target。
+func (s *SyncSet[E]) Contain(target E) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.m[target]
+	return ok
+}
+
+// ContainsAll 检查集合是否包含所有指定的元素。
+func (s *SyncSet[E]) ContainsAll(elements ...E) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, e := range elements {
+		if _, ok := s.m[e]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAny 检查集合是否包含指定的任何一个元素。
+func (s *SyncSet[E]) ContainsAny(elements ...E) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, e := range elements {
+		if _, ok := s.m[e]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Len 返回集合中元素的个数。
+func (s *SyncSet[E]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.m)
+}
+
+// IsEmpty 判断集合是否为空。
+func (s *SyncSet[E]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// ToSlice 返回集合中所有元素组成的切片，顺序不确定。
+func (s *SyncSet[E]) ToSlice() []E {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]E, 0, len(s.m))
+	for e := range s.m {
+		result = append(result, e)
+	}
+	return result
+}
+
+// Range 遍历集合中的元素，回调返回 false 时停止遍历。
+//
+// 遍历期间持有读锁，回调中不能调用会写入同一个 SyncSet 的方法，否则会死锁。
+func (s *SyncSet[E]) Range(fn func(item E) bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for e := range s.m {
+		if !fn(e) {
+			return
+		}
+	}
+}
+
+// MARK: - Write
+
+// Add 向集合中添加元素。
+//
+// 返回值：
+//   - 新加入集合的元素个数。
+func (s *SyncSet[E]) Add(items ...E) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.m == nil {
+		s.m = make(map[E]struct{}, len(items))
+	}
+	added := 0
+	for _, item := range items {
+		if _, ok := s.m[item]; !ok {
+			s.m[item] = struct{}{}
+			added++
+		}
+	}
+	return added
+}
+
+// Remove 从集合中删除元素。
+//
+// 返回值：
+//   - 实际被删除的元素个数。
+func (s *SyncSet[E]) Remove(items ...E) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	removed := 0
+	for _, item := range items {
+		if _, ok := s.m[item]; ok {
+			delete(s.m, item)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Clear 删除集合中的所有元素。
+func (s *SyncSet[E]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.m = make(map[E]struct{})
+}
+
+// MARK: - Algebra
+
+// Union 返回两个集合的并集，结果为新的集合。
+func (s *SyncSet[E]) Union(other *SyncSet[E]) *SyncSet[E] {
+	result := NewSyncSet(s.ToSlice()...)
+	result.Add(other.ToSlice()...)
+	return result
+}
+
+// Intersect 返回两个集合的交集，结果为新的集合。
+func (s *SyncSet[E]) Intersect(other *SyncSet[E]) *SyncSet[E] {
+	result := NewSyncSet[E]()
+	for _, e := range s.ToSlice() {
+		if other.Contain(e) {
+			result.m[e] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference 返回存在于 s 但不存在于 other 中的元素组成的新集合。
+func (s *SyncSet[E]) Difference(other *SyncSet[E]) *SyncSet[E] {
+	result := NewSyncSet[E]()
+	for _, e := range s.ToSlice() {
+		if !other.Contain(e) {
+			result.m[e] = struct{}{}
+		}
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+package goexsync
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestSyncSet(t *testing.T) {
+	t.Run("TestSyncSet_AddRemove", func(t *testing.T) {
+		s := NewSyncSet(1, 2, 2, 3)
+		if s.Len() != 3 {
+			t.Errorf("Expected 3 elements, but got %d", s.Len())
+		}
+		if added := s.Add(3, 4); added != 1 {
+			t.Errorf("Expected 1 element added, but got %d", added)
+		}
+		if removed := s.Remove(1, 5); removed != 1 {
+			t.Errorf("Expected 1 element removed, but got %d", removed)
+		}
+		if !s.ContainsAll(2, 3, 4) || s.ContainsAny(1, 5) {
+			t.Errorf("Unexpected set content %v", s.ToSlice())
+		}
+	})
+
+	t.Run("TestSyncSet_Algebra", func(t *testing.T) {
+		a := NewSyncSet(1, 2, 3)
+		b := NewSyncSet(2, 3, 4)
+		testCases := []struct {
+			name   string
+			result *SyncSet[int]
+			want   []int
+		}{
+			{"Union", a.Union(b), []int{1, 2, 3, 4}},
+			{"Intersect", a.Intersect(b), []int{2, 3}},
+			{"Difference", a.Difference(b), []int{1}},
+		}
+		for _, tc := range testCases {
+			got := tc.result.ToSlice()
+			sort.Ints(got)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("%s: expected %v, but got %v", tc.name, tc.want, got)
+			}
+		}
+	})
+
+	t.Run("TestSyncSet_Concurrent", func(t *testing.T) {
+		s := NewSyncSet[int]()
+		var wg sync.WaitGroup
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					s.Add(i)
+					s.Contain(i)
+				}
+			}()
+		}
+		wg.Wait()
+		if s.Len() != 100 {
+			t.Errorf("Expected 100 elements, but got %d", s.Len())
+		}
+	})
+}
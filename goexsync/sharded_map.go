@@ -0,0 +1,217 @@
+package goexsync
+
+import (
+	"sync"
+
+	"github.com/birdmichael/GoEx/internal/hashutil"
+)
+
+// DefaultShardCount 是 NewShardedMap 在未指定分片数时使用的分片数量。
+const DefaultShardCount = 32
+
+// ShardedMap 是一个按 key 哈希分片的并发安全 map。
+//
+// 每个分片拥有独立的读写锁，不同分片上的操作互不阻塞，适合高并发写入的场景。
+//
+// 参数：
+//   - K: key 的类型。
+//   - V: value 的类型。
+type ShardedMap[K comparable, V any] struct {
+	shards []*shard[K, V]
+	hasher func(key K) uint64
+}
+
+type shard[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  map[K]V
+}
+
+// NewShardedMap 创建一个 ShardedMap。
+//
+// 参数：
+//   - shards: 分片数量，小于等于 0 时使用 DefaultShardCount。
+//   - hasher: key 的哈希函数，为 nil 时使用内置哈希；对结构体等复杂 key 提供自定义哈希可以显著提升性能。
+//
+// 返回值：
+//   - 新的 ShardedMap。
+func NewShardedMap[K comparable, V any](shards int, hasher func(key K) uint64) *ShardedMap[K, V] {
+	if shards <= 0 {
+		shards = DefaultShardCount
+	}
+	if hasher == nil {
+		hasher = hashutil.Hash[K]
+	}
+
+	m := &ShardedMap[K, V]{
+		shards: make([]*shard[K, V], shards),
+		hasher: hasher,
+	}
+	for i := range m.shards {
+		m.shards[i] = &shard[K, V]{m: make(map[K]V)}
+	}
+	return m
+}
+
+func (m *ShardedMap[K, V]) shardFor(key K) *shard[K, V] {
+	return m.shards[m.hasher(key)%uint64(len(m.shards))]
+}
+
+// MARK: - Read
+
+// Load 返回 key 对应的值。
+//
+// 返回值：
+//   - value: key 对应的值，不存在时为零值。
+//   - ok: key 是否存在。
+func (m *ShardedMap[K, V]) Load(key K) (value V, ok bool) {
+	s := m.shardFor(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	value, ok = s.m[key]
+	return value, ok
+}
+
+// Has 判断 key 是否存在。
+func (m *ShardedMap[K, V]) Has(key K) bool {
+	_, ok := m.Load(key)
+	return ok
+}
+
+// Len 返回键值对的个数。
+//
+// 各分片依次加锁统计，并发写入时结果只是一个近似值。
+func (m *ShardedMap[K, V]) Len() int {
+	n := 0
+	for _, s := range m.shards {
+		s.mu.RLock()
+		n += len(s.m)
+		s.mu.RUnlock()
+	}
+	return n
+}
+
+// Keys 返回所有 key 组成的切片，顺序不确定。
+func (m *ShardedMap[K, V]) Keys() []K {
+	var keys []K
+	m.Range(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Range 依次遍历每个分片的键值对，回调返回 false 时停止遍历。
+//
+// 遍历某个分片时持有该分片的读锁，回调中不能写入同一个 ShardedMap。
+func (m *ShardedMap[K, V]) Range(fn func(key K, value V) bool) {
+	for _, s := range m.shards {
+		if !s.rangeLocked(fn) {
+			return
+		}
+	}
+}
+
+func (s *shard[K, V]) rangeLocked(fn func(key K, value V) bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for k, v := range s.m {
+		if !fn(k, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Snapshot 返回当前所有键值对的副本。
+func (m *ShardedMap[K, V]) Snapshot() map[K]V {
+	result := make(map[K]V)
+	m.Range(func(key K, value V) bool {
+		result[key] = value
+		return true
+	})
+	return result
+}
+
+// MARK: - Write
+
+// Store 设置 key 对应的值。
+func (m *ShardedMap[K, V]) Store(key K, value V) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.m[key] = value
+}
+
+// LoadOrStore 如果 key 已存在则返回已有的值，否则存入 value。
+//
+// 返回值：
+//   - actual: key 最终对应的值。
+//   - loaded: 如果值是已存在的，返回 true；如果是新存入的，返回 false。
+func (m *ShardedMap[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if v, ok := s.m[key]; ok {
+		return v, true
+	}
+	s.m[key] = value
+	return value, false
+}
+
+// LoadAndDelete 删除 key 并返回它原来的值。
+func (m *ShardedMap[K, V]) LoadAndDelete(key K) (value V, loaded bool) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, loaded = s.m[key]
+	delete(s.m, key)
+	return value, loaded
+}
+
+// Delete 删除 key。
+func (m *ShardedMap[K, V]) Delete(key K) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.m, key)
+}
+
+// Compute 在 key 所在分片的写锁保护下原子地更新 key 对应的值。
+//
+// 参数：
+//   - key: 要更新的 key。
+//   - fn: 接受旧值及其是否存在，返回新值以及是否保留该 key；keep 为 false 时删除 key。
+//
+// 返回值：
+//   - value: 更新后的值。
+//   - ok: key 在更新后是否存在。
+func (m *ShardedMap[K, V]) Compute(key K, fn func(old V, loaded bool) (value V, keep bool)) (value V, ok bool) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old, loaded := s.m[key]
+	value, keep := fn(old, loaded)
+	if !keep {
+		delete(s.m, key)
+		var zero V
+		return zero, false
+	}
+	s.m[key] = value
+	return value, true
+}
+
+// Clear 删除所有键值对。
+func (m *ShardedMap[K, V]) Clear() {
+	for _, s := range m.shards {
+		s.mu.Lock()
+		s.m = make(map[K]V)
+		s.mu.Unlock()
+	}
+}
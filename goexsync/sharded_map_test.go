@@ -0,0 +1,101 @@
+package goexsync
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestShardedMap(t *testing.T) {
+	t.Run("TestShardedMap_ReadWrite", func(t *testing.T) {
+		m := NewShardedMap[int, string](4, nil)
+		for i := 0; i < 100; i++ {
+			m.Store(i, "v")
+		}
+		if m.Len() != 100 {
+			t.Errorf("Expected 100 elements, but got %d", m.Len())
+		}
+		if v, loaded := m.LoadOrStore(1, "x"); v != "v" || !loaded {
+			t.Errorf("Expected LoadOrStore to return existing value")
+		}
+		m.Delete(1)
+		if m.Has(1) {
+			t.Errorf("Expected key 1 to be deleted")
+		}
+		m.Clear()
+		if m.Len() != 0 {
+			t.Errorf("Expected empty map after Clear")
+		}
+	})
+
+	t.Run("TestShardedMap_CustomHasher", func(t *testing.T) {
+		type point struct{ x, y int }
+		m := NewShardedMap[point, int](0, func(p point) uint64 { return uint64(p.x*31 + p.y) })
+		m.Store(point{1, 2}, 3)
+		m.Store(point{2, 1}, 4)
+		keys := m.Keys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].x < keys[j].x })
+		if !reflect.DeepEqual(keys, []point{{1, 2}, {2, 1}}) {
+			t.Errorf("Unexpected keys %v", keys)
+		}
+	})
+
+	t.Run("TestShardedMap_PointerKey", func(t *testing.T) {
+		type node struct{ v int }
+		m := NewShardedMap[*node, int](0, nil)
+		p := &node{v: 1}
+		m.Store(p, 1)
+		p.v = 2
+		if v, ok := m.Load(p); !ok || v != 1 {
+			t.Errorf("Expected pointer key to be found after pointee changed, but got %v %v", v, ok)
+		}
+	})
+
+	t.Run("TestShardedMap_SignedZeroKey", func(t *testing.T) {
+		type point struct{ F float64 }
+		m := NewShardedMap[point, int](0, nil)
+		m.Store(point{F: 0}, 1)
+		if v, ok := m.Load(point{F: math.Copysign(0, -1)}); !ok || v != 1 {
+			t.Errorf("Expected -0 key to find +0 entry, but got %v %v", v, ok)
+		}
+	})
+
+	t.Run("TestShardedMap_ConcurrentCompute", func(t *testing.T) {
+		m := NewShardedMap[string, int](0, nil)
+		keys := []string{"a", "b", "c", "d"}
+		var wg sync.WaitGroup
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 1000; i++ {
+					m.Compute(keys[i%len(keys)], func(old int, _ bool) (int, bool) { return old + 1, true })
+				}
+			}()
+		}
+		wg.Wait()
+		expected := map[string]int{"a": 2000, "b": 2000, "c": 2000, "d": 2000}
+		if result := m.Snapshot(); !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, but got %v", expected, result)
+		}
+	})
+}
+
+func BenchmarkShardedMap_StoreLoad(b *testing.B) {
+	m := NewShardedMap[string, int](0, nil)
+	keys := benchmarkKeys()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			key := keys[i%len(keys)]
+			if i%4 == 0 {
+				m.Store(key, i)
+			} else {
+				m.Load(key)
+			}
+			i++
+		}
+	})
+}
@@ -0,0 +1,405 @@
+package goexsync
+
+import (
+	"sync"
+
+	"github.com/birdmichael/GoEx/goexslice"
+	"github.com/birdmichael/GoEx/tupleext"
+)
+
+// SyncSlice 是一个并发安全的切片容器，基于读写锁实现。
+//
+// 读操作（Get、Len、Filter 等）持有读锁，可以并发执行；写操作（Append、Set 等）持有写锁。
+// 所有返回切片的方法都会返回副本，调用方可以放心修改而不影响容器内部数据。
+//
+// 参数：
+//   - E: 元素类型。
+type SyncSlice[E any] struct {
+	mu    sync.RWMutex
+	items []E
+}
+
+// NewSyncSlice 创建一个包含给定元素的 SyncSlice。
+//
+// 参数：
+//   - items: 初始元素。
+//
+// 返回值：
+//   - 新的 SyncSlice，内部持有 items 的副本。
+//
+// 示例：
+//   - NewSyncSlice(1, 2, 3).Len() 返回 3。
+func NewSyncSlice[E any](items ...E) *SyncSlice[E] {
+	s := &SyncSlice[E]{}
+	s.items = append(s.items, items...)
+	return s
+}
+
+// MARK: - Read
+
+// Len 返回切片中元素的个数。
+func (s *SyncSlice[E]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.items)
+}
+
+// IsEmpty 判断切片是否为空。
+func (s *SyncSlice[E]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return goexslice.IsEmpty(s.items)
+}
+
+// Get 安全地获取指定索引位置的元素。
+//
+// 参数：
+//   - index: 要获取元素的索引。
+//
+// 返回值：
+//   - 如果索引有效，返回该索引位置的元素和 true；否则返回零值和 false。
+func (s *SyncSlice[E]) Get(index int) (v E, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return goexslice.SafeIndex(s.items, index)
+}
+
+// First 返回切片中的第一个元素。
+//
+// 返回值：
+//   - v: 切片中的第一个元素。
+//   - ok: 切片为空时返回 false。
+func (s *SyncSlice[E]) First() (v E, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return goexslice.First(s.items)
+}
+
+// Last 返回切片中的最后一个元素。
+//
+// 返回值：
+//   - v: 切片中的最后一个元素。
+//   - ok: 切片为空时返回 false。
+func (s *SyncSlice[E]) Last() (v E, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return goexslice.Last(s.items)
+}
+
+// ContainBy 检查切片中是否存在满足 predicate 的元素。
+//
+// 参数：
+//   - predicate: 判断元素是否满足条件的函数。
+//
+// 返回值：
+//   - 如果存在满足条件的元素，返回 true；否则返回 false。
+func (s *SyncSlice[E]) ContainBy(predicate func(item E) bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return goexslice.ContainBy(s.items, predicate)
+}
+
+// FindFirstBy 查找第一个满足条件的元素。
+//
+// 参数：
+//   - predicate: 接受元素索引和元素值的判断函数。
+//
+// 返回值：
+//   - v: 第一个满足条件的元素。
+//   - ok: 表示是否找到满足条件的元素。
+func (s *SyncSlice[E]) FindFirstBy(predicate func(index int, item E) bool) (v E, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return goexslice.FindFirstBy(s.items, predicate)
+}
+
+// FindLastBy 查找最后一个满足条件的元素。
+//
+// 参数：
+//   - predicate: 接受元素索引和元素值的判断函数。
+//
+// 返回值：
+//   - v: 最后一个满足条件的元素。
+//   - ok: 表示是否找到满足条件的元素。
+func (s *SyncSlice[E]) FindLastBy(predicate func(index int, item E) bool) (v E, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return goexslice.FindLastBy(s.items, predicate)
+}
+
+// Filter 返回满足条件的元素组成的新切片。
+//
+// 参数：
+//   - predicate: 用于判断是否保留元素的条件函数。
+//
+// 返回值：
+//   - 新切片，其中仅包含满足条件的元素。
+func (s *SyncSlice[E]) Filter(predicate goexslice.Predicate[E]) []E {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if predicate == nil {
+		return s.snapshot()
+	}
+	return goexslice.Filter(s.items, predicate)
+}
+
+// Group 将切片按指定的大小分割成多个子切片，子切片均为副本。
+//
+// 参数：
+//   - size: 每个子切片的大小。
+//
+// 返回值：
+//   - 分割后的子切片列表，size 小于等于 0 或切片为空时返回 nil。
+func (s *SyncSlice[E]) Group(size int) [][]E {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return goexslice.Group(s.items, size)
+}
+
+// Range 按顺序遍历切片中的元素，回调返回 false 时停止遍历。
+//
+// 遍历期间持有读锁，回调中不能调用会写入同一个 SyncSlice 的方法，否则会死锁。
+//
+// 参数：
+//   - fn: 接受元素索引和元素值的回调函数。
+func (s *SyncSlice[E]) Range(fn func(index int, item E) bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for i, item := range s.items {
+		if !fn(i, item) {
+			return
+		}
+	}
+}
+
+// Snapshot 返回当前所有元素的副本。
+func (s *SyncSlice[E]) Snapshot() []E {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.snapshot()
+}
+
+// Enumerated 返回一个包含元素及其索引的元组切片。
+func (s *SyncSlice[E]) Enumerated() []tupleext.Tuple[E, int] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return goexslice.Enumerated(s.items)
+}
+
+// MARK: - Write
+
+// Append 在切片末尾追加元素。
+//
+// 参数：
+//   - items: 要追加的元素。
+func (s *SyncSlice[E]) Append(items ...E) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.items = append(s.items, items...)
+}
+
+// Prepend 在切片开头添加一个元素。
+//
+// 参数：
+//   - item: 要添加的元素。
+func (s *SyncSlice[E]) Prepend(item E) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.items = goexslice.Prepend(s.items, item)
+}
+
+// InsertAt 在指定索引处插入元素。
+//
+// 参数：
+//   - index: 要插入元素的索引位置。
+//   - items: 要插入的元素。
+//
+// 返回值：
+//   - 如果索引超出范围，返回 false 且不修改切片；否则返回 true。
+func (s *SyncSlice[E]) InsertAt(index int, items ...E) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if index < 0 || index > len(s.items) {
+		return false
+	}
+	result := make([]E, 0, len(s.items)+len(items))
+	result = append(result, s.items[:index]...)
+	result = append(result, items...)
+	s.items = append(result, s.items[index:]...)
+	return true
+}
+
+// Set 设置指定索引位置的元素。
+//
+// 参数：
+//   - index: 要设置的索引。
+//   - item: 新的元素值。
+//
+// 返回值：
+//   - 如果索引有效，返回 true；否则返回 false。
+func (s *SyncSlice[E]) Set(index int, item E) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if index < 0 || index >= len(s.items) {
+		return false
+	}
+	s.items[index] = item
+	return true
+}
+
+// RemoveAt 删除指定索引位置的元素。
+//
+// 参数：
+//   - index: 要删除的索引。
+//
+// 返回值：
+//   - v: 被删除的元素。
+//   - ok: 索引无效时返回 false。
+func (s *SyncSlice[E]) RemoveAt(index int) (v E, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if index < 0 || index >= len(s.items) {
+		return v, false
+	}
+	v = s.items[index]
+	s.items = append(s.items[:index], s.items[index+1:]...)
+	return v, true
+}
+
+// RemoveBy 删除所有满足条件的元素。
+//
+// 参数：
+//   - predicate: 判断元素是否需要删除的函数。
+//
+// 返回值：
+//   - 被删除的元素个数。
+func (s *SyncSlice[E]) RemoveBy(predicate goexslice.Predicate[E]) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := s.items[:0]
+	for _, item := range s.items {
+		if !predicate(item) {
+			kept = append(kept, item)
+		}
+	}
+	removed := len(s.items) - len(kept)
+	var zero E
+	for i := len(kept); i < len(s.items); i++ {
+		s.items[i] = zero
+	}
+	s.items = kept
+	return removed
+}
+
+// SafeSwap 安全地交换两个索引位置的元素。
+//
+// 参数：
+//   - from: 要交换的第一个元素的索引。
+//   - to: 要交换的第二个元素的索引。
+//
+// 返回值：
+//   - 如果交换成功，返回 true；否则返回 false。
+func (s *SyncSlice[E]) SafeSwap(from, to int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return goexslice.SafeSwap(s.items, from, to)
+}
+
+// Reverse 将切片中的元素顺序颠倒。
+func (s *SyncSlice[E]) Reverse() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	goexslice.Reverse(s.items)
+}
+
+// RandomIn 随机打乱切片中的元素顺序。
+func (s *SyncSlice[E]) RandomIn() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	goexslice.RandomIn(s.items)
+}
+
+// Update 在写锁保护下以原子方式修改整个切片。
+//
+// 参数：
+//   - fn: 接受当前切片并返回新切片的函数。
+func (s *SyncSlice[E]) Update(fn func(items []E) []E) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.items = fn(s.items)
+}
+
+// Clear 清空切片。
+func (s *SyncSlice[E]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.items = nil
+}
+
+func (s *SyncSlice[E]) snapshot() []E {
+	if s.items == nil {
+		return nil
+	}
+	result := make([]E, len(s.items))
+	copy(result, s.items)
+	return result
+}
+
+// MARK: - Comparable
+
+// Contain 检查 SyncSlice 中是否包含元素 target。
+//
+// 方法无法额外约束类型参数，因此对可比较元素的操作以函数形式提供。
+//
+// 参数：
+//   - s: 要检查的 SyncSlice。
+//   - target: 要查找的目标元素。
+//
+// 返回值：
+//   - 如果包含 target，返回 true；否则返回 false。
+func Contain[E comparable](s *SyncSlice[E], target E) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return goexslice.Contain(s.items, target)
+}
+
+// ContainsAll 检查 SyncSlice 是否包含所有指定的元素。
+func ContainsAll[E comparable](s *SyncSlice[E], elements ...E) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return goexslice.ContainsAll(s.items, elements...)
+}
+
+// ContainsAny 检查 SyncSlice 是否包含指定的任何一个元素。
+func ContainsAny[E comparable](s *SyncSlice[E], elements ...E) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return goexslice.ContainsAny(s.items, elements...)
+}
@@ -0,0 +1,91 @@
+package goexsync
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestSyncSlice(t *testing.T) {
+	t.Run("TestSyncSlice_ReadWrite", func(t *testing.T) {
+		s := NewSyncSlice(2, 3, 4)
+		s.Prepend(1)
+		s.Append(6)
+		if ok := s.InsertAt(4, 5); !ok {
+			t.Fatalf("Expected InsertAt to succeed")
+		}
+		expected := []int{1, 2, 3, 4, 5, 6}
+		if result := s.Snapshot(); !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, but got %v", expected, result)
+		}
+		if v, ok := s.Get(2); v != 3 || !ok {
+			t.Errorf("Expected Get(2) = 3 and true, but got %v and %v", v, ok)
+		}
+		if _, ok := s.Get(10); ok {
+			t.Errorf("Expected Get(10) to fail")
+		}
+		if !Contain(s, 4) || Contain(s, 7) {
+			t.Errorf("Contain returned unexpected result")
+		}
+	})
+
+	t.Run("TestSyncSlice_Filter", func(t *testing.T) {
+		s := NewSyncSlice(1, 2, 3, 4)
+		expected := []int{2, 4}
+		result := s.Filter(func(v int) bool { return v%2 == 0 })
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %v, but got %v", expected, result)
+		}
+	})
+
+	t.Run("TestSyncSlice_RemoveBy", func(t *testing.T) {
+		s := NewSyncSlice(1, 2, 3, 4, 5)
+		removed := s.RemoveBy(func(v int) bool { return v > 3 })
+		expected := []int{1, 2, 3}
+		if removed != 2 || !reflect.DeepEqual(s.Snapshot(), expected) {
+			t.Errorf("Expected %v and 2 removed, but got %v and %d", expected, s.Snapshot(), removed)
+		}
+	})
+
+	t.Run("TestSyncSlice_SnapshotIsCopy", func(t *testing.T) {
+		s := NewSyncSlice(1, 2, 3)
+		snap := s.Snapshot()
+		snap[0] = 100
+		if v, _ := s.First(); v != 1 {
+			t.Errorf("Expected snapshot modification not to affect slice, but got %v", v)
+		}
+	})
+
+	t.Run("TestSyncSlice_Concurrent", func(t *testing.T) {
+		s := NewSyncSlice[int]()
+		var wg sync.WaitGroup
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					s.Append(g*100 + i)
+					s.Len()
+					s.ContainBy(func(v int) bool { return v < 0 })
+				}
+			}(g)
+		}
+		wg.Wait()
+
+		result := s.Snapshot()
+		sort.Ints(result)
+		if len(result) != 800 || result[0] != 0 || result[799] != 799 {
+			t.Errorf("Expected 800 distinct values, but got %d", len(result))
+		}
+	})
+}
+
+func BenchmarkSyncSlice_Append(b *testing.B) {
+	s := NewSyncSlice[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			s.Append(1)
+		}
+	})
+}
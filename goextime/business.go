@@ -0,0 +1,141 @@
+package goextime
+
+import (
+	"time"
+)
+
+// BusinessOptions 是创建 BusinessCalendar 时的配置项，所有字段均可省略。
+type BusinessOptions struct {
+	// Weekend 是每周的休息日，默认为星期六和星期日。
+	Weekend []time.Weekday
+	// Holidays 是节假日，只使用日期部分。
+	Holidays []time.Time
+	// Workdays 是需要上班的休息日，例如节假日前后调休补班的周末，只使用日期部分。
+	Workdays []time.Time
+}
+
+// BusinessCalendar 根据周末、节假日与调休计算工作日，创建后不可修改，可以并发使用。
+//
+// 日期按传入时间自身的时区取得，调用方应使用统一的时区。
+type BusinessCalendar struct {
+	weekend  [7]bool
+	holidays map[civilDate]struct{}
+	workdays map[civilDate]struct{}
+}
+
+// civilDate 是不含时区的日期，用作节假日的键。
+type civilDate struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func dateOf(t time.Time) civilDate {
+	year, month, day := t.Date()
+	return civilDate{year, month, day}
+}
+
+// NewBusinessCalendar 创建一个工作日日历。Weekend 包含一周全部七天时会 panic。
+//
+// 示例：
+//
+//	cal := goextime.NewBusinessCalendar(goextime.BusinessOptions{
+//		Holidays: goextime.Days(oct1, oct7),
+//		Workdays: []time.Time{sep29, oct12},
+//	})
+func NewBusinessCalendar(opts BusinessOptions) *BusinessCalendar {
+	if opts.Weekend == nil {
+		opts.Weekend = []time.Weekday{time.Saturday, time.Sunday}
+	}
+
+	c := &BusinessCalendar{
+		holidays: make(map[civilDate]struct{}, len(opts.Holidays)),
+		workdays: make(map[civilDate]struct{}, len(opts.Workdays)),
+	}
+	rest := 0
+	for _, weekday := range opts.Weekend {
+		if !c.weekend[weekday] {
+			c.weekend[weekday] = true
+			rest++
+		}
+	}
+	if rest == len(c.weekend) {
+		panic("goextime: weekend must not cover every day of the week")
+	}
+	for _, t := range opts.Holidays {
+		c.holidays[dateOf(t)] = struct{}{}
+	}
+	for _, t := range opts.Workdays {
+		c.workdays[dateOf(t)] = struct{}{}
+	}
+	return c
+}
+
+// IsBusinessDay 判断 t 所在日期是否为工作日。调休上班日总是工作日，其次节假日与周末不是工作日。
+func (c *BusinessCalendar) IsBusinessDay(t time.Time) bool {
+	d := dateOf(t)
+	if _, ok := c.workdays[d]; ok {
+		return true
+	}
+	if _, ok := c.holidays[d]; ok {
+		return false
+	}
+	return !c.weekend[t.Weekday()]
+}
+
+// AddBusinessDays 返回 t 之后第 n 个工作日的同一时刻，n 为负数时向前查找，n 为 0 时返回 t。
+//
+// 示例：
+//   - 2024-05-17（星期五）加 1 个工作日返回 2024-05-20（星期一）。
+func (c *BusinessCalendar) AddBusinessDays(t time.Time, n int) time.Time {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		t = t.AddDate(0, 0, step)
+		if c.IsBusinessDay(t) {
+			n--
+		}
+	}
+	return t
+}
+
+// NextBusinessDay 返回 t 所在日期当天或之后的第一个工作日的同一时刻。
+func (c *BusinessCalendar) NextBusinessDay(t time.Time) time.Time {
+	for !c.IsBusinessDay(t) {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t
+}
+
+// BusinessDaysBetween 返回 [start, end) 日期范围内的工作日数量，只比较日期部分；end 早于 start 时为负数。
+//
+// 示例：
+//   - 2024-05-13（星期一）到 2024-05-20（星期一）之间有 5 个工作日。
+func (c *BusinessCalendar) BusinessDaysBetween(start, end time.Time) int {
+	days, sign := DaysBetween(start, end), 1
+	if days < 0 {
+		start, days, sign = end, -days, -1
+	}
+
+	count := 0
+	first := StartOf(start, Day)
+	for i := 0; i < days; i++ {
+		if c.IsBusinessDay(first.AddDate(0, 0, i)) {
+			count++
+		}
+	}
+	return sign * count
+}
+
+// BusinessDays 返回 start 所在日期到 end 所在日期（包含两端）之间所有工作日的零点。
+func (c *BusinessCalendar) BusinessDays(start, end time.Time) []time.Time {
+	var result []time.Time
+	for _, day := range Days(start, end) {
+		if c.IsBusinessDay(day) {
+			result = append(result, day)
+		}
+	}
+	return result
+}
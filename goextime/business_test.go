@@ -0,0 +1,116 @@
+package goextime
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// nationalDay 是 2024 年国庆假期：10 月 1 日至 7 日放假，9 月 29 日（星期日）与 10 月 12 日（星期六）补班。
+func nationalDay() *BusinessCalendar {
+	return NewBusinessCalendar(BusinessOptions{
+		Holidays: Days(date(2024, time.October, 1), date(2024, time.October, 7)),
+		Workdays: []time.Time{date(2024, time.September, 29), date(2024, time.October, 12)},
+	})
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	cal := nationalDay()
+	tests := []struct {
+		name     string
+		t        time.Time
+		expected bool
+	}{
+		{"weekday", date(2024, time.September, 27), true},
+		{"weekend", date(2024, time.September, 28), false},
+		{"make-up workday", date(2024, time.September, 29), true},
+		{"holiday on weekday", date(2024, time.October, 1), false},
+		{"after holiday", date(2024, time.October, 8), true},
+		{"make-up saturday", time.Date(2024, time.October, 12, 18, 0, 0, 0, time.UTC), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cal.IsBusinessDay(tt.t); got != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	cal := nationalDay()
+	friday := time.Date(2024, time.May, 17, 9, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		t        time.Time
+		n        int
+		expected time.Time
+	}{
+		{"over weekend", friday, 1, time.Date(2024, time.May, 20, 9, 30, 0, 0, time.UTC)},
+		{"zero", friday, 0, friday},
+		{"backward", friday, -5, time.Date(2024, time.May, 10, 9, 30, 0, 0, time.UTC)},
+		{"over holiday", date(2024, time.September, 30), 1, date(2024, time.October, 8)},
+		{"into make-up day", date(2024, time.September, 27), 1, date(2024, time.September, 29)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cal.AddBusinessDays(tt.t, tt.n); !got.Equal(tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestNextBusinessDay(t *testing.T) {
+	cal := nationalDay()
+	if got := cal.NextBusinessDay(date(2024, time.October, 3)); !got.Equal(date(2024, time.October, 8)) {
+		t.Errorf("Expected %v, but got %v", date(2024, time.October, 8), got)
+	}
+	if got := cal.NextBusinessDay(date(2024, time.October, 8)); !got.Equal(date(2024, time.October, 8)) {
+		t.Errorf("Expected %v, but got %v", date(2024, time.October, 8), got)
+	}
+}
+
+func TestBusinessDaysBetween(t *testing.T) {
+	cal := NewBusinessCalendar(BusinessOptions{})
+	monday := date(2024, time.May, 13)
+	tests := []struct {
+		name       string
+		start, end time.Time
+		expected   int
+	}{
+		{"week", monday, date(2024, time.May, 20), 5},
+		{"same day", monday, monday.Add(10 * time.Hour), 0},
+		{"weekend only", date(2024, time.May, 18), date(2024, time.May, 20), 0},
+		{"reversed", date(2024, time.May, 20), monday, -5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cal.BusinessDaysBetween(tt.start, tt.end); got != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+
+	if got := nationalDay().BusinessDaysBetween(date(2024, time.September, 28), date(2024, time.October, 14)); got != 7 {
+		t.Errorf("Expected %v, but got %v", 7, got)
+	}
+}
+
+func TestBusinessDays(t *testing.T) {
+	cal := NewBusinessCalendar(BusinessOptions{Weekend: []time.Weekday{time.Friday}})
+	got := cal.BusinessDays(date(2024, time.May, 16), date(2024, time.May, 18))
+	expected := []time.Time{date(2024, time.May, 16), date(2024, time.May, 18)}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+}
+
+func TestNewBusinessCalendarAllWeekend(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected panic when every day is weekend")
+		}
+	}()
+	NewBusinessCalendar(BusinessOptions{Weekend: []time.Weekday{0, 1, 2, 3, 4, 5, 6}})
+}
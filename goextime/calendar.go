@@ -0,0 +1,164 @@
+package goextime
+
+import (
+	"time"
+)
+
+// Unit 是日历单位。
+type Unit int
+
+const (
+	// Day 表示自然日。
+	Day Unit = iota
+	// Week 表示自然周。
+	Week
+	// Month 表示自然月。
+	Month
+	// Quarter 表示季度。
+	Quarter
+	// Year 表示自然年。
+	Year
+)
+
+// String 返回单位的名称。
+func (u Unit) String() string {
+	switch u {
+	case Day:
+		return "day"
+	case Week:
+		return "week"
+	case Month:
+		return "month"
+	case Quarter:
+		return "quarter"
+	case Year:
+		return "year"
+	default:
+		return "unknown"
+	}
+}
+
+// MARK: - Start / End
+
+// StartOf 返回 t 所在日历单位的起始时刻，结果与 t 位于同一时区。周以星期一为第一天，
+// 需要其他起始日时使用 StartOfWeek。
+//
+// 参数：
+//   - t: 时间。
+//   - unit: 日历单位，未知的单位会 panic。
+//
+// 示例：
+//   - StartOf(2024-05-17 15:04, Month) 返回 2024-05-01 00:00。
+//   - StartOf(2024-05-17 15:04, Quarter) 返回 2024-04-01 00:00。
+func StartOf(t time.Time, unit Unit) time.Time {
+	year, month, day := t.Date()
+	switch unit {
+	case Day:
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	case Week:
+		return StartOfWeek(t, time.Monday)
+	case Month:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	case Quarter:
+		return time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, t.Location())
+	case Year:
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, t.Location())
+	default:
+		panic("goextime: unknown unit")
+	}
+}
+
+// EndOf 返回 t 所在日历单位的最后一个时刻，即下一个单位起始时刻的前一纳秒。
+//
+// 示例：
+//   - EndOf(2024-02-10, Month) 返回 2024-02-29 23:59:59.999999999。
+func EndOf(t time.Time, unit Unit) time.Time {
+	return Add(StartOf(t, unit), 1, unit).Add(-time.Nanosecond)
+}
+
+// StartOfWeek 返回 t 所在周的起始时刻，first 指定每周的第一天。
+//
+// 示例：
+//   - StartOfWeek(2024-05-15 (星期三), time.Sunday) 返回 2024-05-12 00:00。
+func StartOfWeek(t time.Time, first time.Weekday) time.Time {
+	offset := (int(t.Weekday()) - int(first) + 7) % 7
+	year, month, day := t.Date()
+	return time.Date(year, month, day-offset, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfWeek 返回 t 所在周的最后一个时刻，first 指定每周的第一天。
+func EndOfWeek(t time.Time, first time.Weekday) time.Time {
+	return StartOfWeek(t, first).AddDate(0, 0, 7).Add(-time.Nanosecond)
+}
+
+// MARK: - Arithmetic
+
+// Add 在 t 上增加 n 个日历单位，n 可以为负数。按月、季度、年增加时，超出目标月份天数的日期取该月最后一天。
+//
+// 示例：
+//   - Add(2024-01-31, 1, Month) 返回 2024-02-29。
+//   - Add(2024-05-17, -2, Week) 返回 2024-05-03。
+func Add(t time.Time, n int, unit Unit) time.Time {
+	switch unit {
+	case Day:
+		return t.AddDate(0, 0, n)
+	case Week:
+		return t.AddDate(0, 0, 7*n)
+	case Month:
+		return AddMonths(t, n)
+	case Quarter:
+		return AddMonths(t, 3*n)
+	case Year:
+		return AddYears(t, n)
+	default:
+		panic("goextime: unknown unit")
+	}
+}
+
+// AddMonths 在 t 上增加 n 个月，超出目标月份天数的日期取该月最后一天，时分秒保持不变。
+//
+// 与 time.Time.AddDate 不同，1 月 31 日加一个月得到 2 月的最后一天，而不是 3 月初。
+//
+// 示例：
+//   - AddMonths(2023-01-31, 1) 返回 2023-02-28。
+//   - AddMonths(2024-03-31, -1) 返回 2024-02-29。
+func AddMonths(t time.Time, n int) time.Time {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+
+	// 先定位到目标月份的 1 日，time.Date 会规范化超出范围的月份
+	first := time.Date(year, month+time.Month(n), 1, 0, 0, 0, 0, t.Location())
+	day = min(day, DaysIn(first.Year(), first.Month()))
+	return time.Date(first.Year(), first.Month(), day, hour, minute, second, t.Nanosecond(), t.Location())
+}
+
+// AddYears 在 t 上增加 n 年，闰年 2 月 29 日在非闰年取 2 月 28 日。
+func AddYears(t time.Time, n int) time.Time {
+	return AddMonths(t, 12*n)
+}
+
+// DaysIn 返回指定年份月份的天数。
+//
+// 示例：
+//   - DaysIn(2024, time.February) 返回 29。
+func DaysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// SameDay 判断两个时间是否在同一个自然日，各自按自身的时区取日期。
+func SameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+// DaysBetween 返回从 a 所在日期到 b 所在日期相差的自然日数，b 早于 a 时为负数。
+//
+// 只比较日期，不受时分秒与夏令时影响，例如 23:59 到次日 00:01 相差 1 天。
+func DaysBetween(a, b time.Time) int {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	start := time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)
+	end := time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC)
+	return int((end.Unix() - start.Unix()) / 86400)
+}
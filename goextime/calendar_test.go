@@ -0,0 +1,176 @@
+package goextime
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestStartOf(t *testing.T) {
+	now := time.Date(2024, time.May, 15, 15, 4, 5, 6, time.UTC) // 星期三
+	tests := []struct {
+		unit     Unit
+		expected time.Time
+	}{
+		{Day, date(2024, time.May, 15)},
+		{Week, date(2024, time.May, 13)},
+		{Month, date(2024, time.May, 1)},
+		{Quarter, date(2024, time.April, 1)},
+		{Year, date(2024, time.January, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.unit.String(), func(t *testing.T) {
+			if got := StartOf(now, tt.unit); !got.Equal(tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestEndOf(t *testing.T) {
+	now := time.Date(2024, time.February, 10, 8, 0, 0, 0, time.UTC)
+	tests := []struct {
+		unit     Unit
+		expected time.Time
+	}{
+		{Day, time.Date(2024, time.February, 10, 23, 59, 59, 999999999, time.UTC)},
+		{Week, time.Date(2024, time.February, 11, 23, 59, 59, 999999999, time.UTC)},
+		{Month, time.Date(2024, time.February, 29, 23, 59, 59, 999999999, time.UTC)},
+		{Quarter, time.Date(2024, time.March, 31, 23, 59, 59, 999999999, time.UTC)},
+		{Year, time.Date(2024, time.December, 31, 23, 59, 59, 999999999, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.unit.String(), func(t *testing.T) {
+			if got := EndOf(now, tt.unit); !got.Equal(tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestStartOfWeek(t *testing.T) {
+	wednesday := time.Date(2024, time.May, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		first    time.Weekday
+		expected time.Time
+	}{
+		{time.Sunday, date(2024, time.May, 12)},
+		{time.Monday, date(2024, time.May, 13)},
+		{time.Wednesday, date(2024, time.May, 15)},
+		{time.Thursday, date(2024, time.May, 9)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.first.String(), func(t *testing.T) {
+			if got := StartOfWeek(wednesday, tt.first); !got.Equal(tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+			expectedEnd := tt.expected.AddDate(0, 0, 7).Add(-time.Nanosecond)
+			if got := EndOfWeek(wednesday, tt.first); !got.Equal(expectedEnd) {
+				t.Errorf("Expected %v, but got %v", expectedEnd, got)
+			}
+		})
+	}
+}
+
+func TestStartOfKeepsLocation(t *testing.T) {
+	loc := time.FixedZone("CST", 8*60*60)
+	// UTC 时间 5 月 14 日 20:00 在东八区已是 5 月 15 日
+	now := time.Date(2024, time.May, 14, 20, 0, 0, 0, time.UTC).In(loc)
+	expected := time.Date(2024, time.May, 15, 0, 0, 0, 0, loc)
+	if got := StartOf(now, Day); !got.Equal(expected) || got.Location() != loc {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+}
+
+func TestAddMonths(t *testing.T) {
+	tests := []struct {
+		name     string
+		t        time.Time
+		n        int
+		expected time.Time
+	}{
+		{"clamp leap", date(2024, time.January, 31), 1, date(2024, time.February, 29)},
+		{"clamp", date(2023, time.January, 31), 1, date(2023, time.February, 28)},
+		{"backward", date(2024, time.March, 31), -1, date(2024, time.February, 29)},
+		{"across year", date(2024, time.November, 30), 3, date(2025, time.February, 28)},
+		{"backward across year", date(2024, time.January, 15), -13, date(2022, time.December, 15)},
+		{"keeps clock", time.Date(2024, time.May, 31, 9, 30, 0, 7, time.UTC), 1, time.Date(2024, time.June, 30, 9, 30, 0, 7, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AddMonths(tt.t, tt.n); !got.Equal(tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestAdd(t *testing.T) {
+	leap := date(2024, time.February, 29)
+	tests := []struct {
+		unit     Unit
+		n        int
+		expected time.Time
+	}{
+		{Day, 1, date(2024, time.March, 1)},
+		{Week, -2, date(2024, time.February, 15)},
+		{Month, 12, date(2025, time.February, 28)},
+		{Quarter, 1, date(2024, time.May, 29)},
+		{Year, 4, date(2028, time.February, 29)},
+		{Year, 1, date(2025, time.February, 28)},
+	}
+	for _, tt := range tests {
+		if got := Add(leap, tt.n, tt.unit); !got.Equal(tt.expected) {
+			t.Errorf("Add(%v, %v): Expected %v, but got %v", tt.n, tt.unit, tt.expected, got)
+		}
+	}
+}
+
+func TestDaysIn(t *testing.T) {
+	tests := []struct {
+		year     int
+		month    time.Month
+		expected int
+	}{
+		{2024, time.February, 29},
+		{2023, time.February, 28},
+		{1900, time.February, 28},
+		{2000, time.February, 29},
+		{2024, time.April, 30},
+		{2024, time.December, 31},
+	}
+	for _, tt := range tests {
+		if got := DaysIn(tt.year, tt.month); got != tt.expected {
+			t.Errorf("DaysIn(%v, %v): Expected %v, but got %v", tt.year, tt.month, tt.expected, got)
+		}
+	}
+}
+
+func TestDaysBetween(t *testing.T) {
+	late := time.Date(2024, time.February, 28, 23, 59, 0, 0, time.UTC)
+	early := time.Date(2024, time.February, 29, 0, 1, 0, 0, time.UTC)
+	if got := DaysBetween(late, early); got != 1 {
+		t.Errorf("Expected %v, but got %v", 1, got)
+	}
+	if got := DaysBetween(date(2025, time.January, 1), date(2024, time.January, 1)); got != -366 {
+		t.Errorf("Expected %v, but got %v", -366, got)
+	}
+	if !SameDay(late, late.Add(-time.Hour)) || SameDay(late, early) {
+		t.Errorf("Expected SameDay to compare dates")
+	}
+}
+
+func TestUnknownUnit(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected panic for unknown unit")
+		}
+	}()
+	if Unit(9).String() != "unknown" {
+		t.Errorf("Expected %v, but got %v", "unknown", Unit(9).String())
+	}
+	StartOf(time.Now(), Unit(9))
+}
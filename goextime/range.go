@@ -0,0 +1,35 @@
+package goextime
+
+import (
+	"time"
+)
+
+// Range 返回从 start 开始、每次增加 step 个日历单位、不晚于 end 的时间切片。
+//
+// 第 i 个值按 Add(start, i*step, unit) 计算，因此按月递增时月末日期不会逐渐漂移。
+// step 必须为正数，否则会 panic；start 晚于 end 时返回空切片。
+//
+// 示例：
+//   - Range(2024-01-31, 2024-04-30, Month, 1) 返回 [2024-01-31 2024-02-29 2024-03-31 2024-04-30]。
+func Range(start, end time.Time, unit Unit, step int) []time.Time {
+	if step <= 0 {
+		panic("goextime: range step must be positive")
+	}
+
+	var result []time.Time
+	for i := 0; ; i++ {
+		t := Add(start, i*step, unit)
+		if t.After(end) {
+			return result
+		}
+		result = append(result, t)
+	}
+}
+
+// Days 返回从 start 所在日期到 end 所在日期（包含两端）每一天的零点。
+//
+// 示例：
+//   - Days(2024-02-27 15:00, 2024-03-01 09:00) 返回 [2024-02-27 2024-02-28 2024-02-29 2024-03-01]。
+func Days(start, end time.Time) []time.Time {
+	return Range(StartOf(start, Day), end, Day, 1)
+}
@@ -0,0 +1,69 @@
+package goextime
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end time.Time
+		unit       Unit
+		step       int
+		expected   []time.Time
+	}{
+		{
+			"month end",
+			date(2024, time.January, 31), date(2024, time.April, 30), Month, 1,
+			[]time.Time{date(2024, time.January, 31), date(2024, time.February, 29), date(2024, time.March, 31), date(2024, time.April, 30)},
+		},
+		{
+			"weeks exclusive",
+			date(2024, time.May, 1), date(2024, time.May, 14), Week, 1,
+			[]time.Time{date(2024, time.May, 1), date(2024, time.May, 8)},
+		},
+		{
+			"step",
+			date(2024, time.May, 1), date(2024, time.May, 7), Day, 3,
+			[]time.Time{date(2024, time.May, 1), date(2024, time.May, 4), date(2024, time.May, 7)},
+		},
+		{
+			"reversed",
+			date(2024, time.May, 2), date(2024, time.May, 1), Day, 1,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Range(tt.start, tt.end, tt.unit, tt.step)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRangeInvalidStep(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected panic for zero step")
+		}
+	}()
+	Range(date(2024, time.May, 1), date(2024, time.May, 2), Day, 0)
+}
+
+func TestDays(t *testing.T) {
+	start := time.Date(2024, time.February, 27, 15, 0, 0, 0, time.UTC)
+	end := time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)
+	expected := []time.Time{
+		date(2024, time.February, 27),
+		date(2024, time.February, 28),
+		date(2024, time.February, 29),
+		date(2024, time.March, 1),
+	}
+	if got := Days(start, end); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+}
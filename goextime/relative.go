@@ -0,0 +1,113 @@
+package goextime
+
+import (
+	"fmt"
+	"time"
+)
+
+// Language 是相对时间文本使用的语言。
+type Language int
+
+const (
+	// Chinese 输出如 "3 天前"、"2 小时后"。
+	Chinese Language = iota
+	// English 输出如 "3 days ago"、"in 2 hours"。
+	English
+)
+
+// String 返回语言的名称。
+func (l Language) String() string {
+	switch l {
+	case Chinese:
+		return "chinese"
+	case English:
+		return "english"
+	default:
+		return "unknown"
+	}
+}
+
+// relativeUnit 是相对时间文本中的一个单位，limit 是使用该单位的时长上限。
+type relativeUnit struct {
+	size    time.Duration
+	limit   time.Duration
+	chinese string
+	english string
+}
+
+const (
+	day   = 24 * time.Hour
+	month = 30 * day
+	year  = 365 * day
+)
+
+var relativeUnits = []relativeUnit{
+	{time.Minute, time.Hour, "分钟", "minute"},
+	{time.Hour, day, "小时", "hour"},
+	{day, 7 * day, "天", "day"},
+	{7 * day, month, "周", "week"},
+	{month, year, "个月", "month"},
+	{year, 0, "年", "year"},
+}
+
+// FormatRelative 返回 t 相对于 now 的可读文本，类似 Swift 中的 RelativeDateTimeFormatter。
+//
+// 相差不足一分钟时返回 "刚刚" 或 "just now"；否则依次使用分钟、小时、天、周、月、年中
+// 最大的合适单位，数值向下取整。月按 30 天、年按 365 天计算。
+//
+// 参数：
+//   - t: 要描述的时间。
+//   - now: 当前时间，测试中可以使用 goexclock.Fake 的 Now()。
+//   - lang: 输出语言，未知的语言按 English 处理。
+//
+// 示例：
+//   - FormatRelative(now.Add(-72*time.Hour), now, Chinese) 返回 "3 天前"。
+//   - FormatRelative(now.Add(90*time.Minute), now, English) 返回 "in 1 hour"。
+func FormatRelative(t, now time.Time, lang Language) string {
+	// 分别按方向相减，Sub 结果超出范围时饱和为 MaxInt64，而 MinInt64 取反仍为负数
+	future := t.After(now)
+	d := now.Sub(t)
+	if future {
+		d = t.Sub(now)
+	}
+
+	if d < time.Minute {
+		if lang == Chinese {
+			return "刚刚"
+		}
+		return "just now"
+	}
+
+	unit := relativeUnits[len(relativeUnits)-1]
+	for _, u := range relativeUnits {
+		if d < u.limit {
+			unit = u
+			break
+		}
+	}
+	n := int64(d / unit.size)
+	if unit.size == year {
+		// 超过约 292 年时 Duration 已饱和，年数改用不会溢出的秒数计算
+		seconds := now.Unix() - t.Unix()
+		if future {
+			seconds = -seconds
+		}
+		n = seconds / int64(year/time.Second)
+	}
+
+	if lang == Chinese {
+		if future {
+			return fmt.Sprintf("%d %s后", n, unit.chinese)
+		}
+		return fmt.Sprintf("%d %s前", n, unit.chinese)
+	}
+
+	name := unit.english
+	if n != 1 {
+		name += "s"
+	}
+	if future {
+		return fmt.Sprintf("in %d %s", n, name)
+	}
+	return fmt.Sprintf("%d %s ago", n, name)
+}
@@ -0,0 +1,72 @@
+package goextime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/birdmichael/GoEx/goexclock"
+)
+
+func TestFormatRelative(t *testing.T) {
+	clock := goexclock.NewFake(time.Date(2024, time.May, 17, 12, 0, 0, 0, time.UTC))
+	now := clock.Now()
+	tests := []struct {
+		offset  time.Duration
+		chinese string
+		english string
+	}{
+		{0, "刚刚", "just now"},
+		{-59 * time.Second, "刚刚", "just now"},
+		{-time.Minute, "1 分钟前", "1 minute ago"},
+		{-45 * time.Minute, "45 分钟前", "45 minutes ago"},
+		{90 * time.Minute, "1 小时后", "in 1 hour"},
+		{-72 * time.Hour, "3 天前", "3 days ago"},
+		{-7 * day, "1 周前", "1 week ago"},
+		{20 * day, "2 周后", "in 2 weeks"},
+		{-65 * day, "2 个月前", "2 months ago"},
+		{-400 * day, "1 年前", "1 year ago"},
+		{3 * year, "3 年后", "in 3 years"},
+	}
+	for _, tt := range tests {
+		target := now.Add(tt.offset)
+		if got := FormatRelative(target, now, Chinese); got != tt.chinese {
+			t.Errorf("Expected %v, but got %v", tt.chinese, got)
+		}
+		if got := FormatRelative(target, now, English); got != tt.english {
+			t.Errorf("Expected %v, but got %v", tt.english, got)
+		}
+	}
+}
+
+func TestFormatRelativeFarAway(t *testing.T) {
+	now := time.Date(2024, time.May, 17, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		t        time.Time
+		lang     Language
+		expected string
+	}{
+		{"zero time", time.Time{}, English, "2024 years ago"},
+		{"300 years ago", now.AddDate(-300, 0, 0), Chinese, "300 年前"},
+		{"300 years later", now.AddDate(300, 0, 0), English, "in 300 years"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatRelative(tt.t, now, tt.lang); got != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestFormatRelativeWithClock(t *testing.T) {
+	clock := goexclock.NewFake(time.Date(2024, time.May, 17, 12, 0, 0, 0, time.UTC))
+	posted := clock.Now()
+	clock.Advance(3 * 24 * time.Hour)
+	if got := FormatRelative(posted, clock.Now(), Chinese); got != "3 天前" {
+		t.Errorf("Expected %v, but got %v", "3 天前", got)
+	}
+	if got := Language(9).String(); got != "unknown" {
+		t.Errorf("Expected %v, but got %v", "unknown", got)
+	}
+}
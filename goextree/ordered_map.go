@@ -0,0 +1,428 @@
+package goextree
+
+import (
+	"cmp"
+	"errors"
+
+	"github.com/birdmichael/GoEx/goexslice"
+	"github.com/birdmichael/GoEx/tupleext"
+)
+
+// ErrNotSorted 表示批量加载的数据没有按 key 严格递增排列。
+var ErrNotSorted = errors.New("goextree: entries are not strictly sorted by key")
+
+// OrderedMap 是一个按 key 有序的 map，基于维护子树大小的 AVL 树实现。
+//
+// 查找、插入、删除、Floor/Ceiling 与 Rank/Select 的时间复杂度均为 O(log n)。
+// OrderedMap 不是并发安全的。
+//
+// 参数：
+//   - K: key 的类型。
+//   - V: value 的类型。
+type OrderedMap[K, V any] struct {
+	root *node[K, V]
+	less goexslice.Comparator[K]
+}
+
+type node[K, V any] struct {
+	key         K
+	value       V
+	left, right *node[K, V]
+	height      int
+	size        int
+}
+
+// New 创建一个按 key 的自然顺序排列的 OrderedMap。
+func New[K cmp.Ordered, V any]() *OrderedMap[K, V] {
+	return NewFunc[K, V](cmp.Less[K])
+}
+
+// NewFunc 创建一个按 less 排列的 OrderedMap。
+//
+// 参数：
+//   - less: 当 value1 应排在 value2 之前时返回 true；两个 key 互不小于对方时视为相同。
+//
+// 示例：
+//
+//	byLength := NewFunc[string, int](func(a, b string) bool { return len(a) < len(b) })
+func NewFunc[K, V any](less goexslice.Comparator[K]) *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{less: less}
+}
+
+// FromSorted 从按 key 严格递增的数据批量构建 OrderedMap，时间复杂度为 O(n)。
+//
+// 返回值：
+//   - 新的 OrderedMap。
+//   - 数据没有严格递增时为 ErrNotSorted。
+func FromSorted[K cmp.Ordered, V any](entries []tupleext.Tuple[K, V]) (*OrderedMap[K, V], error) {
+	return FromSortedFunc(cmp.Less[K], entries)
+}
+
+// FromSortedFunc 与 FromSorted 相同，但按 less 判断顺序。
+func FromSortedFunc[K, V any](less goexslice.Comparator[K], entries []tupleext.Tuple[K, V]) (*OrderedMap[K, V], error) {
+	for i := 1; i < len(entries); i++ {
+		if !less(entries[i-1].S1, entries[i].S1) {
+			return nil, ErrNotSorted
+		}
+	}
+	return &OrderedMap[K, V]{root: build(entries), less: less}, nil
+}
+
+// build 以中间元素为根递归构建树，左右子树大小之差不超过 1，因此满足 AVL 的平衡条件。
+func build[K, V any](entries []tupleext.Tuple[K, V]) *node[K, V] {
+	if len(entries) == 0 {
+		return nil
+	}
+	mid := len(entries) / 2
+	n := &node[K, V]{
+		key:   entries[mid].S1,
+		value: entries[mid].S2,
+		left:  build(entries[:mid]),
+		right: build(entries[mid+1:]),
+	}
+	n.update()
+	return n
+}
+
+// MARK: - Read
+
+// Len 返回键值对的个数。
+func (m *OrderedMap[K, V]) Len() int {
+	return m.root.len()
+}
+
+// Get 返回 key 对应的值。
+//
+// 返回值：
+//   - value: key 对应的值，不存在时为零值。
+//   - ok: key 是否存在。
+func (m *OrderedMap[K, V]) Get(key K) (value V, ok bool) {
+	n := m.root
+	for n != nil {
+		switch {
+		case m.less(key, n.key):
+			n = n.left
+		case m.less(n.key, key):
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+	return value, false
+}
+
+// Has 判断 key 是否存在。
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Min 返回最小的 key 及其值，map 为空时 ok 为 false。
+func (m *OrderedMap[K, V]) Min() (key K, value V, ok bool) {
+	n := m.root
+	if n == nil {
+		return key, value, false
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return n.key, n.value, true
+}
+
+// Max 返回最大的 key 及其值，map 为空时 ok 为 false。
+func (m *OrderedMap[K, V]) Max() (key K, value V, ok bool) {
+	n := m.root
+	if n == nil {
+		return key, value, false
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n.key, n.value, true
+}
+
+// Floor 返回小于等于 key 的最大 key 及其值，不存在时 ok 为 false。
+func (m *OrderedMap[K, V]) Floor(key K) (k K, v V, ok bool) {
+	var found *node[K, V]
+	for n := m.root; n != nil; {
+		if m.less(key, n.key) {
+			n = n.left
+		} else {
+			found = n
+			n = n.right
+		}
+	}
+	return found.entry()
+}
+
+// Ceiling 返回大于等于 key 的最小 key 及其值，不存在时 ok 为 false。
+func (m *OrderedMap[K, V]) Ceiling(key K) (k K, v V, ok bool) {
+	var found *node[K, V]
+	for n := m.root; n != nil; {
+		if m.less(n.key, key) {
+			n = n.right
+		} else {
+			found = n
+			n = n.left
+		}
+	}
+	return found.entry()
+}
+
+// Rank 返回小于 key 的 key 的个数，即 key 在有序序列中的位置（从 0 开始）。key 不必存在。
+func (m *OrderedMap[K, V]) Rank(key K) int {
+	rank := 0
+	for n := m.root; n != nil; {
+		if m.less(n.key, key) {
+			rank += n.left.len() + 1
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return rank
+}
+
+// Select 返回有序序列中第 i 个（从 0 开始）key 及其值，i 越界时 ok 为 false。
+func (m *OrderedMap[K, V]) Select(i int) (key K, value V, ok bool) {
+	if i < 0 || i >= m.Len() {
+		return key, value, false
+	}
+	n := m.root
+	for {
+		left := n.left.len()
+		switch {
+		case i < left:
+			n = n.left
+		case i > left:
+			i -= left + 1
+			n = n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+}
+
+// Ascend 按 key 从小到大遍历，回调返回 false 时停止遍历。
+func (m *OrderedMap[K, V]) Ascend(fn func(key K, value V) bool) {
+	m.root.ascend(fn)
+}
+
+// Descend 按 key 从大到小遍历，回调返回 false 时停止遍历。
+func (m *OrderedMap[K, V]) Descend(fn func(key K, value V) bool) {
+	m.root.descend(fn)
+}
+
+// Range 按 key 从小到大遍历区间 [lo, hi) 中的键值对，回调返回 false 时停止遍历。
+//
+// 示例：
+//
+//	m.Range(10, 20, func(k int, v string) bool { fmt.Println(k, v); return true })
+func (m *OrderedMap[K, V]) Range(lo, hi K, fn func(key K, value V) bool) {
+	m.rangeNode(m.root, lo, hi, fn)
+}
+
+func (m *OrderedMap[K, V]) rangeNode(n *node[K, V], lo, hi K, fn func(key K, value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	aboveLo := !m.less(n.key, lo)
+	belowHi := m.less(n.key, hi)
+	if aboveLo && !m.rangeNode(n.left, lo, hi, fn) {
+		return false
+	}
+	if aboveLo && belowHi && !fn(n.key, n.value) {
+		return false
+	}
+	if belowHi {
+		return m.rangeNode(n.right, lo, hi, fn)
+	}
+	return true
+}
+
+// Keys 返回按顺序排列的所有 key。
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Len())
+	m.Ascend(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values 返回按 key 的顺序排列的所有值。
+func (m *OrderedMap[K, V]) Values() []V {
+	values := make([]V, 0, m.Len())
+	m.Ascend(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// Entries 返回按 key 的顺序排列的所有键值对，可以直接传给 FromSorted。
+func (m *OrderedMap[K, V]) Entries() []tupleext.Tuple[K, V] {
+	entries := make([]tupleext.Tuple[K, V], 0, m.Len())
+	m.Ascend(func(key K, value V) bool {
+		entries = append(entries, tupleext.Tuple[K, V]{S1: key, S2: value})
+		return true
+	})
+	return entries
+}
+
+// MARK: - Write
+
+// Set 设置 key 对应的值；已存在相同的 key 时保留原有的 key，只更新值。
+//
+// 返回值：
+//   - 如果 key 原本不存在，返回 true。
+func (m *OrderedMap[K, V]) Set(key K, value V) bool {
+	var added bool
+	m.root = m.insert(m.root, key, value, &added)
+	return added
+}
+
+// Delete 删除 key。
+//
+// 返回值：
+//   - 如果 key 存在，返回 true。
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	var deleted bool
+	m.root = m.delete(m.root, key, &deleted)
+	return deleted
+}
+
+// Clear 删除所有键值对。
+func (m *OrderedMap[K, V]) Clear() {
+	m.root = nil
+}
+
+func (m *OrderedMap[K, V]) insert(n *node[K, V], key K, value V, added *bool) *node[K, V] {
+	if n == nil {
+		*added = true
+		return &node[K, V]{key: key, value: value, height: 1, size: 1}
+	}
+	switch {
+	case m.less(key, n.key):
+		n.left = m.insert(n.left, key, value, added)
+	case m.less(n.key, key):
+		n.right = m.insert(n.right, key, value, added)
+	default:
+		n.value = value
+		return n
+	}
+	return n.rebalance()
+}
+
+func (m *OrderedMap[K, V]) delete(n *node[K, V], key K, deleted *bool) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+	switch {
+	case m.less(key, n.key):
+		n.left = m.delete(n.left, key, deleted)
+	case m.less(n.key, key):
+		n.right = m.delete(n.right, key, deleted)
+	default:
+		*deleted = true
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		var successor *node[K, V]
+		n.right = n.right.removeMin(&successor)
+		successor.left, successor.right = n.left, n.right
+		n = successor
+	}
+	return n.rebalance()
+}
+
+// MARK: - Node
+
+func (n *node[K, V]) len() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *node[K, V]) h() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *node[K, V]) entry() (key K, value V, ok bool) {
+	if n == nil {
+		return key, value, false
+	}
+	return n.key, n.value, true
+}
+
+func (n *node[K, V]) update() {
+	n.height = max(n.left.h(), n.right.h()) + 1
+	n.size = n.left.len() + n.right.len() + 1
+}
+
+func (n *node[K, V]) rotateLeft() *node[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.update()
+	r.update()
+	return r
+}
+
+func (n *node[K, V]) rotateRight() *node[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.update()
+	l.update()
+	return l
+}
+
+// rebalance 更新 n 的高度与大小，并在左右子树高度差超过 1 时旋转。
+func (n *node[K, V]) rebalance() *node[K, V] {
+	n.update()
+	switch balance := n.left.h() - n.right.h(); {
+	case balance > 1:
+		if n.left.left.h() < n.left.right.h() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case balance < -1:
+		if n.right.right.h() < n.right.left.h() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+// removeMin 从以 n 为根的子树中移除最小的节点并通过 out 返回。
+func (n *node[K, V]) removeMin(out **node[K, V]) *node[K, V] {
+	if n.left == nil {
+		*out = n
+		return n.right
+	}
+	n.left = n.left.removeMin(out)
+	return n.rebalance()
+}
+
+func (n *node[K, V]) ascend(fn func(key K, value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.left.ascend(fn) && fn(n.key, n.value) && n.right.ascend(fn)
+}
+
+func (n *node[K, V]) descend(fn func(key K, value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.right.descend(fn) && fn(n.key, n.value) && n.left.descend(fn)
+}
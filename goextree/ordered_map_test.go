@@ -0,0 +1,295 @@
+package goextree
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/birdmichael/GoEx/tupleext"
+)
+
+// checkInvariants 检查 AVL 树的平衡条件、有序性以及高度与大小的维护是否正确。
+func checkInvariants[K, V any](t *testing.T, m *OrderedMap[K, V]) {
+	t.Helper()
+	var check func(n *node[K, V]) (height, size int)
+	check = func(n *node[K, V]) (int, int) {
+		if n == nil {
+			return 0, 0
+		}
+		lh, ls := check(n.left)
+		rh, rs := check(n.right)
+		if lh-rh > 1 || rh-lh > 1 {
+			t.Fatalf("Unbalanced node %v: %d vs %d", n.key, lh, rh)
+		}
+		if n.left != nil && !m.less(n.left.key, n.key) || n.right != nil && !m.less(n.key, n.right.key) {
+			t.Fatalf("Unordered node %v", n.key)
+		}
+		if n.height != max(lh, rh)+1 || n.size != ls+rs+1 {
+			t.Fatalf("Stale augmentation at node %v", n.key)
+		}
+		return n.height, n.size
+	}
+	check(m.root)
+}
+
+func TestOrderedMap_Basic(t *testing.T) {
+	m := New[int, string]()
+	for _, k := range []int{5, 3, 8, 1, 4} {
+		if !m.Set(k, "v") {
+			t.Errorf("Expected Set(%d) to add a new key", k)
+		}
+	}
+	if m.Set(3, "three") {
+		t.Errorf("Expected Set to replace an existing key")
+	}
+
+	if v, ok := m.Get(3); !ok || v != "three" {
+		t.Errorf("Expected three, but got %v", v)
+	}
+	if m.Has(7) || m.Len() != 5 {
+		t.Errorf("Unexpected state %v", m.Keys())
+	}
+	if !reflect.DeepEqual(m.Keys(), []int{1, 3, 4, 5, 8}) {
+		t.Errorf("Unexpected keys %v", m.Keys())
+	}
+
+	if k, _, ok := m.Min(); !ok || k != 1 {
+		t.Errorf("Expected %v, but got %v", 1, k)
+	}
+	if k, _, ok := m.Max(); !ok || k != 8 {
+		t.Errorf("Expected %v, but got %v", 8, k)
+	}
+
+	if !m.Delete(5) || m.Delete(5) {
+		t.Errorf("Expected Delete to report existence")
+	}
+	if !reflect.DeepEqual(m.Keys(), []int{1, 3, 4, 8}) {
+		t.Errorf("Unexpected keys %v", m.Keys())
+	}
+	checkInvariants(t, m)
+
+	m.Clear()
+	if _, _, ok := m.Min(); ok || m.Len() != 0 {
+		t.Errorf("Expected empty map after Clear")
+	}
+	if _, _, ok := m.Max(); ok {
+		t.Errorf("Expected Max of empty map to fail")
+	}
+}
+
+func TestOrderedMap_FloorCeiling(t *testing.T) {
+	m := New[int, int]()
+	for _, k := range []int{10, 20, 30} {
+		m.Set(k, k*10)
+	}
+
+	tests := []struct {
+		key               int
+		floor, ceiling    int
+		hasFloor, hasCeil bool
+	}{
+		{5, 0, 10, false, true},
+		{10, 10, 10, true, true},
+		{15, 10, 20, true, true},
+		{30, 30, 30, true, true},
+		{35, 30, 0, true, false},
+	}
+	for _, tt := range tests {
+		k, v, ok := m.Floor(tt.key)
+		if ok != tt.hasFloor || k != tt.floor || ok && v != k*10 {
+			t.Errorf("Floor(%d): expected %v, but got %v", tt.key, tt.floor, k)
+		}
+		k, _, ok = m.Ceiling(tt.key)
+		if ok != tt.hasCeil || k != tt.ceiling {
+			t.Errorf("Ceiling(%d): expected %v, but got %v", tt.key, tt.ceiling, k)
+		}
+	}
+}
+
+func TestOrderedMap_Range(t *testing.T) {
+	m := New[int, int]()
+	for i := 0; i < 20; i += 2 {
+		m.Set(i, i)
+	}
+
+	collect := func(lo, hi int, limit int) []int {
+		var keys []int
+		m.Range(lo, hi, func(k, v int) bool {
+			keys = append(keys, k)
+			return len(keys) < limit
+		})
+		return keys
+	}
+
+	tests := []struct {
+		name     string
+		lo, hi   int
+		limit    int
+		expected []int
+	}{
+		{"Inclusive", 4, 10, 100, []int{4, 6, 8}},
+		{"Between", 3, 9, 100, []int{4, 6, 8}},
+		{"All", -1, 100, 100, []int{0, 2, 4, 6, 8, 10, 12, 14, 16, 18}},
+		{"Empty", 5, 5, 100, nil},
+		{"Reversed", 10, 4, 100, nil},
+		{"Stop", 0, 100, 2, []int{0, 2}},
+	}
+	for _, tt := range tests {
+		t.Run("TestOrderedMap_Range_"+tt.name, func(t *testing.T) {
+			if got := collect(tt.lo, tt.hi, tt.limit); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+
+	var desc []int
+	m.Descend(func(k, v int) bool {
+		desc = append(desc, k)
+		return k > 14
+	})
+	if !reflect.DeepEqual(desc, []int{18, 16, 14}) {
+		t.Errorf("Unexpected descend %v", desc)
+	}
+}
+
+func TestOrderedMap_RankSelect(t *testing.T) {
+	m := New[string, int]()
+	for i, k := range []string{"d", "b", "a", "c", "e"} {
+		m.Set(k, i)
+	}
+
+	for i, k := range []string{"a", "b", "c", "d", "e"} {
+		if got := m.Rank(k); got != i {
+			t.Errorf("Rank(%q): expected %v, but got %v", k, i, got)
+		}
+		if got, _, ok := m.Select(i); !ok || got != k {
+			t.Errorf("Select(%d): expected %v, but got %v", i, k, got)
+		}
+	}
+	if got := m.Rank("bb"); got != 2 {
+		t.Errorf("Expected %v, but got %v", 2, got)
+	}
+	if _, _, ok := m.Select(5); ok {
+		t.Errorf("Expected Select out of range to fail")
+	}
+	if _, _, ok := m.Select(-1); ok {
+		t.Errorf("Expected Select out of range to fail")
+	}
+}
+
+func TestOrderedMap_NewFunc(t *testing.T) {
+	// 按字符串长度排序，长度相同的 key 视为相同
+	m := NewFunc[string, int](func(a, b string) bool { return len(a) < len(b) })
+	m.Set("ccc", 3)
+	m.Set("a", 1)
+	m.Set("bb", 2)
+	m.Set("xx", 20)
+
+	if !reflect.DeepEqual(m.Keys(), []string{"a", "bb", "ccc"}) {
+		t.Errorf("Unexpected keys %v", m.Keys())
+	}
+	if v, _ := m.Get("zz"); v != 20 {
+		t.Errorf("Expected %v, but got %v", 20, v)
+	}
+}
+
+func TestFromSorted(t *testing.T) {
+	entries := make([]tupleext.Tuple[int, int], 1000)
+	for i := range entries {
+		entries[i] = tupleext.Tuple[int, int]{S1: i * 3, S2: i}
+	}
+
+	m, err := FromSorted(entries)
+	if err != nil {
+		t.Fatalf("Expected nil, but got %v", err)
+	}
+	checkInvariants(t, m)
+	if m.Len() != 1000 || !reflect.DeepEqual(m.Entries(), entries) {
+		t.Errorf("Expected entries to round-trip")
+	}
+	if k, v, _ := m.Select(500); k != 1500 || v != 500 {
+		t.Errorf("Expected (1500, 500), but got (%v, %v)", k, v)
+	}
+
+	m.Set(1, 1)
+	m.Delete(0)
+	checkInvariants(t, m)
+
+	for _, bad := range [][]tupleext.Tuple[int, int]{
+		{{S1: 2}, {S1: 1}},
+		{{S1: 1}, {S1: 1}},
+	} {
+		if _, err := FromSorted(bad); !errors.Is(err, ErrNotSorted) {
+			t.Errorf("Expected %v, but got %v", ErrNotSorted, err)
+		}
+	}
+	if m, err := FromSorted[int, int](nil); err != nil || m.Len() != 0 {
+		t.Errorf("Expected empty map, but got %v", err)
+	}
+}
+
+func TestOrderedMap_RandomOperations(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	m := New[int, int]()
+	model := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		k := rnd.Intn(500)
+		if rnd.Intn(3) == 0 {
+			_, existed := model[k]
+			delete(model, k)
+			if m.Delete(k) != existed {
+				t.Fatalf("Delete(%d) disagrees with model", k)
+			}
+		} else {
+			_, existed := model[k]
+			model[k] = i
+			if m.Set(k, i) == existed {
+				t.Fatalf("Set(%d) disagrees with model", k)
+			}
+		}
+	}
+	checkInvariants(t, m)
+
+	keys := make([]int, 0, len(model))
+	for k := range model {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	if !reflect.DeepEqual(m.Keys(), keys) {
+		t.Fatalf("Keys disagree with model")
+	}
+	for _, k := range keys {
+		if v, _ := m.Get(k); v != model[k] {
+			t.Errorf("Get(%d): expected %v, but got %v", k, model[k], v)
+		}
+	}
+	for q := -1; q <= 501; q++ {
+		i, _ := slices.BinarySearch(keys, q)
+		if got := m.Rank(q); got != i {
+			t.Errorf("Rank(%d): expected %v, but got %v", q, i, got)
+		}
+	}
+}
+
+func BenchmarkOrderedMap_Set(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	m := New[int, int]()
+	for i := 0; i < b.N; i++ {
+		m.Set(rnd.Int(), i)
+	}
+}
+
+func BenchmarkOrderedMap_Get(b *testing.B) {
+	m := New[int, int]()
+	for i := 0; i < 100000; i++ {
+		m.Set(i, i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.Get(i % 100000)
+	}
+}
@@ -0,0 +1,72 @@
+package goextrie
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Match 是模糊搜索的一个结果。
+type Match[E cmp.Ordered, V any] struct {
+	Key      []E
+	Value    V
+	Distance int // 与查询的编辑距离
+}
+
+// FuzzySearch 返回与 key 的编辑距离（Levenshtein 距离）不超过 maxDistance 的所有键值对。
+//
+// 搜索沿 Trie 逐层计算编辑距离矩阵的一行，某个子树中不可能存在满足条件的 key 时会被剪枝。
+//
+// 返回值：
+//   - 按编辑距离从小到大排列的结果，距离相同时按 key 的字典序排列。
+//
+// 示例：
+//   - 已插入 "apple" 与 "apply" 时，FuzzySearch("appel", 2) 返回两者。
+func (t *Trie[E, V]) FuzzySearch(key []E, maxDistance int) []Match[E, V] {
+	if maxDistance < 0 {
+		return nil
+	}
+	row := make([]int, len(key)+1)
+	for i := range row {
+		row[i] = i
+	}
+
+	var matches []Match[E, V]
+	var search func(n *node[E, V], path []E, row []int)
+	search = func(n *node[E, V], path []E, row []int) {
+		if n.hasValue && row[len(key)] <= maxDistance {
+			matches = append(matches, Match[E, V]{Key: slices.Clone(path), Value: n.value, Distance: row[len(key)]})
+		}
+		for _, c := range n.children {
+			// 行的最小值沿路径不会减小，超过 maxDistance 后整个子树都可以剪枝
+			next, pruned := row, false
+			for _, e := range c.label {
+				next = nextRow(key, next, e)
+				if slices.Min(next) > maxDistance {
+					pruned = true
+					break
+				}
+			}
+			if !pruned {
+				search(c, append(path, c.label...), next)
+			}
+		}
+	}
+	search(t.root, nil, row)
+
+	slices.SortStableFunc(matches, func(a, b Match[E, V]) int { return cmp.Compare(a.Distance, b.Distance) })
+	return matches
+}
+
+// nextRow 根据上一行计算编辑距离矩阵的下一行，e 为路径上新增的元素。
+func nextRow[E cmp.Ordered](key []E, prev []int, e E) []int {
+	row := make([]int, len(prev))
+	row[0] = prev[0] + 1
+	for i := 1; i < len(row); i++ {
+		cost := 1
+		if key[i-1] == e {
+			cost = 0
+		}
+		row[i] = min(row[i-1]+1, prev[i]+1, prev[i-1]+cost)
+	}
+	return row
+}
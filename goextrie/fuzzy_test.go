@@ -0,0 +1,53 @@
+package goextrie
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTrie_FuzzySearch(t *testing.T) {
+	for _, mode := range modes {
+		t.Run("TestTrie_FuzzySearch_"+mode.name, func(t *testing.T) {
+			tr := mode.new()
+			for i, k := range []string{"apple", "apply", "ape", "maple", "applesauce"} {
+				tr.Insert([]byte(k), i)
+			}
+
+			tests := []struct {
+				key      string
+				max      int
+				expected []string
+				dists    []int
+			}{
+				{"apple", 0, []string{"apple"}, []int{0}},
+				{"appel", 2, []string{"ape", "apple", "apply"}, []int{2, 2, 2}},
+				{"aple", 1, []string{"ape", "apple", "maple"}, []int{1, 1, 1}},
+				{"xyz", 2, nil, nil},
+				{"apple", -1, nil, nil},
+			}
+			for _, tt := range tests {
+				var keys []string
+				var dists []int
+				for _, m := range tr.FuzzySearch([]byte(tt.key), tt.max) {
+					keys = append(keys, string(m.Key))
+					dists = append(dists, m.Distance)
+				}
+				if !reflect.DeepEqual(keys, tt.expected) || !reflect.DeepEqual(dists, tt.dists) {
+					t.Errorf("FuzzySearch(%q, %d): expected %v %v, but got %v %v", tt.key, tt.max, tt.expected, tt.dists, keys, dists)
+				}
+			}
+		})
+	}
+}
+
+func TestNextRow(t *testing.T) {
+	// 逐行计算 "kitten" 与 "sitting" 的编辑距离
+	key := []byte("kitten")
+	row := []int{0, 1, 2, 3, 4, 5, 6}
+	for _, e := range []byte("sitting") {
+		row = nextRow(key, row, e)
+	}
+	if row[len(key)] != 3 {
+		t.Errorf("Expected %v, but got %v", 3, row[len(key)])
+	}
+}
@@ -0,0 +1,98 @@
+package goextrie
+
+// StringTrie 是以字符串为 key 的 Trie，按 Unicode 码点（rune）划分 key，模糊搜索的编辑距离也按字符计算。
+//
+// 参数：
+//   - V: value 的类型。
+type StringTrie[V any] struct {
+	t *Trie[rune, V]
+}
+
+// StringMatch 是 StringTrie 模糊搜索的一个结果。
+type StringMatch[V any] struct {
+	Key      string
+	Value    V
+	Distance int // 与查询的编辑距离
+}
+
+// NewString 创建一个普通模式的 StringTrie。
+//
+// 示例：
+//
+//	words := NewString[int]()
+//	words.Insert("hello", 1)
+//	words.KeysWithPrefix("he") // [hello]
+func NewString[V any]() *StringTrie[V] {
+	return &StringTrie[V]{t: New[rune, V]()}
+}
+
+// NewStringCompact 创建一个紧凑模式（基数树）的 StringTrie。
+func NewStringCompact[V any]() *StringTrie[V] {
+	return &StringTrie[V]{t: NewCompact[rune, V]()}
+}
+
+// Len 返回 key 的个数。
+func (s *StringTrie[V]) Len() int {
+	return s.t.Len()
+}
+
+// Get 返回 key 对应的值。
+func (s *StringTrie[V]) Get(key string) (value V, ok bool) {
+	return s.t.Get([]rune(key))
+}
+
+// Has 判断 key 是否存在。
+func (s *StringTrie[V]) Has(key string) bool {
+	return s.t.Has([]rune(key))
+}
+
+// Insert 设置 key 对应的值，key 原本不存在时返回 true。
+func (s *StringTrie[V]) Insert(key string, value V) bool {
+	return s.t.Insert([]rune(key), value)
+}
+
+// Delete 删除 key，key 存在时返回 true。
+func (s *StringTrie[V]) Delete(key string) bool {
+	return s.t.Delete([]rune(key))
+}
+
+// Clear 删除所有 key。
+func (s *StringTrie[V]) Clear() {
+	s.t.Clear()
+}
+
+// LongestPrefix 返回是 key 前缀的最长的已存在 key，详见 Trie.LongestPrefix。
+func (s *StringTrie[V]) LongestPrefix(key string) (prefix string, value V, ok bool) {
+	p, value, ok := s.t.LongestPrefix([]rune(key))
+	return string(p), value, ok
+}
+
+// Walk 按字典序遍历所有键值对，回调返回 false 时停止遍历。
+func (s *StringTrie[V]) Walk(fn func(key string, value V) bool) {
+	s.t.Walk(func(key []rune, value V) bool { return fn(string(key), value) })
+}
+
+// WalkPrefix 按字典序遍历所有以 prefix 开头的键值对，回调返回 false 时停止遍历。
+func (s *StringTrie[V]) WalkPrefix(prefix string, fn func(key string, value V) bool) {
+	s.t.WalkPrefix([]rune(prefix), func(key []rune, value V) bool { return fn(string(key), value) })
+}
+
+// KeysWithPrefix 返回所有以 prefix 开头的 key，按字典序排列，常用于自动补全。
+func (s *StringTrie[V]) KeysWithPrefix(prefix string) []string {
+	var keys []string
+	s.WalkPrefix(prefix, func(key string, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// FuzzySearch 返回与 key 的编辑距离不超过 maxDistance 的所有键值对，详见 Trie.FuzzySearch。
+func (s *StringTrie[V]) FuzzySearch(key string, maxDistance int) []StringMatch[V] {
+	matches := s.t.FuzzySearch([]rune(key), maxDistance)
+	result := make([]StringMatch[V], len(matches))
+	for i, m := range matches {
+		result[i] = StringMatch[V]{Key: string(m.Key), Value: m.Value, Distance: m.Distance}
+	}
+	return result
+}
@@ -0,0 +1,50 @@
+package goextrie
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStringTrie(t *testing.T) {
+	for name, tr := range map[string]*StringTrie[int]{"Plain": NewString[int](), "Compact": NewStringCompact[int]()} {
+		t.Run("TestStringTrie_"+name, func(t *testing.T) {
+			for i, k := range []string{"北京", "北京大学", "北海", "上海"} {
+				tr.Insert(k, i)
+			}
+
+			if v, ok := tr.Get("北海"); !ok || v != 2 || tr.Len() != 4 {
+				t.Errorf("Expected 2, but got %v", v)
+			}
+			if got := tr.KeysWithPrefix("北"); !reflect.DeepEqual(got, []string{"北京", "北京大学", "北海"}) {
+				t.Errorf("Unexpected keys %v", got)
+			}
+			if prefix, v, ok := tr.LongestPrefix("北京大学医学部"); !ok || prefix != "北京大学" || v != 1 {
+				t.Errorf("Unexpected longest prefix %q", prefix)
+			}
+
+			// 编辑距离按字符而不是字节计算
+			matches := tr.FuzzySearch("北海道", 1)
+			expected := []StringMatch[int]{{Key: "北海", Value: 2, Distance: 1}}
+			if !reflect.DeepEqual(matches, expected) {
+				t.Errorf("Expected %v, but got %v", expected, matches)
+			}
+
+			var walked []string
+			tr.Walk(func(key string, _ int) bool {
+				walked = append(walked, key)
+				return true
+			})
+			if len(walked) != 4 {
+				t.Errorf("Unexpected walk %v", walked)
+			}
+
+			if !tr.Delete("北京") || !tr.Has("北京大学") || tr.Has("北京") {
+				t.Errorf("Expected Delete to keep longer keys")
+			}
+			tr.Clear()
+			if tr.Len() != 0 {
+				t.Errorf("Expected empty trie after Clear")
+			}
+		})
+	}
+}
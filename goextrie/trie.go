@@ -0,0 +1,293 @@
+package goextrie
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Trie 是一个以 []E 为 key 的前缀树。
+//
+// 普通模式下每条边保存一个元素；紧凑模式（基数树）下只有一个子节点且没有值的节点会被合并，
+// 每条边保存一段元素，节点数量更少。两种模式的行为完全相同。
+// 子节点按元素排序，因此所有遍历都按 key 的字典序进行。Trie 不是并发安全的。
+//
+// 参数：
+//   - E: key 中元素的类型，例如 rune、byte，或按路径分段匹配时的 string。
+//   - V: value 的类型。
+type Trie[E cmp.Ordered, V any] struct {
+	root    *node[E, V]
+	compact bool
+	size    int
+}
+
+type node[E cmp.Ordered, V any] struct {
+	// label 是从父节点到该节点的边上的元素，根节点为空
+	label    []E
+	children []*node[E, V]
+	value    V
+	hasValue bool
+}
+
+// New 创建一个普通模式的 Trie。
+//
+// 示例：
+//
+//	routes := New[string, Handler]()
+//	routes.Insert([]string{"api", "users"}, listUsers)
+func New[E cmp.Ordered, V any]() *Trie[E, V] {
+	return &Trie[E, V]{root: &node[E, V]{}}
+}
+
+// NewCompact 创建一个紧凑模式（基数树）的 Trie，适合 key 较长且共享前缀较少的场景。
+func NewCompact[E cmp.Ordered, V any]() *Trie[E, V] {
+	return &Trie[E, V]{root: &node[E, V]{}, compact: true}
+}
+
+// child 返回以元素 e 开头的子节点及其位置；不存在时返回 nil 与应插入的位置。
+func (n *node[E, V]) child(e E) (*node[E, V], int) {
+	i, ok := slices.BinarySearchFunc(n.children, e, func(c *node[E, V], e E) int { return cmp.Compare(c.label[0], e) })
+	if !ok {
+		return nil, i
+	}
+	return n.children[i], i
+}
+
+// commonPrefix 返回 a 与 b 的最长公共前缀的长度。
+func commonPrefix[E cmp.Ordered](a, b []E) int {
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	return n
+}
+
+// MARK: - Read
+
+// Len 返回 key 的个数。
+func (t *Trie[E, V]) Len() int {
+	return t.size
+}
+
+// Get 返回 key 对应的值。
+//
+// 返回值：
+//   - value: key 对应的值。
+//   - ok: key 是否存在。
+func (t *Trie[E, V]) Get(key []E) (value V, ok bool) {
+	n := t.find(key)
+	if n == nil || !n.hasValue {
+		return value, false
+	}
+	return n.value, true
+}
+
+// Has 判断 key 是否存在。
+func (t *Trie[E, V]) Has(key []E) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+func (t *Trie[E, V]) find(key []E) *node[E, V] {
+	n := t.root
+	for len(key) > 0 {
+		c, _ := n.child(key[0])
+		if c == nil || commonPrefix(c.label, key) < len(c.label) {
+			return nil
+		}
+		key = key[len(c.label):]
+		n = c
+	}
+	return n
+}
+
+// LongestPrefix 返回是 key 前缀的最长的已存在 key（包括 key 自身），常用于路由匹配。
+//
+// 返回值：
+//   - prefix: 匹配到的 key。
+//   - value: 对应的值。
+//   - ok: 不存在任何匹配时为 false。
+//
+// 示例：
+//   - 已插入 "/api" 与 "/api/users" 时，LongestPrefix("/api/users/42") 返回 "/api/users"。
+func (t *Trie[E, V]) LongestPrefix(key []E) (prefix []E, value V, ok bool) {
+	n := t.root
+	consumed := 0
+	if n.hasValue {
+		prefix, value, ok = key[:0:0], n.value, true
+	}
+	for consumed < len(key) {
+		c, _ := n.child(key[consumed])
+		if c == nil || commonPrefix(c.label, key[consumed:]) < len(c.label) {
+			break
+		}
+		consumed += len(c.label)
+		n = c
+		if n.hasValue {
+			prefix, value, ok = slices.Clone(key[:consumed]), n.value, true
+		}
+	}
+	return prefix, value, ok
+}
+
+// Walk 按字典序遍历所有键值对，回调返回 false 时停止遍历。
+func (t *Trie[E, V]) Walk(fn func(key []E, value V) bool) {
+	t.root.walk(nil, fn)
+}
+
+// WalkPrefix 按字典序遍历所有以 prefix 开头的键值对（包括 prefix 自身），回调返回 false 时停止遍历。
+//
+// 传给回调的 key 是新分配的切片，可以保留。
+func (t *Trie[E, V]) WalkPrefix(prefix []E, fn func(key []E, value V) bool) {
+	n := t.root
+	path := make([]E, 0, len(prefix))
+	for len(prefix) > 0 {
+		c, _ := n.child(prefix[0])
+		if c == nil {
+			return
+		}
+		common := commonPrefix(c.label, prefix)
+		if common < len(prefix) && common < len(c.label) {
+			return
+		}
+		// prefix 可能在边的中间结束，此时整条边下的子树都匹配
+		path = append(path, c.label...)
+		prefix = prefix[common:]
+		n = c
+	}
+	n.walk(path, fn)
+}
+
+func (n *node[E, V]) walk(path []E, fn func(key []E, value V) bool) bool {
+	if n.hasValue && !fn(slices.Clone(path), n.value) {
+		return false
+	}
+	for _, c := range n.children {
+		if !c.walk(append(path, c.label...), fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// KeysWithPrefix 返回所有以 prefix 开头的 key，按字典序排列。
+func (t *Trie[E, V]) KeysWithPrefix(prefix []E) [][]E {
+	var keys [][]E
+	t.WalkPrefix(prefix, func(key []E, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// MARK: - Write
+
+// Insert 设置 key 对应的值。
+//
+// 返回值：
+//   - 如果 key 原本不存在，返回 true。
+func (t *Trie[E, V]) Insert(key []E, value V) bool {
+	n := t.root
+	for len(key) > 0 {
+		c, i := n.child(key[0])
+		if c == nil {
+			c = t.newChain(key)
+			n.children = slices.Insert(n.children, i, c)
+			for len(c.children) > 0 {
+				c = c.children[0]
+			}
+			n, key = c, nil
+			break
+		}
+
+		common := commonPrefix(c.label, key)
+		if common < len(c.label) {
+			// 在公共前缀处拆分边，只会在紧凑模式下发生
+			mid := &node[E, V]{label: c.label[:common:common], children: []*node[E, V]{c}}
+			c.label = c.label[common:]
+			n.children[i] = mid
+			c = mid
+		}
+		n, key = c, key[common:]
+	}
+
+	added := !n.hasValue
+	n.value, n.hasValue = value, true
+	if added {
+		t.size++
+	}
+	return added
+}
+
+// newChain 创建一条保存 key 的路径，紧凑模式下为一个节点，普通模式下每个元素一个节点。
+func (t *Trie[E, V]) newChain(key []E) *node[E, V] {
+	if t.compact {
+		return &node[E, V]{label: slices.Clone(key)}
+	}
+	head := &node[E, V]{label: []E{key[0]}}
+	for n, rest := head, key[1:]; len(rest) > 0; rest = rest[1:] {
+		c := &node[E, V]{label: []E{rest[0]}}
+		n.children = []*node[E, V]{c}
+		n = c
+	}
+	return head
+}
+
+// Delete 删除 key。
+//
+// 返回值：
+//   - 如果 key 存在，返回 true。
+func (t *Trie[E, V]) Delete(key []E) bool {
+	path := []*node[E, V]{t.root}
+	n := t.root
+	for len(key) > 0 {
+		c, _ := n.child(key[0])
+		if c == nil || commonPrefix(c.label, key) < len(c.label) {
+			return false
+		}
+		key = key[len(c.label):]
+		n = c
+		path = append(path, n)
+	}
+	if !n.hasValue {
+		return false
+	}
+
+	var zero V
+	n.value, n.hasValue = zero, false
+	t.size--
+
+	// 自下而上移除没有值也没有子节点的节点
+	i := len(path) - 1
+	for ; i > 0; i-- {
+		n, parent := path[i], path[i-1]
+		if n.hasValue || len(n.children) > 0 {
+			break
+		}
+		_, j := parent.child(n.label[0])
+		parent.children = slices.Delete(parent.children, j, j+1)
+	}
+	// 只有最后保留下来的节点的子节点数量可能发生变化，紧凑模式下检查它能否与唯一的子节点合并
+	if t.compact && i > 0 {
+		path[i].mergeChild()
+	}
+	return true
+}
+
+// mergeChild 将没有值且只有一个子节点的 n 与其子节点合并。
+func (n *node[E, V]) mergeChild() {
+	if n.hasValue || len(n.children) != 1 {
+		return
+	}
+	c := n.children[0]
+	n.label = append(slices.Clone(n.label), c.label...)
+	n.children = c.children
+	n.value, n.hasValue = c.value, c.hasValue
+}
+
+// Clear 删除所有 key。
+func (t *Trie[E, V]) Clear() {
+	t.root = &node[E, V]{}
+	t.size = 0
+}
@@ -0,0 +1,289 @@
+package goextrie
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// modes 以相同的用例测试普通模式与紧凑模式。
+var modes = []struct {
+	name string
+	new  func() *Trie[byte, int]
+}{
+	{"Plain", New[byte, int]},
+	{"Compact", NewCompact[byte, int]},
+}
+
+// checkStructure 检查节点结构：普通模式下每条边只有一个元素；紧凑模式下除根节点外，
+// 没有值的节点至少有两个子节点。两种模式下都不应存在没有值的叶子节点。
+func checkStructure(t *testing.T, tr *Trie[byte, int]) {
+	t.Helper()
+	var check func(n *node[byte, int], isRoot bool)
+	check = func(n *node[byte, int], isRoot bool) {
+		if !isRoot {
+			if !tr.compact && len(n.label) != 1 {
+				t.Fatalf("Expected single element edges, but got %q", n.label)
+			}
+			if !n.hasValue && len(n.children) == 0 {
+				t.Fatalf("Found dangling node %q", n.label)
+			}
+			if tr.compact && !n.hasValue && len(n.children) == 1 {
+				t.Fatalf("Found mergeable node %q", n.label)
+			}
+		}
+		for i := 1; i < len(n.children); i++ {
+			if n.children[i-1].label[0] >= n.children[i].label[0] {
+				t.Fatalf("Children of %q are not sorted", n.label)
+			}
+		}
+		for _, c := range n.children {
+			check(c, false)
+		}
+	}
+	check(tr.root, true)
+}
+
+func TestTrie_Basic(t *testing.T) {
+	for _, mode := range modes {
+		t.Run("TestTrie_Basic_"+mode.name, func(t *testing.T) {
+			tr := mode.new()
+			for i, k := range []string{"team", "tea", "ten", "to", "inn", ""} {
+				if !tr.Insert([]byte(k), i) {
+					t.Errorf("Expected Insert(%q) to add a new key", k)
+				}
+			}
+			if tr.Insert([]byte("tea"), 10) || tr.Len() != 6 {
+				t.Errorf("Expected Insert to replace an existing key")
+			}
+			checkStructure(t, tr)
+
+			if v, ok := tr.Get([]byte("tea")); !ok || v != 10 {
+				t.Errorf("Expected 10, but got %v", v)
+			}
+			if v, ok := tr.Get(nil); !ok || v != 5 {
+				t.Errorf("Expected empty key to be stored, but got %v", v)
+			}
+			for _, k := range []string{"te", "teams", "i", "x"} {
+				if tr.Has([]byte(k)) {
+					t.Errorf("Expected %q to be absent", k)
+				}
+			}
+
+			var keys []string
+			tr.Walk(func(key []byte, _ int) bool {
+				keys = append(keys, string(key))
+				return true
+			})
+			if !reflect.DeepEqual(keys, []string{"", "inn", "tea", "team", "ten", "to"}) {
+				t.Errorf("Unexpected keys %q", keys)
+			}
+
+			if !tr.Delete([]byte("tea")) || tr.Delete([]byte("tea")) || tr.Delete([]byte("te")) {
+				t.Errorf("Expected Delete to report existence")
+			}
+			if !tr.Has([]byte("team")) || tr.Len() != 5 {
+				t.Errorf("Expected other keys to survive")
+			}
+			checkStructure(t, tr)
+
+			tr.Clear()
+			if tr.Len() != 0 || tr.Has([]byte("team")) {
+				t.Errorf("Expected empty trie after Clear")
+			}
+		})
+	}
+}
+
+func TestTrie_WalkPrefix(t *testing.T) {
+	for _, mode := range modes {
+		t.Run("TestTrie_WalkPrefix_"+mode.name, func(t *testing.T) {
+			tr := mode.new()
+			for i, k := range []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus"} {
+				tr.Insert([]byte(k), i)
+			}
+
+			tests := []struct {
+				prefix   string
+				expected []string
+			}{
+				{"rom", []string{"romane", "romanus", "romulus"}},
+				{"rub", []string{"rubens", "ruber", "rubicon", "rubicundus"}},
+				{"rubic", []string{"rubicon", "rubicundus"}},
+				{"ruber", []string{"ruber"}},
+				{"rubero", nil},
+				{"x", nil},
+				{"", []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus"}},
+			}
+			for _, tt := range tests {
+				var got []string
+				for _, k := range tr.KeysWithPrefix([]byte(tt.prefix)) {
+					got = append(got, string(k))
+				}
+				if !reflect.DeepEqual(got, tt.expected) {
+					t.Errorf("Prefix %q: expected %v, but got %v", tt.prefix, tt.expected, got)
+				}
+			}
+
+			count := 0
+			tr.WalkPrefix([]byte("r"), func(key []byte, _ int) bool {
+				count++
+				return count < 2
+			})
+			if count != 2 {
+				t.Errorf("Expected walk to stop after 2 keys, but got %d", count)
+			}
+		})
+	}
+}
+
+func TestTrie_LongestPrefix(t *testing.T) {
+	for _, mode := range modes {
+		t.Run("TestTrie_LongestPrefix_"+mode.name, func(t *testing.T) {
+			tr := mode.new()
+			tr.Insert([]byte("/api"), 1)
+			tr.Insert([]byte("/api/users"), 2)
+			tr.Insert([]byte("/static/"), 3)
+
+			tests := []struct {
+				key      string
+				prefix   string
+				value    int
+				expected bool
+			}{
+				{"/api/users/42", "/api/users", 2, true},
+				{"/api/user", "/api", 1, true},
+				{"/api", "/api", 1, true},
+				{"/static/css/app.css", "/static/", 3, true},
+				{"/stat", "", 0, false},
+				{"/", "", 0, false},
+			}
+			for _, tt := range tests {
+				prefix, value, ok := tr.LongestPrefix([]byte(tt.key))
+				if ok != tt.expected || string(prefix) != tt.prefix || value != tt.value {
+					t.Errorf("LongestPrefix(%q): expected (%q, %v, %v), but got (%q, %v, %v)",
+						tt.key, tt.prefix, tt.value, tt.expected, prefix, value, ok)
+				}
+			}
+
+			tr.Insert(nil, 0)
+			if prefix, _, ok := tr.LongestPrefix([]byte("/x")); !ok || len(prefix) != 0 {
+				t.Errorf("Expected empty key to match, but got %q", prefix)
+			}
+		})
+	}
+}
+
+func TestTrie_PathSegments(t *testing.T) {
+	routes := NewCompact[string, string]()
+	routes.Insert([]string{"api", "v1", "users"}, "users")
+	routes.Insert([]string{"api", "v1", "orders"}, "orders")
+
+	prefix, handler, ok := routes.LongestPrefix([]string{"api", "v1", "users", "42"})
+	if !ok || handler != "users" || !reflect.DeepEqual(prefix, []string{"api", "v1", "users"}) {
+		t.Errorf("Unexpected match %v, %v", prefix, handler)
+	}
+	if got := routes.KeysWithPrefix([]string{"api", "v1"}); len(got) != 2 {
+		t.Errorf("Expected 2 routes, but got %v", got)
+	}
+}
+
+func TestTrie_RandomOperations(t *testing.T) {
+	for _, mode := range modes {
+		t.Run("TestTrie_RandomOperations_"+mode.name, func(t *testing.T) {
+			rnd := rand.New(rand.NewSource(1))
+			tr := mode.new()
+			model := map[string]int{}
+
+			randomKey := func() string {
+				b := make([]byte, rnd.Intn(6))
+				for i := range b {
+					b[i] = "abc"[rnd.Intn(3)]
+				}
+				return string(b)
+			}
+
+			for i := 0; i < 3000; i++ {
+				k := randomKey()
+				_, existed := model[k]
+				if rnd.Intn(3) == 0 {
+					delete(model, k)
+					if tr.Delete([]byte(k)) != existed {
+						t.Fatalf("Delete(%q) disagrees with model", k)
+					}
+				} else {
+					model[k] = i
+					if tr.Insert([]byte(k), i) == existed {
+						t.Fatalf("Insert(%q) disagrees with model", k)
+					}
+				}
+				checkStructure(t, tr)
+			}
+
+			keys := make([]string, 0, len(model))
+			for k := range model {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			var got []string
+			tr.Walk(func(key []byte, value int) bool {
+				if model[string(key)] != value {
+					t.Errorf("Value of %q: expected %v, but got %v", key, model[string(key)], value)
+				}
+				got = append(got, string(key))
+				return true
+			})
+			if !reflect.DeepEqual(got, keys) || tr.Len() != len(model) {
+				t.Errorf("Keys disagree with model")
+			}
+		})
+	}
+}
+
+func TestTrie_Compact(t *testing.T) {
+	plain, compact := New[byte, int](), NewCompact[byte, int]()
+	for _, tr := range []*Trie[byte, int]{plain, compact} {
+		tr.Insert([]byte("internationalization"), 1)
+		tr.Insert([]byte("internet"), 2)
+	}
+
+	count := func(tr *Trie[byte, int]) int {
+		n := 0
+		var walk func(*node[byte, int])
+		walk = func(nd *node[byte, int]) {
+			n++
+			for _, c := range nd.children {
+				walk(c)
+			}
+		}
+		walk(tr.root)
+		return n
+	}
+	// 紧凑模式：根、"intern"、"ationalization"、"et"
+	if got := count(compact); got != 4 {
+		t.Errorf("Expected %v, but got %v", 4, got)
+	}
+	if got := count(plain); got != 23 {
+		t.Errorf("Expected %v, but got %v", 23, got)
+	}
+}
+
+func BenchmarkTrie_Get(b *testing.B) {
+	for _, mode := range modes {
+		b.Run(mode.name, func(b *testing.B) {
+			tr := mode.new()
+			keys := make([][]byte, 1000)
+			rnd := rand.New(rand.NewSource(1))
+			for i := range keys {
+				keys[i] = make([]byte, 16)
+				rnd.Read(keys[i])
+				tr.Insert(keys[i], i)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				tr.Get(keys[i%len(keys)])
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Package hashutil 为任意可比较类型提供哈希函数，供分片容器、HAMT 等结构复用。
+package hashutil
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+	"reflect"
+)
+
+var seed = maphash.MakeSeed()
+
+// Hash 返回 key 的 64 位哈希值。
+//
+// 对于常见的字符串、整数、浮点数与布尔类型直接计算哈希；其他可比较类型通过反射
+// 逐个字段计算，速度较慢但与 == 的语义一致：指针与通道按地址哈希，浮点数的 -0 与 +0 哈希相同。
+//
+// 参数：
+//   - key: 要计算哈希的值。
+//
+// 返回值：
+//   - key 的哈希值，在同一进程内稳定。
+func Hash[K comparable](key K) uint64 {
+	var buf [8]byte
+
+	switch k := any(key).(type) {
+	case string:
+		return maphash.String(seed, k)
+	case int:
+		binary.LittleEndian.PutUint64(buf[:], uint64(k))
+	case int8:
+		binary.LittleEndian.PutUint64(buf[:], uint64(k))
+	case int16:
+		binary.LittleEndian.PutUint64(buf[:], uint64(k))
+	case int32:
+		binary.LittleEndian.PutUint64(buf[:], uint64(k))
+	case int64:
+		binary.LittleEndian.PutUint64(buf[:], uint64(k))
+	case uint:
+		binary.LittleEndian.PutUint64(buf[:], uint64(k))
+	case uint8:
+		binary.LittleEndian.PutUint64(buf[:], uint64(k))
+	case uint16:
+		binary.LittleEndian.PutUint64(buf[:], uint64(k))
+	case uint32:
+		binary.LittleEndian.PutUint64(buf[:], uint64(k))
+	case uint64:
+		binary.LittleEndian.PutUint64(buf[:], k)
+	case uintptr:
+		binary.LittleEndian.PutUint64(buf[:], uint64(k))
+	case float32:
+		binary.LittleEndian.PutUint64(buf[:], floatBits(float64(k)))
+	case float64:
+		binary.LittleEndian.PutUint64(buf[:], floatBits(k))
+	case bool:
+		if k {
+			buf[0] = 1
+		}
+	default:
+		var h maphash.Hash
+		h.SetSeed(seed)
+		writeValue(&h, reflect.ValueOf(&key).Elem())
+		return h.Sum64()
+	}
+
+	return maphash.Bytes(seed, buf[:])
+}
+
+// floatBits 返回浮点数的位表示，并将 -0 归一化为 +0，保证两者哈希一致。
+func floatBits(f float64) uint64 {
+	if f == 0 {
+		return 0
+	}
+	return math.Float64bits(f)
+}
+
+// writeValue 按 == 的语义把 v 写入哈希，相等的值写入相同的字节。
+func writeValue(h *maphash.Hash, v reflect.Value) {
+	var buf [8]byte
+	writeUint := func(u uint64) {
+		binary.LittleEndian.PutUint64(buf[:], u)
+		h.Write(buf[:])
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		writeUint(uint64(v.Len()))
+		h.WriteString(v.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeUint(floatBits(v.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		writeUint(floatBits(real(c)))
+		writeUint(floatBits(imag(c)))
+	case reflect.Bool:
+		if v.Bool() {
+			writeUint(1)
+		} else {
+			writeUint(0)
+		}
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		writeUint(uint64(v.Pointer()))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			writeValue(h, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			writeValue(h, v.Field(i))
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			writeUint(0)
+			return
+		}
+		// 动态类型不同的接口值不相等，类型名只用于区分，冲突不影响正确性
+		elem := v.Elem()
+		h.WriteString(elem.Type().String())
+		writeValue(h, elem)
+	default:
+		// 切片、映射与函数不可比较，作为接口值的动态类型时 == 本身会 panic
+		writeUint(uint64(v.Kind()))
+	}
+}
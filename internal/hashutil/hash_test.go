@@ -0,0 +1,55 @@
+package hashutil
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHash(t *testing.T) {
+	t.Run("TestHash_PointerByAddress", func(t *testing.T) {
+		type node struct{ v int }
+		p := &node{v: 1}
+		before := Hash(p)
+		p.v = 2
+		if after := Hash(p); after != before {
+			t.Errorf("Expected pointer hash to ignore pointee, but got %v and %v", before, after)
+		}
+	})
+
+	t.Run("TestHash_SignedZero", func(t *testing.T) {
+		type point struct {
+			X float64
+			Y float32
+		}
+		negZero := math.Copysign(0, -1)
+		if Hash(point{X: 0}) != Hash(point{X: negZero, Y: float32(negZero)}) {
+			t.Errorf("Expected -0 and +0 in struct keys to hash equally")
+		}
+		if Hash([2]float64{0, 1}) != Hash([2]float64{negZero, 1}) {
+			t.Errorf("Expected -0 and +0 in array keys to hash equally")
+		}
+		if Hash(complex(negZero, 0)) != Hash(complex(0, negZero)) {
+			t.Errorf("Expected -0 and +0 in complex keys to hash equally")
+		}
+	})
+
+	t.Run("TestHash_EqualKeys", func(t *testing.T) {
+		type key struct {
+			name string
+			any  any
+			ch   chan int
+		}
+		ch := make(chan int)
+		a := key{name: "a", any: 1, ch: ch}
+		b := key{name: "a", any: 1, ch: ch}
+		if Hash(a) != Hash(b) {
+			t.Errorf("Expected equal keys to hash equally")
+		}
+		if Hash(any(0.0)) != Hash(any(math.Copysign(0, -1))) {
+			t.Errorf("Expected -0 and +0 in interface keys to hash equally")
+		}
+		if Hash(key{name: "ab"}) == Hash(key{name: "a", any: "b"}) {
+			t.Errorf("Expected different keys to hash differently")
+		}
+	})
+}